@@ -0,0 +1,61 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+const (
+	// AdoptAnnotation can be set on a Custom Resource to adopt the existing Sentry resource with the given ID, instead of
+	// creating a new one. Once adopted, the Sentry resource is managed like any resource created by the operator.
+	AdoptAnnotation = "sentry.kubernetes.jaceys.me/adopt"
+
+	// ReleaseProjectAnnotation can be set on a Deployment to the slug of a Sentry project, so that a Release is created
+	// for the project whenever the Deployment's image changes. Requires the Deployment watcher to be enabled. Also
+	// identifies the Sentry project of Deployments and StatefulSets whose deploys are tracked.
+	ReleaseProjectAnnotation = "sentry.kubernetes.jaceys.me/release-project"
+
+	// ReleaseContainerAnnotation can be set on a Deployment to the name of the container whose image is used as the
+	// release version. Defaults to the first container in the Deployment's pod template.
+	ReleaseContainerAnnotation = "sentry.kubernetes.jaceys.me/release-container"
+
+	// DeployEnvironmentAnnotation can be set on a Deployment or StatefulSet to the Sentry environment that its deploys
+	// are recorded against. Defaults to the SENTRY_ENVIRONMENT variable of the release container.
+	DeployEnvironmentAnnotation = "sentry.kubernetes.jaceys.me/deploy-environment"
+
+	// DeployVersionAnnotation, DeployStartedAnnotation, DeployFinishedAnnotation and DeployIDAnnotation are set by the
+	// operator on tracked Deployments and StatefulSets to record the state of their latest Sentry deploy.
+	DeployVersionAnnotation  = "sentry.kubernetes.jaceys.me/deploy-version"
+	DeployStartedAnnotation  = "sentry.kubernetes.jaceys.me/deploy-started"
+	DeployFinishedAnnotation = "sentry.kubernetes.jaceys.me/deploy-finished"
+	DeployIDAnnotation       = "sentry.kubernetes.jaceys.me/deploy-id"
+
+	// MonitorProjectAnnotation can be set on a CronJob to the slug of a Sentry project, so that a Monitor mirroring the
+	// CronJob's schedule is created for the project. Requires the CronJob watcher to be enabled.
+	MonitorProjectAnnotation = "sentry.kubernetes.jaceys.me/monitor-project"
+
+	// MonitorTimezoneAnnotation can be set on a CronJob to override the timezone that its schedule is evaluated in.
+	// Defaults to the CronJob's spec.timeZone, then the CRON_TZ or TZ prefix of its schedule, or UTC otherwise.
+	MonitorTimezoneAnnotation = "sentry.kubernetes.jaceys.me/monitor-timezone"
+)
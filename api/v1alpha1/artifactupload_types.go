@@ -0,0 +1,154 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArtifactUploadSpec defines the desired state of ArtifactUpload.
+type ArtifactUploadSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// Slug of the project that the release belongs to.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Version of the release that the files are uploaded to.
+	Release string `json:"release"`
+
+	// +optional
+	// Distribution of the release that the files are uploaded to, such as a build number.
+	Dist string `json:"dist,omitempty"`
+
+	// +optional
+	// Prefix of the names of the uploaded files, which should match the URLs that the files are served from. Defaults
+	// to "~/", which matches any scheme and host.
+	URLPrefix *string `json:"urlPrefix,omitempty"`
+
+	// Source of the files to be uploaded.
+	Source ArtifactUploadSource `json:"source"`
+
+	// +optional
+	// Files of the source to be uploaded. Defaults to every file of the source, named after its key.
+	Files []ArtifactUploadFile `json:"files,omitempty"`
+}
+
+// ArtifactUploadSource is the source of the files of an artifact upload. Only ConfigMaps are currently supported.
+type ArtifactUploadSource struct {
+	// Selects a ConfigMap in the same namespace, whose data and binaryData keys are uploaded as files.
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef"`
+}
+
+// ArtifactUploadFile maps a key of an artifact upload's source to the name of the uploaded file.
+type ArtifactUploadFile struct {
+	// +kubebuilder:validation:MinLength=1
+	// Key of the file in the source.
+	Key string `json:"key"`
+
+	// +optional
+	// Name of the uploaded file, relative to the URL prefix, such as "static/js/main.js.map". Defaults to the key.
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Uploaded;Planned;Error
+type ArtifactUploadCondition string
+
+const (
+	ArtifactUploadConditionUploaded ArtifactUploadCondition = "Uploaded"
+	ArtifactUploadConditionPlanned  ArtifactUploadCondition = "Planned"
+	ArtifactUploadConditionError    ArtifactUploadCondition = "Error"
+)
+
+// ArtifactUploadStatus defines the observed state of ArtifactUpload.
+type ArtifactUploadStatus struct {
+	// The state of the artifact upload.
+	// "Uploaded" indicates that the files were uploaded to the release successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the artifact upload
+	// is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the artifact upload.
+	Condition ArtifactUploadCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the artifact upload.
+	Message string `json:"message,omitempty"`
+
+	// The slug of the project that the files were uploaded to.
+	Project string `json:"project,omitempty"`
+
+	// The version of the release that the files were uploaded to.
+	Release string `json:"release,omitempty"`
+
+	// The distribution of the release that the files were uploaded to.
+	Dist string `json:"dist,omitempty"`
+
+	// The files that were uploaded to the release.
+	Files []ArtifactUploadFileStatus `json:"files,omitempty"`
+
+	// The time that the artifact upload was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// ArtifactUploadFileStatus is a file that was uploaded to a release.
+type ArtifactUploadFileStatus struct {
+	// The name of the release file.
+	Name string `json:"name"`
+
+	// The ID of the release file.
+	ID string `json:"id"`
+
+	// The SHA1 checksum of the release file's content.
+	SHA1 string `json:"sha1"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.project`
+// +kubebuilder:printcolumn:name="Release",type=string,JSONPath=`.spec.release`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// ArtifactUpload is the Schema for the artifactuploads API.
+type ArtifactUpload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArtifactUploadSpec   `json:"spec,omitempty"`
+	Status ArtifactUploadStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ArtifactUploadList contains a list of ArtifactUpload.
+type ArtifactUploadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArtifactUpload `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArtifactUpload{}, &ArtifactUploadList{})
+}
@@ -0,0 +1,201 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DashboardSpec defines the desired state of Dashboard.
+type DashboardSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// Title of the dashboard.
+	Title string `json:"title"`
+
+	// +optional
+	// Widgets of the dashboard, in the order that they should be displayed.
+	Widgets []DashboardWidget `json:"widgets,omitempty"`
+
+	// +optional
+	// Source of additional widgets of the dashboard, which are displayed after the widgets above.
+	WidgetsFrom *DashboardWidgetsSource `json:"widgetsFrom,omitempty"`
+}
+
+// DashboardWidget is a widget displaying the results of one or more queries on a dashboard.
+type DashboardWidget struct {
+	// +kubebuilder:validation:MinLength=1
+	// Title of the widget.
+	Title string `json:"title"`
+
+	// How the results of the widget's queries are displayed.
+	DisplayType DashboardDisplayType `json:"displayType"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=discover;issue
+	// Dataset that the widget's queries are run against. Defaults to "discover".
+	WidgetType string `json:"widgetType,omitempty"`
+
+	// +optional
+	// Interval that the widget's results are grouped by, such as "5m".
+	Interval string `json:"interval,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// Maximum number of results displayed by the widget, for display types that support it.
+	Limit *int `json:"limit,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Queries of the widget.
+	Queries []DashboardWidgetQuery `json:"queries"`
+
+	// +optional
+	// Position and size of the widget on the dashboard's grid. Sentry places the widget automatically if unset.
+	Layout *DashboardWidgetLayout `json:"layout,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=line;area;stacked_area;bar;table;world_map;big_number;top_n
+type DashboardDisplayType string
+
+// DashboardWidgetQuery is a query whose results are displayed by a dashboard widget.
+type DashboardWidgetQuery struct {
+	// +optional
+	// Name of the query, used as its legend.
+	Name string `json:"name,omitempty"`
+
+	// +optional
+	// Aggregate functions of the query, such as "count()".
+	Aggregates []string `json:"aggregates,omitempty"`
+
+	// +optional
+	// Columns that the query's results are grouped by, such as "transaction".
+	Columns []string `json:"columns,omitempty"`
+
+	// +optional
+	// Fields of the query. Defaults to the query's columns followed by its aggregates.
+	Fields []string `json:"fields,omitempty"`
+
+	// +optional
+	// Search conditions of the query, such as "event.type:error".
+	Conditions string `json:"conditions,omitempty"`
+
+	// +optional
+	// Field that the query's results are ordered by, prefixed with "-" for descending order.
+	OrderBy string `json:"orderBy,omitempty"`
+}
+
+// DashboardWidgetLayout is the position and size of a widget on a dashboard's grid.
+type DashboardWidgetLayout struct {
+	// +kubebuilder:validation:Minimum=0
+	X int `json:"x"`
+
+	// +kubebuilder:validation:Minimum=0
+	Y int `json:"y"`
+
+	// +kubebuilder:validation:Minimum=1
+	W int `json:"w"`
+
+	// +kubebuilder:validation:Minimum=1
+	H int `json:"h"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinH int `json:"minH,omitempty"`
+}
+
+// DashboardWidgetsSource is the source of widgets of a dashboard.
+type DashboardWidgetsSource struct {
+	// Selects a key of a ConfigMap in the same namespace, whose value is a YAML list of widgets in the same format as
+	// the widgets of our spec.
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef"`
+
+	// +optional
+	// Variables that are substituted into the widgets loaded from the ConfigMap, where each reference of the form
+	// $(NAME) is replaced by the value of the variable NAME. This allows widgets to be templated across dashboards.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type DashboardCondition string
+
+const (
+	DashboardConditionCreated DashboardCondition = "Created"
+	DashboardConditionPlanned DashboardCondition = "Planned"
+	DashboardConditionError   DashboardCondition = "Error"
+)
+
+// DashboardStatus defines the observed state of Dashboard.
+type DashboardStatus struct {
+	// The state of the Sentry dashboard.
+	// "Created" indicates that the dashboard was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the dashboard is
+	// described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the dashboard.
+	Condition DashboardCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the dashboard.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry dashboard.
+	ID string `json:"id,omitempty"`
+
+	// The number of widgets on the Sentry dashboard.
+	Widgets int `json:"widgets,omitempty"`
+
+	// The time that the dashboard was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Title",type=string,JSONPath=`.spec.title`
+// +kubebuilder:printcolumn:name="Widgets",type=integer,JSONPath=`.status.widgets`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// Dashboard is the Schema for the dashboards API.
+type Dashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DashboardSpec   `json:"spec,omitempty"`
+	Status DashboardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DashboardList contains a list of Dashboard.
+type DashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dashboard `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Dashboard{}, &DashboardList{})
+}
@@ -0,0 +1,107 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InternalIntegrationSpec defines the desired state of InternalIntegration.
+type InternalIntegrationSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=57
+	// Name of the Sentry internal integration.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Permission scopes of the internal integration's auth token. Sentry installs internal integrations on the whole
+	// organization, so the token cannot be restricted to specific projects. When using the operator-wide Sentry
+	// credentials, only the scopes allowed by the operator can be requested, which exclude admin scopes by default.
+	Scopes []InternalIntegrationScope `json:"scopes"`
+}
+
+// +kubebuilder:validation:Enum="org:read";"org:write";"org:admin";"org:integrations";"member:read";"member:write";"member:admin";"team:read";"team:write";"team:admin";"project:read";"project:write";"project:admin";"project:releases";"event:read";"event:write";"event:admin";"alerts:read";"alerts:write"
+type InternalIntegrationScope string
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type InternalIntegrationCondition string
+
+const (
+	InternalIntegrationConditionCreated InternalIntegrationCondition = "Created"
+	InternalIntegrationConditionPlanned InternalIntegrationCondition = "Planned"
+	InternalIntegrationConditionError   InternalIntegrationCondition = "Error"
+)
+
+// InternalIntegrationStatus defines the observed state of InternalIntegration.
+type InternalIntegrationStatus struct {
+	// The state of the Sentry internal integration.
+	// "Created" indicates that the Sentry internal integration was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry internal
+	// integration is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry internal integration.
+	Condition InternalIntegrationCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry internal integration.
+	Message string `json:"message,omitempty"`
+
+	// The slug of the Sentry internal integration.
+	Slug string `json:"slug,omitempty"`
+
+	// The ID of the auth token that is stored in the internal integration's Secret.
+	TokenID string `json:"tokenID,omitempty"`
+
+	// The time that the Sentry internal integration was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Slug",type=string,JSONPath=`.status.slug`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// InternalIntegration is the Schema for the internalintegrations API.
+type InternalIntegration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InternalIntegrationSpec   `json:"spec,omitempty"`
+	Status InternalIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InternalIntegrationList contains a list of InternalIntegration.
+type InternalIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InternalIntegration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InternalIntegration{}, &InternalIntegrationList{})
+}
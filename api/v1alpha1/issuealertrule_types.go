@@ -0,0 +1,148 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IssueAlertRuleSpec defines the desired state of IssueAlertRule.
+type IssueAlertRuleSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50
+	// Slug of the Sentry project that this issue alert rule should be created under.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// Name of the Sentry issue alert rule.
+	Name string `json:"name"`
+
+	// +optional
+	// Name of the environment that the issue alert rule should be limited to. The rule applies to all environments if
+	// this is not set.
+	Environment *string `json:"environment,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=all;any;none
+	// Whether "all", "any" or "none" of the conditions need to be met for the issue alert rule to fire. Defaults to "all".
+	ActionMatch string `json:"actionMatch,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=all;any;none
+	// Whether "all", "any" or "none" of the filters need to pass for the issue alert rule to fire. Defaults to "all".
+	FilterMatch string `json:"filterMatch,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=43200
+	// Minimum number of minutes between actions being taken for the same issue. Defaults to 30.
+	Frequency int `json:"frequency,omitempty"`
+
+	// +optional
+	// Conditions that trigger the issue alert rule.
+	Conditions []IssueAlertRuleComponent `json:"conditions,omitempty"`
+
+	// +optional
+	// Filters that events need to pass for the issue alert rule to fire.
+	Filters []IssueAlertRuleComponent `json:"filters,omitempty"`
+
+	// +optional
+	// Actions that are taken when the issue alert rule fires.
+	Actions []IssueAlertRuleComponent `json:"actions,omitempty"`
+}
+
+// IssueAlertRuleComponent is a condition, filter or action of an issue alert rule.
+type IssueAlertRuleComponent struct {
+	// +kubebuilder:validation:MinLength=1
+	// ID of the type of component, such as "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition".
+	ID string `json:"id"`
+
+	// +optional
+	// Fields of the component, which depend on its type, such as "interval" and "value" for an event frequency
+	// condition.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type IssueAlertRuleCondition string
+
+const (
+	IssueAlertRuleConditionCreated IssueAlertRuleCondition = "Created"
+	IssueAlertRuleConditionPlanned IssueAlertRuleCondition = "Planned"
+	IssueAlertRuleConditionError   IssueAlertRuleCondition = "Error"
+)
+
+// IssueAlertRuleStatus defines the observed state of IssueAlertRule.
+type IssueAlertRuleStatus struct {
+	// The state of the Sentry issue alert rule.
+	// "Created" indicates that the Sentry issue alert rule was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry issue
+	// alert rule is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry issue alert rule.
+	Condition IssueAlertRuleCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry issue alert rule, such as
+	// validation errors returned by Sentry.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry issue alert rule.
+	ID string `json:"id,omitempty"`
+
+	// The time that the Sentry issue alert rule was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+
+	// The ID of the Sentry project that this issue alert rule belongs to.
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// IssueAlertRule is the Schema for the issuealertrules API.
+type IssueAlertRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IssueAlertRuleSpec   `json:"spec,omitempty"`
+	Status IssueAlertRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IssueAlertRuleList contains a list of IssueAlertRule.
+type IssueAlertRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IssueAlertRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IssueAlertRule{}, &IssueAlertRuleList{})
+}
@@ -0,0 +1,178 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MetricAlertRuleSpec defines the desired state of MetricAlertRule.
+type MetricAlertRuleSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// Name of the Sentry metric alert rule.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Slugs of the Sentry projects that the metric alert rule should monitor.
+	Projects []string `json:"projects"`
+
+	// +optional
+	// Name of the environment that the metric alert rule should be limited to. The rule applies to all environments if
+	// this is not set.
+	Environment *string `json:"environment,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=events;transactions
+	// Dataset that the metric alert rule should query. Defaults to "events".
+	Dataset string `json:"dataset,omitempty"`
+
+	// +optional
+	// Search query for filtering the events that the metric alert rule should aggregate, such as "level:error".
+	Query string `json:"query,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Aggregate function to monitor, such as "count()" or "p95(transaction.duration)".
+	Aggregate string `json:"aggregate"`
+
+	// +kubebuilder:validation:Enum=1;5;10;15;30;60;120;240;1440
+	// Time window in minutes over which the aggregate is computed.
+	TimeWindow int `json:"timeWindow"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=Above;Below
+	// Whether the metric alert rule fires when the aggregate is "Above" or "Below" its triggers' thresholds. Defaults
+	// to "Above".
+	ThresholdType MetricAlertRuleThresholdType `json:"thresholdType,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// Value of the aggregate at which the metric alert rule is resolved, as a decimal number such as "10" or "0.5".
+	// Defaults to the thresholds of its triggers.
+	ResolveThreshold *string `json:"resolveThreshold,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Triggers of the metric alert rule, each with its own threshold and actions.
+	Triggers []MetricAlertRuleTrigger `json:"triggers"`
+}
+
+type MetricAlertRuleThresholdType string
+
+const (
+	MetricAlertRuleThresholdTypeAbove MetricAlertRuleThresholdType = "Above"
+	MetricAlertRuleThresholdTypeBelow MetricAlertRuleThresholdType = "Below"
+)
+
+// MetricAlertRuleTrigger is a threshold at which a metric alert rule fires, along with the actions to be taken.
+type MetricAlertRuleTrigger struct {
+	// +kubebuilder:validation:Enum=critical;warning
+	// Label of the trigger.
+	Label string `json:"label"`
+
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// Value of the aggregate at which the trigger fires, as a decimal number such as "100" or "0.5".
+	AlertThreshold string `json:"alertThreshold"`
+
+	// +optional
+	// Actions that are taken when the trigger fires.
+	Actions []MetricAlertRuleAction `json:"actions,omitempty"`
+}
+
+// MetricAlertRuleAction is an action taken when a metric alert rule's trigger fires.
+type MetricAlertRuleAction struct {
+	// +kubebuilder:validation:Enum=email;slack;pagerduty;msteams;sentry_app
+	// Type of the action.
+	Type string `json:"type"`
+
+	// +kubebuilder:validation:Enum=user;team;specific;sentry_app
+	// Type of the action's target.
+	TargetType string `json:"targetType"`
+
+	// +optional
+	// Identifier of the action's target, such as the ID of a Sentry user or team, or the name of a Slack channel.
+	TargetIdentifier string `json:"targetIdentifier,omitempty"`
+
+	// +optional
+	// ID of the Sentry integration used for the action, such as a Slack or PagerDuty integration.
+	IntegrationID *int `json:"integrationID,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type MetricAlertRuleCondition string
+
+const (
+	MetricAlertRuleConditionCreated MetricAlertRuleCondition = "Created"
+	MetricAlertRuleConditionPlanned MetricAlertRuleCondition = "Planned"
+	MetricAlertRuleConditionError   MetricAlertRuleCondition = "Error"
+)
+
+// MetricAlertRuleStatus defines the observed state of MetricAlertRule.
+type MetricAlertRuleStatus struct {
+	// The state of the Sentry metric alert rule.
+	// "Created" indicates that the Sentry metric alert rule was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry metric
+	// alert rule is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry metric alert rule.
+	Condition MetricAlertRuleCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry metric alert rule, such as
+	// validation errors returned by Sentry.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry metric alert rule.
+	ID string `json:"id,omitempty"`
+
+	// The time that the Sentry metric alert rule was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// MetricAlertRule is the Schema for the metricalertrules API.
+type MetricAlertRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MetricAlertRuleSpec   `json:"spec,omitempty"`
+	Status MetricAlertRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MetricAlertRuleList contains a list of MetricAlertRule.
+type MetricAlertRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MetricAlertRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MetricAlertRule{}, &MetricAlertRuleList{})
+}
@@ -0,0 +1,127 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MonitorSpec defines the desired state of Monitor.
+type MonitorSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50
+	// Slug of the Sentry project that this monitor should be created under.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// Name of the Sentry monitor.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MaxLength=50
+	// +kubebuilder:validation:Pattern=`^([a-z0-9_-]+)?$`
+	// +optional
+	// Slug of the Sentry monitor, which is used by check-ins to identify the monitor. Generated from the name by Sentry
+	// if not set.
+	Slug string `json:"slug,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// The crontab schedule that the monitored job runs on, such as "0 2 * * *" or "@daily".
+	Schedule string `json:"schedule"`
+
+	// +optional
+	// The tz database name of the timezone that the schedule is evaluated in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	// The number of minutes after the expected time that a check-in is considered missed.
+	CheckinMargin *int `json:"checkinMargin,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	// The number of minutes that a job is allowed to run for before it is considered failed.
+	MaxRuntime *int `json:"maxRuntime,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type MonitorCondition string
+
+const (
+	MonitorConditionCreated MonitorCondition = "Created"
+	MonitorConditionPlanned MonitorCondition = "Planned"
+	MonitorConditionError   MonitorCondition = "Error"
+)
+
+// MonitorStatus defines the observed state of Monitor.
+type MonitorStatus struct {
+	// The state of the Sentry monitor.
+	// "Created" indicates that the Sentry monitor was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry monitor is
+	// described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry monitor.
+	Condition MonitorCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry monitor.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry monitor.
+	ID string `json:"id,omitempty"`
+
+	// The slug of the Sentry monitor.
+	Slug string `json:"slug,omitempty"`
+
+	// The time that the Sentry monitor was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// Monitor is the Schema for the monitors API.
+type Monitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MonitorSpec   `json:"spec,omitempty"`
+	Status MonitorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MonitorList contains a list of Monitor.
+type MonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Monitor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Monitor{}, &MonitorList{})
+}
@@ -0,0 +1,122 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrganizationMemberSpec defines the desired state of OrganizationMember.
+type OrganizationMemberSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// Email of the Sentry organization member. This cannot be changed once the member has been invited.
+	Email string `json:"email"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=member;admin;manager;owner;billing
+	// Role of the member within the Sentry organization. Members are invited as "member" if this is not set, and the
+	// role of an adopted member is left untouched.
+	Role string `json:"role,omitempty"`
+
+	// +optional
+	// Slugs of the Sentry teams that the member should belong to. The member is removed from any other Sentry teams,
+	// and an empty list removes the member from all Sentry teams. The member's Sentry teams are left untouched if this
+	// is not set.
+	Teams *[]string `json:"teams,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// Whether the member should be removed from the Sentry organization when the OrganizationMember is deleted.
+	// "Delete" removes the member from the Sentry organization. This is the default for invited members.
+	// "Retain" leaves the member in the Sentry organization. This is the default for adopted members.
+	DeletionPolicy OrganizationMemberDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+type OrganizationMemberDeletionPolicy string
+
+const (
+	OrganizationMemberDeletionPolicyDelete OrganizationMemberDeletionPolicy = "Delete"
+	OrganizationMemberDeletionPolicyRetain OrganizationMemberDeletionPolicy = "Retain"
+)
+
+// +kubebuilder:validation:Enum=Pending;Active;Planned;Error
+type OrganizationMemberCondition string
+
+const (
+	OrganizationMemberConditionPending OrganizationMemberCondition = "Pending"
+	OrganizationMemberConditionActive  OrganizationMemberCondition = "Active"
+	OrganizationMemberConditionPlanned OrganizationMemberCondition = "Planned"
+	OrganizationMemberConditionError   OrganizationMemberCondition = "Error"
+)
+
+// OrganizationMemberStatus defines the observed state of OrganizationMember.
+type OrganizationMemberStatus struct {
+	// The state of the Sentry organization member.
+	// "Pending" indicates that the member was invited successfully, but has yet to accept their invitation.
+	// "Active" indicates that the member has accepted their invitation.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry
+	// organization member is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry organization member.
+	Condition OrganizationMemberCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry organization member.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry organization member.
+	ID string `json:"id,omitempty"`
+
+	// The time that the Sentry organization member was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Email",type=string,JSONPath=`.spec.email`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// OrganizationMember is the Schema for the organizationmembers API.
+type OrganizationMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationMemberSpec   `json:"spec,omitempty"`
+	Status OrganizationMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationMemberList contains a list of OrganizationMember.
+type OrganizationMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationMember `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrganizationMember{}, &OrganizationMemberList{})
+}
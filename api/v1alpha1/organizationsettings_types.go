@@ -0,0 +1,177 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrganizationSettingsSpec defines the desired state of OrganizationSettings. Settings that are not set are left
+// untouched.
+type OrganizationSettingsSpec struct {
+	// +optional
+	// +kubebuilder:validation:Enum=member;admin;manager;owner
+	// Role that is given to new members of the Sentry organization by default.
+	DefaultRole string `json:"defaultRole,omitempty"`
+
+	// +optional
+	// Whether members of the Sentry organization can join and leave teams freely.
+	OpenMembership *bool `json:"openMembership,omitempty"`
+
+	// +optional
+	// Whether members of the Sentry organization are required to enable two-factor authentication.
+	Require2FA *bool `json:"require2FA,omitempty"`
+
+	// +optional
+	// Whether issues of the Sentry organization can be shared with anonymous users.
+	AllowSharedIssues *bool `json:"allowSharedIssues,omitempty"`
+
+	// +optional
+	// Whether sensitive details such as source code and event data should be hidden from alerts and notifications.
+	EnhancedPrivacy *bool `json:"enhancedPrivacy,omitempty"`
+
+	// +optional
+	// Whether data scrubbing should be enforced for all projects of the Sentry organization.
+	DataScrubber *bool `json:"dataScrubber,omitempty"`
+
+	// +optional
+	// Whether the default data scrubbers should be applied to all projects of the Sentry organization.
+	DataScrubberDefaults *bool `json:"dataScrubberDefaults,omitempty"`
+
+	// +optional
+	// Whether IP addresses should be stripped from events sent to all projects of the Sentry organization.
+	ScrubIPAddresses *bool `json:"scrubIPAddresses,omitempty"`
+
+	// +optional
+	// Field names that should be scrubbed from events sent to all projects of the Sentry organization. Set to an empty
+	// list to clear the sensitive fields.
+	SensitiveFields *[]string `json:"sensitiveFields,omitempty"`
+
+	// +optional
+	// Field names that should never be scrubbed from events sent to the projects of the Sentry organization. Set to an
+	// empty list to clear the safe fields.
+	SafeFields *[]string `json:"safeFields,omitempty"`
+
+	// +optional
+	// Whether JavaScript source files should be fetched to improve stack traces.
+	ScrapeJavaScript *bool `json:"scrapeJavaScript,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Synced;Planned;Error
+type OrganizationSettingsCondition string
+
+const (
+	OrganizationSettingsConditionSynced  OrganizationSettingsCondition = "Synced"
+	OrganizationSettingsConditionPlanned OrganizationSettingsCondition = "Planned"
+	OrganizationSettingsConditionError   OrganizationSettingsCondition = "Error"
+)
+
+// OrganizationSettingsStatus defines the observed state of OrganizationSettings.
+type OrganizationSettingsStatus struct {
+	// The state of the Sentry organization's settings.
+	// "Synced" indicates that the Sentry organization's settings match the spec.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry
+	// organization is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry organization's settings.
+	Condition OrganizationSettingsCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry organization's settings.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry organization.
+	ID string `json:"id,omitempty"`
+
+	// The slug of the Sentry organization.
+	Slug string `json:"slug,omitempty"`
+
+	// The name of the Sentry organization.
+	Name string `json:"name,omitempty"`
+
+	// The observed default role of the Sentry organization.
+	DefaultRole string `json:"defaultRole,omitempty"`
+
+	// Whether open membership is enabled for the Sentry organization.
+	OpenMembership bool `json:"openMembership,omitempty"`
+
+	// Whether two-factor authentication is required by the Sentry organization.
+	Require2FA bool `json:"require2FA,omitempty"`
+
+	// Whether issues of the Sentry organization can be shared with anonymous users.
+	AllowSharedIssues bool `json:"allowSharedIssues,omitempty"`
+
+	// Whether enhanced privacy is enabled for the Sentry organization.
+	EnhancedPrivacy bool `json:"enhancedPrivacy,omitempty"`
+
+	// Whether data scrubbing is enforced for the Sentry organization.
+	DataScrubber bool `json:"dataScrubber,omitempty"`
+
+	// Whether the default data scrubbers are applied for the Sentry organization.
+	DataScrubberDefaults bool `json:"dataScrubberDefaults,omitempty"`
+
+	// Whether IP addresses are scrubbed for the Sentry organization.
+	ScrubIPAddresses bool `json:"scrubIPAddresses,omitempty"`
+
+	// The observed sensitive fields of the Sentry organization.
+	SensitiveFields []string `json:"sensitiveFields,omitempty"`
+
+	// The observed safe fields of the Sentry organization.
+	SafeFields []string `json:"safeFields,omitempty"`
+
+	// Whether JavaScript source fetching is enabled for the Sentry organization.
+	ScrapeJavaScript bool `json:"scrapeJavaScript,omitempty"`
+
+	// The time that the Sentry organization's settings were last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// OrganizationSettings is the Schema for the organizationsettings API.
+type OrganizationSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSettingsSpec   `json:"spec,omitempty"`
+	Status OrganizationSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationSettingsList contains a list of OrganizationSettings.
+type OrganizationSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationSettings `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrganizationSettings{}, &OrganizationSettingsList{})
+}
@@ -32,10 +32,15 @@ import (
 
 // ProjectSpec defines the desired state of Project.
 type ProjectSpec struct {
-	// +kubebuilder:validation:MinLength=1
-	// +kubebuilder:validation:MaxLength=50
-	// Slug of the Sentry team that this project should be created under.
-	Team string `json:"team"`
+	// +optional
+	// Slugs of the Sentry teams that should have access to this project, of which there must be at least one including
+	// the deprecated team field. The project is created under the first team.
+	Teams []string `json:"teams,omitempty"`
+
+	// +optional
+	// Deprecated: use teams instead. Slug of a Sentry team that should have access to this project, which is merged
+	// into the teams field and takes precedence as the team the project is created under.
+	Team string `json:"team,omitempty"`
 
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=50
@@ -46,13 +51,159 @@ type ProjectSpec struct {
 	// +kubebuilder:validation:MaxLength=50
 	// Slug of the Sentry project.
 	Slug string `json:"slug"`
+
+	// +optional
+	// Platform of the Sentry project, such as "go" or "javascript".
+	Platform string `json:"platform,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=3600
+	// Minimum delay in seconds between digests of email notifications for the Sentry project.
+	DigestsMinDelay *int `json:"digestsMinDelay,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=3600
+	// Maximum delay in seconds between digests of email notifications for the Sentry project.
+	DigestsMaxDelay *int `json:"digestsMaxDelay,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:MaxLength=200
+	// Prefix of the subject of email notifications for the Sentry project.
+	SubjectPrefix *string `json:"subjectPrefix,omitempty"`
+
+	// +optional
+	// Template of the subject of email notifications for the Sentry project, such as "$shortID - $title".
+	SubjectTemplate *string `json:"subjectTemplate,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// Number of hours after which issues in the Sentry project are automatically resolved if they haven't been seen.
+	// Set to 0 to disable automatic resolution.
+	ResolveAge *int `json:"resolveAge,omitempty"`
+
+	// +optional
+	// Environment that is selected by default in the Sentry UI for the Sentry project.
+	DefaultEnvironment *string `json:"defaultEnvironment,omitempty"`
+
+	// +optional
+	// Domains that are allowed to send events to the Sentry project, such as "*.example.com" or "*". Set to an empty
+	// list to clear the allowed domains.
+	AllowedDomains *[]string `json:"allowedDomains,omitempty"`
+
+	// +optional
+	// Whether IP addresses should be stripped from events sent to the Sentry project.
+	ScrubIPAddresses *bool `json:"scrubIPAddresses,omitempty"`
+
+	// +optional
+	// Whether data scrubbing should be enabled for the Sentry project.
+	DataScrubber *bool `json:"dataScrubber,omitempty"`
+
+	// +optional
+	// Whether the default data scrubbers should be applied to the Sentry project.
+	DataScrubberDefaults *bool `json:"dataScrubberDefaults,omitempty"`
+
+	// +optional
+	// Additional field names that should be scrubbed from events sent to the Sentry project. Set to an empty list to
+	// clear the sensitive fields.
+	SensitiveFields *[]string `json:"sensitiveFields,omitempty"`
+
+	// +optional
+	// Environments of the Sentry project whose visibility should be managed. Environments that are not listed are left
+	// untouched.
+	Environments []ProjectEnvironment `json:"environments,omitempty"`
+
+	// +optional
+	// Inbound data filters of the Sentry project. When set, all of the filters below are managed, and filters that are
+	// not enabled here are disabled. When unset, the Sentry project's filters are left untouched.
+	InboundFilters *ProjectInboundFilters `json:"inboundFilters,omitempty"`
+
+	// +optional
+	// Whether spike protection should be enabled for the Sentry project, which limits the number of events accepted
+	// during sudden spikes in volume. When unset, the Sentry project's spike protection is left untouched.
+	SpikeProtection *bool `json:"spikeProtection,omitempty"`
+
+	// +optional
+	// Quotas on the number of events of each category that the Sentry project accepts. Categories that are not set are
+	// left untouched.
+	Quotas *ProjectQuotas `json:"quotas,omitempty"`
+}
+
+// ProjectQuotas are the per-category quotas of a Sentry project.
+type ProjectQuotas struct {
+	// +optional
+	// Quota on the number of errors accepted by the Sentry project.
+	Errors *ProjectQuota `json:"errors,omitempty"`
+
+	// +optional
+	// Quota on the number of transactions accepted by the Sentry project.
+	Transactions *ProjectQuota `json:"transactions,omitempty"`
+
+	// +optional
+	// Quota on the number of attachments accepted by the Sentry project.
+	Attachments *ProjectQuota `json:"attachments,omitempty"`
+}
+
+// ProjectQuota limits the number of events of a category that a Sentry project accepts within a window.
+type ProjectQuota struct {
+	// +kubebuilder:validation:Minimum=0
+	// Maximum number of events accepted within the window.
+	Limit int `json:"limit"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Length of the window in seconds.
+	Window int `json:"window"`
 }
 
-// +kubebuilder:validation:Enum=Created;Error
+// ProjectInboundFilters are the inbound data filters of a Sentry project, which drop matching events before they are
+// processed.
+type ProjectInboundFilters struct {
+	// +optional
+	// Whether errors known to be caused by browser extensions should be filtered out.
+	BrowserExtensions bool `json:"browserExtensions,omitempty"`
+
+	// +optional
+	// Whether events coming from localhost should be filtered out.
+	Localhost bool `json:"localhost,omitempty"`
+
+	// +optional
+	// Whether events coming from known web crawlers should be filtered out.
+	WebCrawlers bool `json:"webCrawlers,omitempty"`
+
+	// +optional
+	// Legacy browsers whose known errors should be filtered out.
+	LegacyBrowsers []ProjectLegacyBrowser `json:"legacyBrowsers,omitempty"`
+
+	// +optional
+	// Releases whose events should be filtered out. Glob patterns such as "1.0.*" are supported.
+	FilteredReleases []string `json:"filteredReleases,omitempty"`
+
+	// +optional
+	// Error messages whose events should be filtered out. Glob patterns such as "*TypeError*" are supported.
+	FilteredErrorMessages []string `json:"filteredErrorMessages,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=ie_pre_9;ie9;ie10;ie11;safari_pre_6;opera_pre_15;opera_mini_pre_8;android_pre_4;edge_pre_79
+type ProjectLegacyBrowser string
+
+// ProjectEnvironment is an environment of a Sentry project.
+type ProjectEnvironment struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name of the environment.
+	Name string `json:"name"`
+
+	// +optional
+	// Whether the environment should be hidden in the Sentry UI. Defaults to false.
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
 type ProjectCondition string
 
 const (
 	ProjectConditionCreated ProjectCondition = "Created"
+	ProjectConditionPlanned ProjectCondition = "Planned"
 	ProjectConditionError   ProjectCondition = "Error"
 )
 
@@ -60,6 +211,8 @@ const (
 type ProjectStatus struct {
 	// The state of the Sentry project.
 	// "Created" indicates that the Sentry project was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry project is
+	// described in the message.
 	// "Error" indicates that an error occurred while trying to reconcile the Sentry project.
 	Condition ProjectCondition `json:"condition,omitempty"`
 
@@ -69,6 +222,18 @@ type ProjectStatus struct {
 	// The ID of the Sentry project.
 	ID string `json:"id,omitempty"`
 
+	// Names of environments in our spec that don't exist in the Sentry project yet. Sentry creates an environment when
+	// it first receives an event for it, so these are checked periodically.
+	UnknownEnvironments []string `json:"unknownEnvironments,omitempty"`
+
+	// Whether spike protection is in effect for the Sentry project. Unset if the Sentry organization doesn't report
+	// spike protection for its projects.
+	SpikeProtection *bool `json:"spikeProtection,omitempty"`
+
+	// The quotas in effect for the Sentry project. Categories without a quota are left unset, and the quotas are unset
+	// altogether if the Sentry organization doesn't support per-category quotas.
+	Quotas *ProjectQuotas `json:"quotas,omitempty"`
+
 	// The time that the Sentry project was last successfully reconciled.
 	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
 }
@@ -43,11 +43,12 @@ type ProjectKeySpec struct {
 	Name string `json:"name"`
 }
 
-// +kubebuilder:validation:Enum=Created;Error
+// +kubebuilder:validation:Enum=Created;Planned;Error
 type ProjectKeyCondition string
 
 const (
 	ProjectKeyConditionCreated ProjectKeyCondition = "Created"
+	ProjectKeyConditionPlanned ProjectKeyCondition = "Planned"
 	ProjectKeyConditionError   ProjectKeyCondition = "Error"
 )
 
@@ -55,6 +56,8 @@ const (
 type ProjectKeyStatus struct {
 	// The state of the Sentry project key.
 	// "Created" indicates that the Sentry project key was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry project key is
+	// described in the message.
 	// "Error" indicates that an error occurred while trying to reconcile the Sentry project key.
 	Condition ProjectKeyCondition `json:"condition,omitempty"`
 
@@ -0,0 +1,140 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectOwnershipSpec defines the desired state of ProjectOwnership.
+type ProjectOwnershipSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// Slug of the Sentry project whose ownership rules are managed. This cannot be changed once the ownership rules have
+	// been synced.
+	Project string `json:"project"`
+
+	// +optional
+	// Ownership rules in Sentry's raw text format, such as "path:src/frontend/* #frontend". These are placed before any
+	// structured rules below.
+	Raw string `json:"raw,omitempty"`
+
+	// +optional
+	// Structured ownership rules, each mapping a pattern to its owners.
+	Rules []ProjectOwnershipRule `json:"rules,omitempty"`
+
+	// +optional
+	// Whether issues that don't match any rule should be routed to everyone in the Sentry project. Left untouched if
+	// unset.
+	Fallthrough *bool `json:"fallthrough,omitempty"`
+
+	// +optional
+	// Whether issues should be automatically assigned to their owners. Left untouched if unset.
+	AutoAssignment *bool `json:"autoAssignment,omitempty"`
+}
+
+// ProjectOwnershipRule maps the events matching a pattern to their owners.
+type ProjectOwnershipRule struct {
+	// +kubebuilder:validation:Enum=path;module;url
+	// Type of the pattern, matched against the file paths, modules or URLs of events.
+	Type string `json:"type"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Glob pattern of the rule, such as "src/frontend/*".
+	Pattern string `json:"pattern"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Owners of events matching the pattern.
+	Owners []ProjectOwner `json:"owners"`
+}
+
+// ProjectOwner is the owner of events matching an ownership rule, either a Team or a Sentry organization member.
+// Exactly one of its fields should be set.
+type ProjectOwner struct {
+	// +optional
+	// Name of a Team in the same namespace. The Team must have been created in Sentry.
+	Team string `json:"team,omitempty"`
+
+	// +optional
+	// Email of a member of the Sentry organization.
+	Email string `json:"email,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type ProjectOwnershipCondition string
+
+const (
+	ProjectOwnershipConditionCreated ProjectOwnershipCondition = "Created"
+	ProjectOwnershipConditionPlanned ProjectOwnershipCondition = "Planned"
+	ProjectOwnershipConditionError   ProjectOwnershipCondition = "Error"
+)
+
+// ProjectOwnershipStatus defines the observed state of ProjectOwnership.
+type ProjectOwnershipStatus struct {
+	// The state of the Sentry project's ownership rules.
+	// "Created" indicates that the ownership rules were synced successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the ownership rules
+	// is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the ownership rules.
+	Condition ProjectOwnershipCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the ownership rules.
+	Message string `json:"message,omitempty"`
+
+	// The slug of the Sentry project whose ownership rules are managed.
+	Project string `json:"project,omitempty"`
+
+	// The time that the ownership rules were last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.project`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// ProjectOwnership is the Schema for the projectownerships API.
+type ProjectOwnership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectOwnershipSpec   `json:"spec,omitempty"`
+	Status ProjectOwnershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectOwnershipList contains a list of ProjectOwnership.
+type ProjectOwnershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectOwnership `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProjectOwnership{}, &ProjectOwnershipList{})
+}
@@ -0,0 +1,144 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectPluginSpec defines the desired state of ProjectPlugin.
+type ProjectPluginSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// Slug of the Sentry project whose plugin is managed. This cannot be changed once the plugin has been synced.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MinLength=1
+	// ID of the Sentry plugin, such as "jira" or the "segment" and "splunk" data forwarding plugins. This cannot be
+	// changed once the plugin has been synced.
+	Plugin string `json:"plugin"`
+
+	// +optional
+	// Whether the plugin should be enabled for the Sentry project. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// +optional
+	// Configuration of the plugin. Fields that are not set here are cleared in Sentry whenever the configuration is
+	// updated, apart from secret fields which keep their saved value.
+	Config []ProjectPluginConfig `json:"config,omitempty"`
+}
+
+// ProjectPluginConfig is the value of a configuration field of a Sentry plugin. Exactly one of value or valueFrom
+// should be set.
+type ProjectPluginConfig struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name of the plugin's configuration field, such as "token".
+	Name string `json:"name"`
+
+	// +optional
+	// Plain value of the configuration field.
+	Value string `json:"value,omitempty"`
+
+	// +optional
+	// Source of the configuration field's value, for values that are sensitive.
+	ValueFrom *ProjectPluginConfigSource `json:"valueFrom,omitempty"`
+}
+
+// ProjectPluginConfigSource is the source of the value of a plugin's configuration field.
+type ProjectPluginConfigSource struct {
+	// Selects a key of a Secret in the same namespace. Changes to the Secret are applied to the plugin.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
+// +kubebuilder:validation:Enum=Synced;Planned;Error
+type ProjectPluginCondition string
+
+const (
+	ProjectPluginConditionSynced  ProjectPluginCondition = "Synced"
+	ProjectPluginConditionPlanned ProjectPluginCondition = "Planned"
+	ProjectPluginConditionError   ProjectPluginCondition = "Error"
+)
+
+// ProjectPluginStatus defines the observed state of ProjectPlugin.
+type ProjectPluginStatus struct {
+	// The state of the Sentry project's plugin.
+	// "Synced" indicates that the plugin was synced successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the plugin is
+	// described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the plugin.
+	Condition ProjectPluginCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the plugin.
+	Message string `json:"message,omitempty"`
+
+	// The slug of the Sentry project whose plugin is managed.
+	Project string `json:"project,omitempty"`
+
+	// The ID of the Sentry plugin that is managed.
+	Plugin string `json:"plugin,omitempty"`
+
+	// Whether the plugin is enabled for the Sentry project.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// A hash of the plugin's configuration that was last synced. Sentry doesn't return the values of secret fields, so
+	// this is used to detect changes to them. Values read from Secrets are hashed by the Secret's UID and resource
+	// version rather than by their value.
+	ConfigHash string `json:"configHash,omitempty"`
+
+	// The time that the plugin was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.project`
+// +kubebuilder:printcolumn:name="Plugin",type=string,JSONPath=`.spec.plugin`
+// +kubebuilder:printcolumn:name="Enabled",type=boolean,JSONPath=`.status.enabled`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// ProjectPlugin is the Schema for the projectplugins API.
+type ProjectPlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectPluginSpec   `json:"spec,omitempty"`
+	Status ProjectPluginStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectPluginList contains a list of ProjectPlugin.
+type ProjectPluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectPlugin `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProjectPlugin{}, &ProjectPluginList{})
+}
@@ -0,0 +1,149 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReleaseSpec defines the desired state of Release.
+type ReleaseSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// Version of the Sentry release, which is unique within the Sentry organization. This cannot be changed once the
+	// release has been created.
+	Version string `json:"version"`
+
+	// +kubebuilder:validation:MinItems=1
+	// Slugs of the Sentry projects that the release belongs to.
+	Projects []string `json:"projects"`
+
+	// +optional
+	// An optional commit reference, such as a Git SHA or tag, for the release.
+	Ref string `json:"ref,omitempty"`
+
+	// +optional
+	// An optional URL that points to the release, such as a page in a source control system.
+	URL string `json:"url,omitempty"`
+
+	// +optional
+	// Commit ranges of repositories integrated with the Sentry organization, used to associate commits with the release.
+	Refs []ReleaseRef `json:"refs,omitempty"`
+
+	// +optional
+	// Whether the release has been deployed and should be finalized. A finalized release cannot be unfinalized.
+	Finalized bool `json:"finalized,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// Whether the release should be deleted from the Sentry organization when the Release is deleted.
+	// "Delete" deletes the release from the Sentry organization. Sentry refuses to delete releases that have issues
+	// associated with them.
+	// "Retain" leaves the release in the Sentry organization. This is the default.
+	DeletionPolicy ReleaseDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+type ReleaseRef struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name of the repository, as configured in the Sentry organization's repository integrations.
+	Repository string `json:"repository"`
+
+	// +kubebuilder:validation:MinLength=1
+	// The commit at the head of the release.
+	Commit string `json:"commit"`
+
+	// +optional
+	// The commit at the head of the previous release. If unset, Sentry uses the commit of the previous release.
+	PreviousCommit string `json:"previousCommit,omitempty"`
+}
+
+type ReleaseDeletionPolicy string
+
+const (
+	ReleaseDeletionPolicyDelete ReleaseDeletionPolicy = "Delete"
+	ReleaseDeletionPolicyRetain ReleaseDeletionPolicy = "Retain"
+)
+
+// +kubebuilder:validation:Enum=Created;Finalized;Planned;Error
+type ReleaseCondition string
+
+const (
+	ReleaseConditionCreated   ReleaseCondition = "Created"
+	ReleaseConditionFinalized ReleaseCondition = "Finalized"
+	ReleaseConditionPlanned   ReleaseCondition = "Planned"
+	ReleaseConditionError     ReleaseCondition = "Error"
+)
+
+// ReleaseStatus defines the observed state of Release.
+type ReleaseStatus struct {
+	// The state of the Sentry release.
+	// "Created" indicates that the release was created successfully, but has yet to be finalized.
+	// "Finalized" indicates that the release has been finalized.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry release
+	// is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry release.
+	Condition ReleaseCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry release.
+	Message string `json:"message,omitempty"`
+
+	// The version of the Sentry release.
+	Version string `json:"version,omitempty"`
+
+	// The time that the Sentry release was finalized.
+	DateReleased *metav1.Time `json:"dateReleased,omitempty"`
+
+	// The time that the Sentry release was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.spec.version`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// Release is the Schema for the releases API.
+type Release struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReleaseSpec   `json:"spec,omitempty"`
+	Status ReleaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReleaseList contains a list of Release.
+type ReleaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Release `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Release{}, &ReleaseList{})
+}
@@ -0,0 +1,113 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SavedSearchSpec defines the desired state of SavedSearch.
+type SavedSearchSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// Name of the saved search.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Issue search query of the saved search, such as "is:unresolved is:unassigned".
+	Query string `json:"query"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=date;new;priority;freq;user
+	// Sort order of the issues matched by the saved search. Defaults to "date".
+	Sort string `json:"sort,omitempty"`
+
+	// +optional
+	// Slug of the Sentry project to scope the saved search to. If unset, the saved search is shared with the whole
+	// Sentry organization. This cannot be changed once the saved search has been created.
+	Project string `json:"project,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type SavedSearchCondition string
+
+const (
+	SavedSearchConditionCreated SavedSearchCondition = "Created"
+	SavedSearchConditionPlanned SavedSearchCondition = "Planned"
+	SavedSearchConditionError   SavedSearchCondition = "Error"
+)
+
+// SavedSearchStatus defines the observed state of SavedSearch.
+type SavedSearchStatus struct {
+	// The state of the Sentry saved search.
+	// "Created" indicates that the saved search was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the saved search is
+	// described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the saved search.
+	Condition SavedSearchCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the saved search.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry saved search.
+	ID string `json:"id,omitempty"`
+
+	// The slug of the Sentry project that the saved search is scoped to, if any.
+	Project string `json:"project,omitempty"`
+
+	// The time that the saved search was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.project`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// SavedSearch is the Schema for the savedsearches API.
+type SavedSearch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SavedSearchSpec   `json:"spec,omitempty"`
+	Status SavedSearchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SavedSearchList contains a list of SavedSearch.
+type SavedSearchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SavedSearch `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SavedSearch{}, &SavedSearchList{})
+}
@@ -0,0 +1,92 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SentryCredentialsSpec defines the desired state of SentryCredentials.
+type SentryCredentialsSpec struct {
+	// Reference to a Secret in the same namespace containing the Sentry organization, authentication token and
+	// optionally the Sentry URL, under the keys "SENTRY_ORGANIZATION", "SENTRY_TOKEN" and "SENTRY_URL" respectively.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
+
+// +kubebuilder:validation:Enum=Verified;Error
+type SentryCredentialsCondition string
+
+const (
+	SentryCredentialsConditionVerified SentryCredentialsCondition = "Verified"
+	SentryCredentialsConditionError    SentryCredentialsCondition = "Error"
+)
+
+// SentryCredentialsStatus defines the observed state of SentryCredentials.
+type SentryCredentialsStatus struct {
+	// The state of the Sentry credentials.
+	// "Verified" indicates that the Sentry credentials were used to access the Sentry organization successfully.
+	// "Error" indicates that an error occurred while trying to verify the Sentry credentials.
+	Condition SentryCredentialsCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to verify the Sentry credentials.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry organization that the Sentry credentials grant access to.
+	OrganizationID string `json:"organizationID,omitempty"`
+
+	// The time that the Sentry credentials were last successfully verified.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=sentrycredentials
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// SentryCredentials is the Schema for the sentrycredentials API.
+type SentryCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SentryCredentialsSpec   `json:"spec,omitempty"`
+	Status SentryCredentialsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SentryCredentialsList contains a list of SentryCredentials.
+type SentryCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SentryCredentials `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SentryCredentials{}, &SentryCredentialsList{})
+}
@@ -0,0 +1,113 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceHookSpec defines the desired state of ServiceHook.
+type ServiceHookSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50
+	// Slug of the Sentry project that this service hook should be created under.
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MinLength=1
+	// The URL that Sentry should send webhook requests to.
+	URL string `json:"url"`
+
+	// +kubebuilder:validation:MinItems=1
+	// The events that should trigger a webhook request.
+	Events []ServiceHookEvent `json:"events"`
+}
+
+// +kubebuilder:validation:Enum=event.alert;event.created
+type ServiceHookEvent string
+
+const (
+	ServiceHookEventAlert   ServiceHookEvent = "event.alert"
+	ServiceHookEventCreated ServiceHookEvent = "event.created"
+)
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
+type ServiceHookCondition string
+
+const (
+	ServiceHookConditionCreated ServiceHookCondition = "Created"
+	ServiceHookConditionPlanned ServiceHookCondition = "Planned"
+	ServiceHookConditionError   ServiceHookCondition = "Error"
+)
+
+// ServiceHookStatus defines the observed state of ServiceHook.
+type ServiceHookStatus struct {
+	// The state of the Sentry service hook.
+	// "Created" indicates that the Sentry service hook was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry service
+	// hook is described in the message.
+	// "Error" indicates that an error occurred while trying to reconcile the Sentry service hook.
+	Condition ServiceHookCondition `json:"condition,omitempty"`
+
+	// Additional detail about any errors that occurred while trying to reconcile the Sentry service hook.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the Sentry service hook.
+	ID string `json:"id,omitempty"`
+
+	// The time that the Sentry service hook was last successfully reconciled.
+	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
+
+	// The ID of the Sentry project that this service hook belongs to.
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.condition`
+
+// ServiceHook is the Schema for the servicehooks API.
+type ServiceHook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceHookSpec   `json:"spec,omitempty"`
+	Status ServiceHookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceHookList contains a list of ServiceHook.
+type ServiceHookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceHook `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceHook{}, &ServiceHookList{})
+}
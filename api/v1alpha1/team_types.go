@@ -41,13 +41,31 @@ type TeamSpec struct {
 	// +kubebuilder:validation:MaxLength=50
 	// Slug of the Sentry team.
 	Slug string `json:"slug"`
+
+	// +optional
+	// Members of the Sentry team, identified by the email of their Sentry organization member. If set, any other members
+	// of the Sentry team are removed from it.
+	Members []TeamMember `json:"members,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Created;Error
+// TeamMember defines a member of a Sentry team.
+type TeamMember struct {
+	// +kubebuilder:validation:MinLength=1
+	// Email of the Sentry organization member.
+	Email string `json:"email"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=contributor;admin
+	// Role of the member within the Sentry team. Defaults to "contributor".
+	Role string `json:"role,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Created;Planned;Error
 type TeamCondition string
 
 const (
 	TeamConditionCreated TeamCondition = "Created"
+	TeamConditionPlanned TeamCondition = "Planned"
 	TeamConditionError   TeamCondition = "Error"
 )
 
@@ -55,6 +73,8 @@ const (
 type TeamStatus struct {
 	// The state of the Sentry team.
 	// "Created" indicates that the Sentry team was created successfully.
+	// "Planned" indicates that the operator is running in dry-run mode, and the planned action for the Sentry team is
+	// described in the message.
 	// "Error" indicates that an error occurred while trying to reconcile the Sentry team.
 	Condition TeamCondition `json:"condition,omitempty"`
 
@@ -64,6 +84,13 @@ type TeamStatus struct {
 	// The ID of the Sentry team.
 	ID string `json:"id,omitempty"`
 
+	// Emails of members in our spec that are not members of the Sentry organization, and thus cannot be added to the
+	// Sentry team.
+	UnknownMembers []string `json:"unknownMembers,omitempty"`
+
+	// Emails of members in our spec that have yet to accept their invitation to the Sentry organization.
+	PendingMembers []string `json:"pendingMembers,omitempty"`
+
 	// The time that the Sentry team was last successfully reconciled.
 	LastSynced *metav1.Time `json:"lastSynced,omitempty"`
 }
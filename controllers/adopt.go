@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// adopt prepares the given Custom Resource for adopting an existing Sentry resource, by checking that its namespace is
+// allowed to adopt Sentry resources and adding our finalizer. The caller is expected to point its status at the Sentry
+// resource, which is only persisted once the Sentry resource has been successfully reconciled.
+func (s *Sentry) adopt(ctx context.Context, c client.Writer, obj finalizedObject, finalizer string, hasFinalizer bool) error {
+	if !s.allowsAdopt(obj.GetNamespace()) {
+		return fmt.Errorf("namespace %s is not allowed to adopt existing Sentry resources using the default Sentry credentials", obj.GetNamespace())
+	}
+
+	if !hasFinalizer {
+		obj.SetFinalizers(append(obj.GetFinalizers(), finalizer))
+		if err := c.Update(ctx, obj); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+// allowsAdopt reports whether resources in the given namespace are allowed to adopt existing Sentry resources, which
+// they could then modify or delete. Namespaces using their own SentryCredentials can adopt any resource of their own
+// Sentry organization, while namespaces falling back to the operator-wide Sentry organization must be explicitly allowed
+// to do so.
+func (s *Sentry) allowsAdopt(namespace string) bool {
+	if s.Credentials == nil || s.namespaced {
+		return true
+	}
+
+	return containsString(s.Credentials.AdoptNamespaces, namespace)
+}
@@ -0,0 +1,404 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	ArtifactUploadFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/artifactupload"
+
+	defaultArtifactUploadURLPrefix = "~/"
+)
+
+// ArtifactUploadReconciler reconciles a ArtifactUpload object
+type ArtifactUploadReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *ArtifactUploadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.ArtifactUpload{}).
+		// Apply changes to the ConfigMaps referenced by our source
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.uploadsForConfigMap),
+		}).
+		WithEventFilter(&referenceChangedPredicate{}).
+		Complete(r)
+}
+
+// uploadsForConfigMap maps a ConfigMap to the ArtifactUploads in its namespace whose source references it.
+func (r *ArtifactUploadReconciler) uploadsForConfigMap(obj handler.MapObject) []reconcile.Request {
+	var uploadList sentryv1alpha1.ArtifactUploadList
+	if err := r.List(context.Background(), &uploadList, client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list ArtifactUploads", "configmap", obj.Meta.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, upload := range uploadList.Items {
+		ref := upload.Spec.Source.ConfigMapRef
+		if ref != nil && ref.Name == obj.Meta.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: upload.Namespace, Name: upload.Name},
+			})
+		}
+	}
+
+	return requests
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=artifactuploads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=artifactuploads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *ArtifactUploadReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("artifactupload", req.NamespacedName)
+
+	var upload sentryv1alpha1.ArtifactUpload
+	if err := r.Get(ctx, req.NamespacedName, &upload); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch ArtifactUpload")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &upload, ArtifactUploadFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &upload, err)
+	}
+
+	hasFinalizer := containsFinalizer(upload.GetFinalizers(), ArtifactUploadFinalizerName)
+
+	// Attempt to delete the files that we have uploaded and remove our finalizer if we receive a delete request
+	if !upload.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &upload); err != nil {
+				log.Error(err, "failed to delete ArtifactUpload")
+				return ctrl.Result{}, r.handleError(ctx, &upload, err)
+			}
+		}
+
+		log.Info("successfully deleted ArtifactUpload")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between the files of our source and the files of our Sentry release
+	if err := r.handleUpload(ctx, sc, &upload, hasFinalizer); err != nil {
+		log.Error(err, "failed to upload ArtifactUpload")
+		return ctrl.Result{}, r.handleError(ctx, &upload, err)
+	}
+
+	log.Info("successfully uploaded ArtifactUpload")
+
+	return ctrl.Result{}, nil
+}
+
+// handleUpload uploads the files of our source that are missing from or have changed in our Sentry release, and
+// deletes the files that we have previously uploaded but are no longer part of our source. Files of the release that
+// were not uploaded by us are left untouched, and a file of our source that conflicts with one of them is reported as
+// an error.
+func (r *ArtifactUploadReconciler) handleUpload(ctx context.Context, sc *Sentry, upload *sentryv1alpha1.ArtifactUpload, hasFinalizer bool) error {
+	files, err := r.artifactFiles(ctx, upload)
+	if err != nil {
+		return err
+	}
+
+	existing, err := r.getExistingFiles(sc, upload.Spec.Project, upload.Spec.Release, upload.Spec.Dist)
+	if err != nil {
+		return err
+	}
+
+	// Files that we previously uploaded to another release are left in place, so that events of that release can still
+	// be processed using them
+	previous := make(map[string]sentryv1alpha1.ArtifactUploadFileStatus)
+	if upload.Status.Project == upload.Spec.Project && upload.Status.Release == upload.Spec.Release && upload.Status.Dist == upload.Spec.Dist {
+		for _, file := range upload.Status.Files {
+			previous[file.Name] = file
+		}
+	}
+
+	uploaded := make([]sentryv1alpha1.ArtifactUploadFileStatus, 0, len(files))
+	for _, file := range files {
+		checksum := sha1.Sum(file.content)
+		hash := hex.EncodeToString(checksum[:])
+
+		sFile, ok := existing[file.name]
+		if ok && sFile.SHA1 == hash {
+			uploaded = append(uploaded, sentryv1alpha1.ArtifactUploadFileStatus{Name: file.name, ID: sFile.ID, SHA1: hash})
+			delete(previous, file.name)
+			continue
+		}
+
+		// Sentry rejects files with a name that already exists in the release, so the changed file has to be replaced,
+		// unless it was uploaded by other means, such as CI, in which case it's left untouched
+		if ok {
+			if owned, isOwned := previous[file.name]; !isOwned || owned.ID != sFile.ID {
+				return fmt.Errorf("file %s already exists in Sentry release %s and was not uploaded by ArtifactUpload %s", file.name, upload.Spec.Release, upload.Name)
+			}
+
+			resp, err := sc.Client.Releases.DeleteFile(sc.Organization, upload.Spec.Project, upload.Spec.Release, sFile.ID)
+			if err != nil && resp.StatusCode != http.StatusNotFound {
+				return releaseFileError(resp, err)
+			}
+		}
+
+		params := &sentry.UploadReleaseFileParams{
+			Content: file.content,
+			Dist:    upload.Spec.Dist,
+			Name:    file.name,
+		}
+
+		created, resp, err := sc.Client.Releases.UploadFile(sc.Organization, upload.Spec.Project, upload.Spec.Release, params)
+		if err != nil {
+			return releaseFileError(resp, err)
+		}
+
+		uploaded = append(uploaded, sentryv1alpha1.ArtifactUploadFileStatus{Name: created.Name, ID: created.ID, SHA1: created.SHA1})
+		delete(previous, file.name)
+	}
+
+	// Delete the files that we previously uploaded but are no longer part of our source
+	for _, file := range previous {
+		resp, err := sc.Client.Releases.DeleteFile(sc.Organization, upload.Status.Project, upload.Status.Release, file.ID)
+		if err != nil && resp.StatusCode != http.StatusNotFound {
+			return releaseFileError(resp, err)
+		}
+	}
+
+	upload.Status.Condition = sentryv1alpha1.ArtifactUploadConditionUploaded
+	upload.Status.Message = ""
+	upload.Status.Project = upload.Spec.Project
+	upload.Status.Release = upload.Spec.Release
+	upload.Status.Dist = upload.Spec.Dist
+	upload.Status.Files = uploaded
+	upload.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, upload); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		upload.SetFinalizers(append(upload.GetFinalizers(), ArtifactUploadFinalizerName))
+		if err := r.Update(ctx, upload); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *ArtifactUploadReconciler) handleDelete(ctx context.Context, sc *Sentry, upload *sentryv1alpha1.ArtifactUpload) error {
+	for _, file := range upload.Status.Files {
+		resp, err := sc.Client.Releases.DeleteFile(sc.Organization, upload.Status.Project, upload.Status.Release, file.ID)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our Sentry release might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	upload.SetFinalizers(removeFinalizer(upload.GetFinalizers(), ArtifactUploadFinalizerName))
+	if err := r.Update(ctx, upload); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// getExistingFiles retrieves the files of our Sentry release's distribution, keyed by their name.
+func (r *ArtifactUploadReconciler) getExistingFiles(sc *Sentry, project, release, dist string) (map[string]sentry.ReleaseFile, error) {
+	opts := &sentry.ListOptions{}
+	existing := make(map[string]sentry.ReleaseFile)
+	for {
+		files, resp, err := sc.Client.Releases.ListFiles(sc.Organization, project, release, opts)
+		if err != nil {
+			return nil, releaseFileError(resp, err)
+		}
+
+		for _, file := range files {
+			if file.Dist == dist {
+				existing[file.Name] = file
+			}
+		}
+
+		if !resp.NextPage.Results {
+			break
+		}
+		opts.Cursor = resp.NextPage.Cursor
+	}
+
+	return existing, nil
+}
+
+type artifactFile struct {
+	name    string
+	content []byte
+}
+
+// artifactFiles reads the files to be uploaded from our source, naming them after our URL prefix.
+func (r *ArtifactUploadReconciler) artifactFiles(ctx context.Context, upload *sentryv1alpha1.ArtifactUpload) ([]artifactFile, error) {
+	ref := upload.Spec.Source.ConfigMapRef
+	if ref == nil {
+		return nil, fmt.Errorf("source must set configMapRef")
+	}
+
+	var configMap corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: upload.Namespace}, &configMap); err != nil {
+		// Retry as the error might get resolved once the ConfigMap is created
+		return nil, retryableError{fmt.Errorf("failed to fetch ConfigMap for ArtifactUpload %s: %w", upload.Name, err)}
+	}
+
+	contents := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+	for key, value := range configMap.Data {
+		contents[key] = []byte(value)
+	}
+	for key, value := range configMap.BinaryData {
+		contents[key] = value
+	}
+
+	selected := upload.Spec.Files
+	if len(selected) == 0 {
+		keys := make([]string, 0, len(contents))
+		for key := range contents {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			selected = append(selected, sentryv1alpha1.ArtifactUploadFile{Key: key})
+		}
+	}
+
+	prefix := defaultArtifactUploadURLPrefix
+	if upload.Spec.URLPrefix != nil {
+		prefix = *upload.Spec.URLPrefix
+	}
+
+	files := make([]artifactFile, len(selected))
+	for idx, file := range selected {
+		content, ok := contents[file.Key]
+		if !ok {
+			return nil, fmt.Errorf("ConfigMap %s is missing required key %s", configMap.Name, file.Key)
+		}
+
+		name := file.Name
+		if name == "" {
+			name = file.Key
+		}
+
+		files[idx] = artifactFile{name: prefix + name, content: content}
+	}
+
+	return files, nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *ArtifactUploadReconciler) handleError(ctx context.Context, upload *sentryv1alpha1.ArtifactUpload, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(upload, corev1.EventTypeNormal, "DryRun", de.Error())
+		upload.Status.Condition = sentryv1alpha1.ArtifactUploadConditionPlanned
+		upload.Status.Message = de.Error()
+		return r.Status().Update(ctx, upload)
+	}
+
+	upload.Status.Condition = sentryv1alpha1.ArtifactUploadConditionError
+	upload.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, upload); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// releaseFileError wraps errors from requests against the files of a Sentry release, retrying on 5XX and 404 errors.
+func releaseFileError(resp *sentry.Response, err error) error {
+	switch {
+	case resp.StatusCode >= 500:
+		return retryableError{err}
+	case resp.StatusCode == http.StatusNotFound:
+		// Retry on 404 errors as our Sentry release might not have been created yet
+		return retryableError{err}
+	default:
+		// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+		return err
+	}
+}
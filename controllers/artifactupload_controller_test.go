@@ -0,0 +1,227 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("ArtifactUploadReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		uploadName      = "test-artifactupload"
+		uploadNamespace = "test-artifactupload-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		upload    *sentryv1alpha1.ArtifactUpload
+	)
+
+	ctx := context.Background()
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-artifactupload-sourcemaps",
+			Namespace: uploadNamespace,
+		},
+		Data: map[string]string{
+			"main.js.map": `{"version":3,"file":"main.js","mappings":""}`,
+		},
+	}
+
+	request := &sentryv1alpha1.ArtifactUpload{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "ArtifactUpload",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      uploadName,
+			Namespace: uploadNamespace,
+		},
+		Spec: sentryv1alpha1.ArtifactUploadSpec{
+			Project: "test-project",
+			Release: "1.0.0",
+			Source: sentryv1alpha1.ArtifactUploadSource{
+				ConfigMapRef: &corev1.LocalObjectReference{Name: "test-artifactupload-sourcemaps"},
+			},
+			Files: []sentryv1alpha1.ArtifactUploadFile{
+				{Key: "main.js.map", Name: "static/js/main.js.map"},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: uploadName, Namespace: uploadNamespace}
+		upload = new(sentryv1alpha1.ArtifactUpload)
+	})
+
+	Context("when creating an ArtifactUpload", func() {
+		BeforeEach(func() {
+			fakeSentryReleases.ListFilesReturns([]sentry.ReleaseFile{}, newSentryResponse(http.StatusOK), nil)
+			fakeSentryReleases.UploadFileReturns(testSentryReleaseFile("1", "~/static/js/main.js.map", configMap.Data["main.js.map"]), newSentryResponse(http.StatusCreated), nil)
+		})
+
+		It("the ArtifactUpload gets uploaded successfully", func() {
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.ArtifactUploadStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, upload)
+				if err != nil {
+					return nil, err
+				}
+				return &upload.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ArtifactUploadConditionUploaded),
+					"Message":   BeEmpty(),
+					"Project":   Equal("test-project"),
+					"Release":   Equal("1.0.0"),
+					"Files": ConsistOf(MatchFields(IgnoreExtras, Fields{
+						"Name": Equal("~/static/js/main.js.map"),
+						"ID":   Equal("1"),
+					})),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Eventually(func() ([]string, error) {
+				err := k8sClient.Get(ctx, lookupKey, upload)
+				return upload.Finalizers, err
+			}, timeout, interval).Should(ContainElement(controllers.ArtifactUploadFinalizerName))
+
+			By("invoked the Sentry client's .Releases.UploadFile method")
+			organizationSlug, projectSlug, version, params := fakeSentryReleases.UploadFileArgsForCall(fakeSentryReleases.UploadFileCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(version).To(Equal("1.0.0"))
+			Expect(params).To(Equal(&sentry.UploadReleaseFileParams{
+				Content: []byte(configMap.Data["main.js.map"]),
+				Name:    "~/static/js/main.js.map",
+			}))
+		})
+	})
+
+	Context("when updating the ConfigMap of an ArtifactUpload", func() {
+		BeforeEach(func() {
+			// The file of the release was replaced by other means, such as CI
+			existing := testSentryReleaseFile("2", "~/static/js/main.js.map", `{"version":3,"file":"main.js","mappings":"AAAA"}`)
+			fakeSentryReleases.ListFilesReturns([]sentry.ReleaseFile{*existing}, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the ArtifactUpload fails to replace a file that it didn't upload", func() {
+			deleted := fakeSentryReleases.DeleteFileCallCount()
+
+			updated := new(corev1.ConfigMap)
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: configMap.Name, Namespace: uploadNamespace}, updated)).To(Succeed())
+
+			updated.Data["main.js.map"] = `{"version":3,"file":"main.js","mappings":"AACA"}`
+			Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+
+			Eventually(func() (*sentryv1alpha1.ArtifactUploadStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, upload)
+				if err != nil {
+					return nil, err
+				}
+				return &upload.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ArtifactUploadConditionError),
+					"Message":   Equal("file ~/static/js/main.js.map already exists in Sentry release 1.0.0 and was not uploaded by ArtifactUpload test-artifactupload"),
+				})),
+			)
+
+			By("did not invoke the Sentry client's .Releases.DeleteFile method")
+			Expect(fakeSentryReleases.DeleteFileCallCount()).To(Equal(deleted))
+		})
+	})
+
+	Context("when updating an ArtifactUpload", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, upload)).To(Succeed())
+
+			upload.Spec.Files[0].Key = "missing"
+		})
+
+		It("the ArtifactUpload fails to reference a ConfigMap key that doesn't exist", func() {
+			Expect(k8sClient.Update(ctx, upload)).To(Succeed())
+
+			Eventually(func() (*sentryv1alpha1.ArtifactUploadStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, upload)
+				if err != nil {
+					return nil, err
+				}
+				return &upload.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ArtifactUploadConditionError),
+					"Message":   Equal("ConfigMap test-artifactupload-sourcemaps is missing required key missing"),
+				})),
+			)
+		})
+	})
+
+	Context("when deleting an ArtifactUpload", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, upload)).To(Succeed())
+
+			fakeSentryReleases.DeleteFileReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the ArtifactUpload gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, upload)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, upload)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Releases.DeleteFile method")
+			organizationSlug, projectSlug, version, fileID := fakeSentryReleases.DeleteFileArgsForCall(fakeSentryReleases.DeleteFileCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(version).To(Equal("1.0.0"))
+			Expect(fileID).To(Equal("1"))
+		})
+	})
+})
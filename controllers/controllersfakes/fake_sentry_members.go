@@ -0,0 +1,718 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package controllersfakes
+
+import (
+	"sync"
+
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+type FakeSentryMembers struct {
+	AddTeamStub        func(string, string, string) (*sentry.Team, *sentry.Response, error)
+	addTeamMutex       sync.RWMutex
+	addTeamArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	addTeamReturns struct {
+		result1 *sentry.Team
+		result2 *sentry.Response
+		result3 error
+	}
+	addTeamReturnsOnCall map[int]struct {
+		result1 *sentry.Team
+		result2 *sentry.Response
+		result3 error
+	}
+	DeleteStub        func(string, string) (*sentry.Response, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	GetStub        func(string, string) (*sentry.Member, *sentry.Response, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getReturns struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	InviteStub        func(string, *sentry.InviteMemberParams) (*sentry.Member, *sentry.Response, error)
+	inviteMutex       sync.RWMutex
+	inviteArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.InviteMemberParams
+	}
+	inviteReturns struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	inviteReturnsOnCall map[int]struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	ListStub        func(string, *sentry.ListOptions) ([]sentry.Member, *sentry.Response, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.ListOptions
+	}
+	listReturns struct {
+		result1 []sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	RemoveTeamStub        func(string, string, string) (*sentry.Response, error)
+	removeTeamMutex       sync.RWMutex
+	removeTeamArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	removeTeamReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	removeTeamReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	UpdateStub        func(string, string, *sentry.UpdateMemberParams) (*sentry.Member, *sentry.Response, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.UpdateMemberParams
+	}
+	updateReturns struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateTeamStub        func(string, string, string, *sentry.UpdateMemberTeamParams) (*sentry.MemberTeam, *sentry.Response, error)
+	updateTeamMutex       sync.RWMutex
+	updateTeamArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateMemberTeamParams
+	}
+	updateTeamReturns struct {
+		result1 *sentry.MemberTeam
+		result2 *sentry.Response
+		result3 error
+	}
+	updateTeamReturnsOnCall map[int]struct {
+		result1 *sentry.MemberTeam
+		result2 *sentry.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSentryMembers) AddTeam(arg1 string, arg2 string, arg3 string) (*sentry.Team, *sentry.Response, error) {
+	fake.addTeamMutex.Lock()
+	ret, specificReturn := fake.addTeamReturnsOnCall[len(fake.addTeamArgsForCall)]
+	fake.addTeamArgsForCall = append(fake.addTeamArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AddTeam", []interface{}{arg1, arg2, arg3})
+	fake.addTeamMutex.Unlock()
+	if fake.AddTeamStub != nil {
+		return fake.AddTeamStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.addTeamReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMembers) AddTeamCallCount() int {
+	fake.addTeamMutex.RLock()
+	defer fake.addTeamMutex.RUnlock()
+	return len(fake.addTeamArgsForCall)
+}
+
+func (fake *FakeSentryMembers) AddTeamCalls(stub func(string, string, string) (*sentry.Team, *sentry.Response, error)) {
+	fake.addTeamMutex.Lock()
+	defer fake.addTeamMutex.Unlock()
+	fake.AddTeamStub = stub
+}
+
+func (fake *FakeSentryMembers) AddTeamArgsForCall(i int) (string, string, string) {
+	fake.addTeamMutex.RLock()
+	defer fake.addTeamMutex.RUnlock()
+	argsForCall := fake.addTeamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryMembers) AddTeamReturns(result1 *sentry.Team, result2 *sentry.Response, result3 error) {
+	fake.addTeamMutex.Lock()
+	defer fake.addTeamMutex.Unlock()
+	fake.AddTeamStub = nil
+	fake.addTeamReturns = struct {
+		result1 *sentry.Team
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) AddTeamReturnsOnCall(i int, result1 *sentry.Team, result2 *sentry.Response, result3 error) {
+	fake.addTeamMutex.Lock()
+	defer fake.addTeamMutex.Unlock()
+	fake.AddTeamStub = nil
+	if fake.addTeamReturnsOnCall == nil {
+		fake.addTeamReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Team
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.addTeamReturnsOnCall[i] = struct {
+		result1 *sentry.Team
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) Delete(arg1 string, arg2 string) (*sentry.Response, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryMembers) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeSentryMembers) DeleteCalls(stub func(string, string) (*sentry.Response, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeSentryMembers) DeleteArgsForCall(i int) (string, string) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryMembers) DeleteReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryMembers) DeleteReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryMembers) Get(arg1 string, arg2 string) (*sentry.Member, *sentry.Response, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMembers) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSentryMembers) GetCalls(stub func(string, string) (*sentry.Member, *sentry.Response, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSentryMembers) GetArgsForCall(i int) (string, string) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryMembers) GetReturns(result1 *sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) GetReturnsOnCall(i int, result1 *sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Member
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) Invite(arg1 string, arg2 *sentry.InviteMemberParams) (*sentry.Member, *sentry.Response, error) {
+	fake.inviteMutex.Lock()
+	ret, specificReturn := fake.inviteReturnsOnCall[len(fake.inviteArgsForCall)]
+	fake.inviteArgsForCall = append(fake.inviteArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.InviteMemberParams
+	}{arg1, arg2})
+	fake.recordInvocation("Invite", []interface{}{arg1, arg2})
+	fake.inviteMutex.Unlock()
+	if fake.InviteStub != nil {
+		return fake.InviteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.inviteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMembers) InviteCallCount() int {
+	fake.inviteMutex.RLock()
+	defer fake.inviteMutex.RUnlock()
+	return len(fake.inviteArgsForCall)
+}
+
+func (fake *FakeSentryMembers) InviteCalls(stub func(string, *sentry.InviteMemberParams) (*sentry.Member, *sentry.Response, error)) {
+	fake.inviteMutex.Lock()
+	defer fake.inviteMutex.Unlock()
+	fake.InviteStub = stub
+}
+
+func (fake *FakeSentryMembers) InviteArgsForCall(i int) (string, *sentry.InviteMemberParams) {
+	fake.inviteMutex.RLock()
+	defer fake.inviteMutex.RUnlock()
+	argsForCall := fake.inviteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryMembers) InviteReturns(result1 *sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.inviteMutex.Lock()
+	defer fake.inviteMutex.Unlock()
+	fake.InviteStub = nil
+	fake.inviteReturns = struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) InviteReturnsOnCall(i int, result1 *sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.inviteMutex.Lock()
+	defer fake.inviteMutex.Unlock()
+	fake.InviteStub = nil
+	if fake.inviteReturnsOnCall == nil {
+		fake.inviteReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Member
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.inviteReturnsOnCall[i] = struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) List(arg1 string, arg2 *sentry.ListOptions) ([]sentry.Member, *sentry.Response, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.ListOptions
+	}{arg1, arg2})
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMembers) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeSentryMembers) ListCalls(stub func(string, *sentry.ListOptions) ([]sentry.Member, *sentry.Response, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeSentryMembers) ListArgsForCall(i int) (string, *sentry.ListOptions) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryMembers) ListReturns(result1 []sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) ListReturnsOnCall(i int, result1 []sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []sentry.Member
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) RemoveTeam(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
+	fake.removeTeamMutex.Lock()
+	ret, specificReturn := fake.removeTeamReturnsOnCall[len(fake.removeTeamArgsForCall)]
+	fake.removeTeamArgsForCall = append(fake.removeTeamArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RemoveTeam", []interface{}{arg1, arg2, arg3})
+	fake.removeTeamMutex.Unlock()
+	if fake.RemoveTeamStub != nil {
+		return fake.RemoveTeamStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeTeamReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryMembers) RemoveTeamCallCount() int {
+	fake.removeTeamMutex.RLock()
+	defer fake.removeTeamMutex.RUnlock()
+	return len(fake.removeTeamArgsForCall)
+}
+
+func (fake *FakeSentryMembers) RemoveTeamCalls(stub func(string, string, string) (*sentry.Response, error)) {
+	fake.removeTeamMutex.Lock()
+	defer fake.removeTeamMutex.Unlock()
+	fake.RemoveTeamStub = stub
+}
+
+func (fake *FakeSentryMembers) RemoveTeamArgsForCall(i int) (string, string, string) {
+	fake.removeTeamMutex.RLock()
+	defer fake.removeTeamMutex.RUnlock()
+	argsForCall := fake.removeTeamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryMembers) RemoveTeamReturns(result1 *sentry.Response, result2 error) {
+	fake.removeTeamMutex.Lock()
+	defer fake.removeTeamMutex.Unlock()
+	fake.RemoveTeamStub = nil
+	fake.removeTeamReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryMembers) RemoveTeamReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.removeTeamMutex.Lock()
+	defer fake.removeTeamMutex.Unlock()
+	fake.RemoveTeamStub = nil
+	if fake.removeTeamReturnsOnCall == nil {
+		fake.removeTeamReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.removeTeamReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryMembers) Update(arg1 string, arg2 string, arg3 *sentry.UpdateMemberParams) (*sentry.Member, *sentry.Response, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.UpdateMemberParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMembers) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeSentryMembers) UpdateCalls(stub func(string, string, *sentry.UpdateMemberParams) (*sentry.Member, *sentry.Response, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeSentryMembers) UpdateArgsForCall(i int) (string, string, *sentry.UpdateMemberParams) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryMembers) UpdateReturns(result1 *sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) UpdateReturnsOnCall(i int, result1 *sentry.Member, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Member
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *sentry.Member
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) UpdateTeam(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateMemberTeamParams) (*sentry.MemberTeam, *sentry.Response, error) {
+	fake.updateTeamMutex.Lock()
+	ret, specificReturn := fake.updateTeamReturnsOnCall[len(fake.updateTeamArgsForCall)]
+	fake.updateTeamArgsForCall = append(fake.updateTeamArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateMemberTeamParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateTeam", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateTeamMutex.Unlock()
+	if fake.UpdateTeamStub != nil {
+		return fake.UpdateTeamStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateTeamReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMembers) UpdateTeamCallCount() int {
+	fake.updateTeamMutex.RLock()
+	defer fake.updateTeamMutex.RUnlock()
+	return len(fake.updateTeamArgsForCall)
+}
+
+func (fake *FakeSentryMembers) UpdateTeamCalls(stub func(string, string, string, *sentry.UpdateMemberTeamParams) (*sentry.MemberTeam, *sentry.Response, error)) {
+	fake.updateTeamMutex.Lock()
+	defer fake.updateTeamMutex.Unlock()
+	fake.UpdateTeamStub = stub
+}
+
+func (fake *FakeSentryMembers) UpdateTeamArgsForCall(i int) (string, string, string, *sentry.UpdateMemberTeamParams) {
+	fake.updateTeamMutex.RLock()
+	defer fake.updateTeamMutex.RUnlock()
+	argsForCall := fake.updateTeamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryMembers) UpdateTeamReturns(result1 *sentry.MemberTeam, result2 *sentry.Response, result3 error) {
+	fake.updateTeamMutex.Lock()
+	defer fake.updateTeamMutex.Unlock()
+	fake.UpdateTeamStub = nil
+	fake.updateTeamReturns = struct {
+		result1 *sentry.MemberTeam
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) UpdateTeamReturnsOnCall(i int, result1 *sentry.MemberTeam, result2 *sentry.Response, result3 error) {
+	fake.updateTeamMutex.Lock()
+	defer fake.updateTeamMutex.Unlock()
+	fake.UpdateTeamStub = nil
+	if fake.updateTeamReturnsOnCall == nil {
+		fake.updateTeamReturnsOnCall = make(map[int]struct {
+			result1 *sentry.MemberTeam
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateTeamReturnsOnCall[i] = struct {
+		result1 *sentry.MemberTeam
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMembers) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addTeamMutex.RLock()
+	defer fake.addTeamMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.inviteMutex.RLock()
+	defer fake.inviteMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.removeTeamMutex.RLock()
+	defer fake.removeTeamMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateTeamMutex.RLock()
+	defer fake.updateTeamMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSentryMembers) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.SentryMembers = new(FakeSentryMembers)
@@ -0,0 +1,375 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package controllersfakes
+
+import (
+	"sync"
+
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+type FakeSentryMetricAlertRules struct {
+	CreateStub        func(string, *sentry.CreateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.CreateMetricAlertRuleParams
+	}
+	createReturns struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	DeleteStub        func(string, string) (*sentry.Response, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	GetStub        func(string, string) (*sentry.MetricAlertRule, *sentry.Response, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getReturns struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateStub        func(string, string, *sentry.UpdateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.UpdateMetricAlertRuleParams
+	}
+	updateReturns struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSentryMetricAlertRules) Create(arg1 string, arg2 *sentry.CreateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.CreateMetricAlertRuleParams
+	}{arg1, arg2})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMetricAlertRules) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeSentryMetricAlertRules) CreateCalls(stub func(string, *sentry.CreateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeSentryMetricAlertRules) CreateArgsForCall(i int) (string, *sentry.CreateMetricAlertRuleParams) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryMetricAlertRules) CreateReturns(result1 *sentry.MetricAlertRule, result2 *sentry.Response, result3 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMetricAlertRules) CreateReturnsOnCall(i int, result1 *sentry.MetricAlertRule, result2 *sentry.Response, result3 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 *sentry.MetricAlertRule
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMetricAlertRules) Delete(arg1 string, arg2 string) (*sentry.Response, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryMetricAlertRules) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeSentryMetricAlertRules) DeleteCalls(stub func(string, string) (*sentry.Response, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeSentryMetricAlertRules) DeleteArgsForCall(i int) (string, string) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryMetricAlertRules) DeleteReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryMetricAlertRules) DeleteReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryMetricAlertRules) Get(arg1 string, arg2 string) (*sentry.MetricAlertRule, *sentry.Response, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMetricAlertRules) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSentryMetricAlertRules) GetCalls(stub func(string, string) (*sentry.MetricAlertRule, *sentry.Response, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSentryMetricAlertRules) GetArgsForCall(i int) (string, string) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryMetricAlertRules) GetReturns(result1 *sentry.MetricAlertRule, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMetricAlertRules) GetReturnsOnCall(i int, result1 *sentry.MetricAlertRule, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *sentry.MetricAlertRule
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMetricAlertRules) Update(arg1 string, arg2 string, arg3 *sentry.UpdateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.UpdateMetricAlertRuleParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryMetricAlertRules) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeSentryMetricAlertRules) UpdateCalls(stub func(string, string, *sentry.UpdateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeSentryMetricAlertRules) UpdateArgsForCall(i int) (string, string, *sentry.UpdateMetricAlertRuleParams) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryMetricAlertRules) UpdateReturns(result1 *sentry.MetricAlertRule, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMetricAlertRules) UpdateReturnsOnCall(i int, result1 *sentry.MetricAlertRule, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *sentry.MetricAlertRule
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *sentry.MetricAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryMetricAlertRules) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSentryMetricAlertRules) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.SentryMetricAlertRules = new(FakeSentryMetricAlertRules)
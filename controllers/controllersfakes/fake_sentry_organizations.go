@@ -9,6 +9,49 @@ import (
 )
 
 type FakeSentryOrganizations struct {
+	DisableSpikeProtectionStub        func(string, *sentry.SpikeProtectionParams) (*sentry.Response, error)
+	disableSpikeProtectionMutex       sync.RWMutex
+	disableSpikeProtectionArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.SpikeProtectionParams
+	}
+	disableSpikeProtectionReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	disableSpikeProtectionReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	EnableSpikeProtectionStub        func(string, *sentry.SpikeProtectionParams) (*sentry.Response, error)
+	enableSpikeProtectionMutex       sync.RWMutex
+	enableSpikeProtectionArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.SpikeProtectionParams
+	}
+	enableSpikeProtectionReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	enableSpikeProtectionReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	GetStub        func(string) (*sentry.Organization, *sentry.Response, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getReturns struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}
 	ListProjectsStub        func(string, *sentry.ListOptions) ([]sentry.Project, *sentry.Response, error)
 	listProjectsMutex       sync.RWMutex
 	listProjectsArgsForCall []struct {
@@ -25,10 +68,220 @@ type FakeSentryOrganizations struct {
 		result2 *sentry.Response
 		result3 error
 	}
+	UpdateStub        func(string, *sentry.UpdateOrganizationParams) (*sentry.Organization, *sentry.Response, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.UpdateOrganizationParams
+	}
+	updateReturns struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSentryOrganizations) DisableSpikeProtection(arg1 string, arg2 *sentry.SpikeProtectionParams) (*sentry.Response, error) {
+	fake.disableSpikeProtectionMutex.Lock()
+	ret, specificReturn := fake.disableSpikeProtectionReturnsOnCall[len(fake.disableSpikeProtectionArgsForCall)]
+	fake.disableSpikeProtectionArgsForCall = append(fake.disableSpikeProtectionArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.SpikeProtectionParams
+	}{arg1, arg2})
+	fake.recordInvocation("DisableSpikeProtection", []interface{}{arg1, arg2})
+	fake.disableSpikeProtectionMutex.Unlock()
+	if fake.DisableSpikeProtectionStub != nil {
+		return fake.DisableSpikeProtectionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.disableSpikeProtectionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryOrganizations) DisableSpikeProtectionCallCount() int {
+	fake.disableSpikeProtectionMutex.RLock()
+	defer fake.disableSpikeProtectionMutex.RUnlock()
+	return len(fake.disableSpikeProtectionArgsForCall)
+}
+
+func (fake *FakeSentryOrganizations) DisableSpikeProtectionCalls(stub func(string, *sentry.SpikeProtectionParams) (*sentry.Response, error)) {
+	fake.disableSpikeProtectionMutex.Lock()
+	defer fake.disableSpikeProtectionMutex.Unlock()
+	fake.DisableSpikeProtectionStub = stub
+}
+
+func (fake *FakeSentryOrganizations) DisableSpikeProtectionArgsForCall(i int) (string, *sentry.SpikeProtectionParams) {
+	fake.disableSpikeProtectionMutex.RLock()
+	defer fake.disableSpikeProtectionMutex.RUnlock()
+	argsForCall := fake.disableSpikeProtectionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryOrganizations) DisableSpikeProtectionReturns(result1 *sentry.Response, result2 error) {
+	fake.disableSpikeProtectionMutex.Lock()
+	defer fake.disableSpikeProtectionMutex.Unlock()
+	fake.DisableSpikeProtectionStub = nil
+	fake.disableSpikeProtectionReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryOrganizations) DisableSpikeProtectionReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.disableSpikeProtectionMutex.Lock()
+	defer fake.disableSpikeProtectionMutex.Unlock()
+	fake.DisableSpikeProtectionStub = nil
+	if fake.disableSpikeProtectionReturnsOnCall == nil {
+		fake.disableSpikeProtectionReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.disableSpikeProtectionReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryOrganizations) EnableSpikeProtection(arg1 string, arg2 *sentry.SpikeProtectionParams) (*sentry.Response, error) {
+	fake.enableSpikeProtectionMutex.Lock()
+	ret, specificReturn := fake.enableSpikeProtectionReturnsOnCall[len(fake.enableSpikeProtectionArgsForCall)]
+	fake.enableSpikeProtectionArgsForCall = append(fake.enableSpikeProtectionArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.SpikeProtectionParams
+	}{arg1, arg2})
+	fake.recordInvocation("EnableSpikeProtection", []interface{}{arg1, arg2})
+	fake.enableSpikeProtectionMutex.Unlock()
+	if fake.EnableSpikeProtectionStub != nil {
+		return fake.EnableSpikeProtectionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.enableSpikeProtectionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryOrganizations) EnableSpikeProtectionCallCount() int {
+	fake.enableSpikeProtectionMutex.RLock()
+	defer fake.enableSpikeProtectionMutex.RUnlock()
+	return len(fake.enableSpikeProtectionArgsForCall)
+}
+
+func (fake *FakeSentryOrganizations) EnableSpikeProtectionCalls(stub func(string, *sentry.SpikeProtectionParams) (*sentry.Response, error)) {
+	fake.enableSpikeProtectionMutex.Lock()
+	defer fake.enableSpikeProtectionMutex.Unlock()
+	fake.EnableSpikeProtectionStub = stub
+}
+
+func (fake *FakeSentryOrganizations) EnableSpikeProtectionArgsForCall(i int) (string, *sentry.SpikeProtectionParams) {
+	fake.enableSpikeProtectionMutex.RLock()
+	defer fake.enableSpikeProtectionMutex.RUnlock()
+	argsForCall := fake.enableSpikeProtectionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryOrganizations) EnableSpikeProtectionReturns(result1 *sentry.Response, result2 error) {
+	fake.enableSpikeProtectionMutex.Lock()
+	defer fake.enableSpikeProtectionMutex.Unlock()
+	fake.EnableSpikeProtectionStub = nil
+	fake.enableSpikeProtectionReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryOrganizations) EnableSpikeProtectionReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.enableSpikeProtectionMutex.Lock()
+	defer fake.enableSpikeProtectionMutex.Unlock()
+	fake.EnableSpikeProtectionStub = nil
+	if fake.enableSpikeProtectionReturnsOnCall == nil {
+		fake.enableSpikeProtectionReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.enableSpikeProtectionReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryOrganizations) Get(arg1 string) (*sentry.Organization, *sentry.Response, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryOrganizations) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSentryOrganizations) GetCalls(stub func(string) (*sentry.Organization, *sentry.Response, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSentryOrganizations) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSentryOrganizations) GetReturns(result1 *sentry.Organization, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryOrganizations) GetReturnsOnCall(i int, result1 *sentry.Organization, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Organization
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSentryOrganizations) ListProjects(arg1 string, arg2 *sentry.ListOptions) ([]sentry.Project, *sentry.Response, error) {
 	fake.listProjectsMutex.Lock()
 	ret, specificReturn := fake.listProjectsReturnsOnCall[len(fake.listProjectsArgsForCall)]
@@ -96,11 +349,86 @@ func (fake *FakeSentryOrganizations) ListProjectsReturnsOnCall(i int, result1 []
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSentryOrganizations) Update(arg1 string, arg2 *sentry.UpdateOrganizationParams) (*sentry.Organization, *sentry.Response, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.UpdateOrganizationParams
+	}{arg1, arg2})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryOrganizations) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeSentryOrganizations) UpdateCalls(stub func(string, *sentry.UpdateOrganizationParams) (*sentry.Organization, *sentry.Response, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeSentryOrganizations) UpdateArgsForCall(i int) (string, *sentry.UpdateOrganizationParams) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryOrganizations) UpdateReturns(result1 *sentry.Organization, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryOrganizations) UpdateReturnsOnCall(i int, result1 *sentry.Organization, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Organization
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *sentry.Organization
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSentryOrganizations) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.disableSpikeProtectionMutex.RLock()
+	defer fake.disableSpikeProtectionMutex.RUnlock()
+	fake.enableSpikeProtectionMutex.RLock()
+	defer fake.enableSpikeProtectionMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
 	fake.listProjectsMutex.RLock()
 	defer fake.listProjectsMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,6 +9,40 @@ import (
 )
 
 type FakeSentryProjects struct {
+	AddTeamStub        func(string, string, string) (*sentry.Project, *sentry.Response, error)
+	addTeamMutex       sync.RWMutex
+	addTeamArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	addTeamReturns struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}
+	addTeamReturnsOnCall map[int]struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}
+	CreateHookStub        func(string, string, *sentry.CreateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error)
+	createHookMutex       sync.RWMutex
+	createHookArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateServiceHookParams
+	}
+	createHookReturns struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}
+	createHookReturnsOnCall map[int]struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}
 	CreateKeyStub        func(string, string, *sentry.CreateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error)
 	createKeyMutex       sync.RWMutex
 	createKeyArgsForCall []struct {
@@ -26,6 +60,40 @@ type FakeSentryProjects struct {
 		result2 *sentry.Response
 		result3 error
 	}
+	CreateRuleStub        func(string, string, *sentry.CreateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error)
+	createRuleMutex       sync.RWMutex
+	createRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateIssueAlertRuleParams
+	}
+	createRuleReturns struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	createRuleReturnsOnCall map[int]struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	CreateSearchStub        func(string, string, *sentry.CreateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)
+	createSearchMutex       sync.RWMutex
+	createSearchArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateSavedSearchParams
+	}
+	createSearchReturns struct {
+		result1 *sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}
+	createSearchReturnsOnCall map[int]struct {
+		result1 *sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}
 	DeleteStub        func(string, string) (*sentry.Response, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -40,6 +108,21 @@ type FakeSentryProjects struct {
 		result1 *sentry.Response
 		result2 error
 	}
+	DeleteHookStub        func(string, string, string) (*sentry.Response, error)
+	deleteHookMutex       sync.RWMutex
+	deleteHookArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteHookReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteHookReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
 	DeleteKeyStub        func(string, string, string) (*sentry.Response, error)
 	deleteKeyMutex       sync.RWMutex
 	deleteKeyArgsForCall []struct {
@@ -55,6 +138,182 @@ type FakeSentryProjects struct {
 		result1 *sentry.Response
 		result2 error
 	}
+	DeleteRuleStub        func(string, string, string) (*sentry.Response, error)
+	deleteRuleMutex       sync.RWMutex
+	deleteRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteRuleReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteRuleReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	DeleteSearchStub        func(string, string, string) (*sentry.Response, error)
+	deleteSearchMutex       sync.RWMutex
+	deleteSearchArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteSearchReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteSearchReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	DisablePluginStub        func(string, string, string) (*sentry.Response, error)
+	disablePluginMutex       sync.RWMutex
+	disablePluginArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	disablePluginReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	disablePluginReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	EnablePluginStub        func(string, string, string) (*sentry.Response, error)
+	enablePluginMutex       sync.RWMutex
+	enablePluginArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	enablePluginReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	enablePluginReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	GetStub        func(string, string) (*sentry.Project, *sentry.Response, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getReturns struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}
+	GetEnvironmentStub        func(string, string, string) (*sentry.ProjectEnvironment, *sentry.Response, error)
+	getEnvironmentMutex       sync.RWMutex
+	getEnvironmentArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getEnvironmentReturns struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}
+	getEnvironmentReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}
+	GetOwnershipStub        func(string, string) (*sentry.ProjectOwnership, *sentry.Response, error)
+	getOwnershipMutex       sync.RWMutex
+	getOwnershipArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getOwnershipReturns struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
+	}
+	getOwnershipReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
+	}
+	GetPluginStub        func(string, string, string) (*sentry.ProjectPlugin, *sentry.Response, error)
+	getPluginMutex       sync.RWMutex
+	getPluginArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getPluginReturns struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}
+	getPluginReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}
+	GetRuleStub        func(string, string, string) (*sentry.IssueAlertRule, *sentry.Response, error)
+	getRuleMutex       sync.RWMutex
+	getRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getRuleReturns struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	getRuleReturnsOnCall map[int]struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	ListFiltersStub        func(string, string) ([]sentry.ProjectFilter, *sentry.Response, error)
+	listFiltersMutex       sync.RWMutex
+	listFiltersArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listFiltersReturns struct {
+		result1 []sentry.ProjectFilter
+		result2 *sentry.Response
+		result3 error
+	}
+	listFiltersReturnsOnCall map[int]struct {
+		result1 []sentry.ProjectFilter
+		result2 *sentry.Response
+		result3 error
+	}
+	ListHooksStub        func(string, string, *sentry.ListOptions) ([]sentry.ServiceHook, *sentry.Response, error)
+	listHooksMutex       sync.RWMutex
+	listHooksArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.ListOptions
+	}
+	listHooksReturns struct {
+		result1 []sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}
+	listHooksReturnsOnCall map[int]struct {
+		result1 []sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}
 	ListKeysStub        func(string, string, *sentry.ListOptions) ([]sentry.ProjectKey, *sentry.Response, error)
 	listKeysMutex       sync.RWMutex
 	listKeysArgsForCall []struct {
@@ -72,6 +331,72 @@ type FakeSentryProjects struct {
 		result2 *sentry.Response
 		result3 error
 	}
+	ListQuotasStub        func(string, string) ([]sentry.ProjectQuota, *sentry.Response, error)
+	listQuotasMutex       sync.RWMutex
+	listQuotasArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listQuotasReturns struct {
+		result1 []sentry.ProjectQuota
+		result2 *sentry.Response
+		result3 error
+	}
+	listQuotasReturnsOnCall map[int]struct {
+		result1 []sentry.ProjectQuota
+		result2 *sentry.Response
+		result3 error
+	}
+	ListSearchesStub        func(string, string) ([]sentry.SavedSearch, *sentry.Response, error)
+	listSearchesMutex       sync.RWMutex
+	listSearchesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listSearchesReturns struct {
+		result1 []sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}
+	listSearchesReturnsOnCall map[int]struct {
+		result1 []sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}
+	RemoveTeamStub        func(string, string, string) (*sentry.Project, *sentry.Response, error)
+	removeTeamMutex       sync.RWMutex
+	removeTeamArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	removeTeamReturns struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}
+	removeTeamReturnsOnCall map[int]struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}
+	ResetPluginStub        func(string, string, string) (*sentry.ProjectPlugin, *sentry.Response, error)
+	resetPluginMutex       sync.RWMutex
+	resetPluginArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	resetPluginReturns struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}
+	resetPluginReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}
 	UpdateStub        func(string, string, *sentry.UpdateProjectParams) (*sentry.Project, *sentry.Response, error)
 	updateMutex       sync.RWMutex
 	updateArgsForCall []struct {
@@ -89,6 +414,58 @@ type FakeSentryProjects struct {
 		result2 *sentry.Response
 		result3 error
 	}
+	UpdateEnvironmentStub        func(string, string, string, *sentry.UpdateProjectEnvironmentParams) (*sentry.ProjectEnvironment, *sentry.Response, error)
+	updateEnvironmentMutex       sync.RWMutex
+	updateEnvironmentArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateProjectEnvironmentParams
+	}
+	updateEnvironmentReturns struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}
+	updateEnvironmentReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateFilterStub        func(string, string, string, *sentry.UpdateProjectFilterParams) (*sentry.Response, error)
+	updateFilterMutex       sync.RWMutex
+	updateFilterArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateProjectFilterParams
+	}
+	updateFilterReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	updateFilterReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	UpdateHookStub        func(string, string, string, *sentry.UpdateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error)
+	updateHookMutex       sync.RWMutex
+	updateHookArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateServiceHookParams
+	}
+	updateHookReturns struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}
+	updateHookReturnsOnCall map[int]struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}
 	UpdateKeyStub        func(string, string, string, *sentry.UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error)
 	updateKeyMutex       sync.RWMutex
 	updateKeyArgsForCall []struct {
@@ -107,36 +484,261 @@ type FakeSentryProjects struct {
 		result2 *sentry.Response
 		result3 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeSentryProjects) CreateKey(arg1 string, arg2 string, arg3 *sentry.CreateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error) {
-	fake.createKeyMutex.Lock()
-	ret, specificReturn := fake.createKeyReturnsOnCall[len(fake.createKeyArgsForCall)]
-	fake.createKeyArgsForCall = append(fake.createKeyArgsForCall, struct {
+	UpdateOwnershipStub        func(string, string, *sentry.UpdateProjectOwnershipParams) (*sentry.ProjectOwnership, *sentry.Response, error)
+	updateOwnershipMutex       sync.RWMutex
+	updateOwnershipArgsForCall []struct {
 		arg1 string
 		arg2 string
-		arg3 *sentry.CreateProjectKeyParams
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("CreateKey", []interface{}{arg1, arg2, arg3})
-	fake.createKeyMutex.Unlock()
-	if fake.CreateKeyStub != nil {
-		return fake.CreateKeyStub(arg1, arg2, arg3)
+		arg3 *sentry.UpdateProjectOwnershipParams
 	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+	updateOwnershipReturns struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
 	}
-	fakeReturns := fake.createKeyReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeSentryProjects) CreateKeyCallCount() int {
-	fake.createKeyMutex.RLock()
-	defer fake.createKeyMutex.RUnlock()
-	return len(fake.createKeyArgsForCall)
-}
-
+	updateOwnershipReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdatePluginStub        func(string, string, string, sentry.UpdateProjectPluginParams) (*sentry.ProjectPlugin, *sentry.Response, error)
+	updatePluginMutex       sync.RWMutex
+	updatePluginArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 sentry.UpdateProjectPluginParams
+	}
+	updatePluginReturns struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}
+	updatePluginReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateQuotaStub        func(string, string, string, *sentry.UpdateProjectQuotaParams) (*sentry.ProjectQuota, *sentry.Response, error)
+	updateQuotaMutex       sync.RWMutex
+	updateQuotaArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateProjectQuotaParams
+	}
+	updateQuotaReturns struct {
+		result1 *sentry.ProjectQuota
+		result2 *sentry.Response
+		result3 error
+	}
+	updateQuotaReturnsOnCall map[int]struct {
+		result1 *sentry.ProjectQuota
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateRuleStub        func(string, string, string, *sentry.UpdateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error)
+	updateRuleMutex       sync.RWMutex
+	updateRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateIssueAlertRuleParams
+	}
+	updateRuleReturns struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	updateRuleReturnsOnCall map[int]struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateSearchStub        func(string, string, string, *sentry.UpdateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)
+	updateSearchMutex       sync.RWMutex
+	updateSearchArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateSavedSearchParams
+	}
+	updateSearchReturns struct {
+		result1 *sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}
+	updateSearchReturnsOnCall map[int]struct {
+		result1 *sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSentryProjects) AddTeam(arg1 string, arg2 string, arg3 string) (*sentry.Project, *sentry.Response, error) {
+	fake.addTeamMutex.Lock()
+	ret, specificReturn := fake.addTeamReturnsOnCall[len(fake.addTeamArgsForCall)]
+	fake.addTeamArgsForCall = append(fake.addTeamArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AddTeam", []interface{}{arg1, arg2, arg3})
+	fake.addTeamMutex.Unlock()
+	if fake.AddTeamStub != nil {
+		return fake.AddTeamStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.addTeamReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) AddTeamCallCount() int {
+	fake.addTeamMutex.RLock()
+	defer fake.addTeamMutex.RUnlock()
+	return len(fake.addTeamArgsForCall)
+}
+
+func (fake *FakeSentryProjects) AddTeamCalls(stub func(string, string, string) (*sentry.Project, *sentry.Response, error)) {
+	fake.addTeamMutex.Lock()
+	defer fake.addTeamMutex.Unlock()
+	fake.AddTeamStub = stub
+}
+
+func (fake *FakeSentryProjects) AddTeamArgsForCall(i int) (string, string, string) {
+	fake.addTeamMutex.RLock()
+	defer fake.addTeamMutex.RUnlock()
+	argsForCall := fake.addTeamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) AddTeamReturns(result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.addTeamMutex.Lock()
+	defer fake.addTeamMutex.Unlock()
+	fake.AddTeamStub = nil
+	fake.addTeamReturns = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) AddTeamReturnsOnCall(i int, result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.addTeamMutex.Lock()
+	defer fake.addTeamMutex.Unlock()
+	fake.AddTeamStub = nil
+	if fake.addTeamReturnsOnCall == nil {
+		fake.addTeamReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Project
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.addTeamReturnsOnCall[i] = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) CreateHook(arg1 string, arg2 string, arg3 *sentry.CreateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error) {
+	fake.createHookMutex.Lock()
+	ret, specificReturn := fake.createHookReturnsOnCall[len(fake.createHookArgsForCall)]
+	fake.createHookArgsForCall = append(fake.createHookArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateServiceHookParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateHook", []interface{}{arg1, arg2, arg3})
+	fake.createHookMutex.Unlock()
+	if fake.CreateHookStub != nil {
+		return fake.CreateHookStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createHookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) CreateHookCallCount() int {
+	fake.createHookMutex.RLock()
+	defer fake.createHookMutex.RUnlock()
+	return len(fake.createHookArgsForCall)
+}
+
+func (fake *FakeSentryProjects) CreateHookCalls(stub func(string, string, *sentry.CreateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error)) {
+	fake.createHookMutex.Lock()
+	defer fake.createHookMutex.Unlock()
+	fake.CreateHookStub = stub
+}
+
+func (fake *FakeSentryProjects) CreateHookArgsForCall(i int) (string, string, *sentry.CreateServiceHookParams) {
+	fake.createHookMutex.RLock()
+	defer fake.createHookMutex.RUnlock()
+	argsForCall := fake.createHookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) CreateHookReturns(result1 *sentry.ServiceHook, result2 *sentry.Response, result3 error) {
+	fake.createHookMutex.Lock()
+	defer fake.createHookMutex.Unlock()
+	fake.CreateHookStub = nil
+	fake.createHookReturns = struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) CreateHookReturnsOnCall(i int, result1 *sentry.ServiceHook, result2 *sentry.Response, result3 error) {
+	fake.createHookMutex.Lock()
+	defer fake.createHookMutex.Unlock()
+	fake.CreateHookStub = nil
+	if fake.createHookReturnsOnCall == nil {
+		fake.createHookReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ServiceHook
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createHookReturnsOnCall[i] = struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) CreateKey(arg1 string, arg2 string, arg3 *sentry.CreateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error) {
+	fake.createKeyMutex.Lock()
+	ret, specificReturn := fake.createKeyReturnsOnCall[len(fake.createKeyArgsForCall)]
+	fake.createKeyArgsForCall = append(fake.createKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateProjectKeyParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateKey", []interface{}{arg1, arg2, arg3})
+	fake.createKeyMutex.Unlock()
+	if fake.CreateKeyStub != nil {
+		return fake.CreateKeyStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createKeyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) CreateKeyCallCount() int {
+	fake.createKeyMutex.RLock()
+	defer fake.createKeyMutex.RUnlock()
+	return len(fake.createKeyArgsForCall)
+}
+
 func (fake *FakeSentryProjects) CreateKeyCalls(stub func(string, string, *sentry.CreateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error)) {
 	fake.createKeyMutex.Lock()
 	defer fake.createKeyMutex.Unlock()
@@ -161,353 +763,2105 @@ func (fake *FakeSentryProjects) CreateKeyReturns(result1 *sentry.ProjectKey, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) CreateKeyReturnsOnCall(i int, result1 *sentry.ProjectKey, result2 *sentry.Response, result3 error) {
-	fake.createKeyMutex.Lock()
-	defer fake.createKeyMutex.Unlock()
-	fake.CreateKeyStub = nil
-	if fake.createKeyReturnsOnCall == nil {
-		fake.createKeyReturnsOnCall = make(map[int]struct {
+func (fake *FakeSentryProjects) CreateKeyReturnsOnCall(i int, result1 *sentry.ProjectKey, result2 *sentry.Response, result3 error) {
+	fake.createKeyMutex.Lock()
+	defer fake.createKeyMutex.Unlock()
+	fake.CreateKeyStub = nil
+	if fake.createKeyReturnsOnCall == nil {
+		fake.createKeyReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectKey
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createKeyReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectKey
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) CreateRule(arg1 string, arg2 string, arg3 *sentry.CreateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error) {
+	fake.createRuleMutex.Lock()
+	ret, specificReturn := fake.createRuleReturnsOnCall[len(fake.createRuleArgsForCall)]
+	fake.createRuleArgsForCall = append(fake.createRuleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateIssueAlertRuleParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateRule", []interface{}{arg1, arg2, arg3})
+	fake.createRuleMutex.Unlock()
+	if fake.CreateRuleStub != nil {
+		return fake.CreateRuleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createRuleReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) CreateRuleCallCount() int {
+	fake.createRuleMutex.RLock()
+	defer fake.createRuleMutex.RUnlock()
+	return len(fake.createRuleArgsForCall)
+}
+
+func (fake *FakeSentryProjects) CreateRuleCalls(stub func(string, string, *sentry.CreateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error)) {
+	fake.createRuleMutex.Lock()
+	defer fake.createRuleMutex.Unlock()
+	fake.CreateRuleStub = stub
+}
+
+func (fake *FakeSentryProjects) CreateRuleArgsForCall(i int) (string, string, *sentry.CreateIssueAlertRuleParams) {
+	fake.createRuleMutex.RLock()
+	defer fake.createRuleMutex.RUnlock()
+	argsForCall := fake.createRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) CreateRuleReturns(result1 *sentry.IssueAlertRule, result2 *sentry.Response, result3 error) {
+	fake.createRuleMutex.Lock()
+	defer fake.createRuleMutex.Unlock()
+	fake.CreateRuleStub = nil
+	fake.createRuleReturns = struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) CreateRuleReturnsOnCall(i int, result1 *sentry.IssueAlertRule, result2 *sentry.Response, result3 error) {
+	fake.createRuleMutex.Lock()
+	defer fake.createRuleMutex.Unlock()
+	fake.CreateRuleStub = nil
+	if fake.createRuleReturnsOnCall == nil {
+		fake.createRuleReturnsOnCall = make(map[int]struct {
+			result1 *sentry.IssueAlertRule
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createRuleReturnsOnCall[i] = struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) CreateSearch(arg1 string, arg2 string, arg3 *sentry.CreateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error) {
+	fake.createSearchMutex.Lock()
+	ret, specificReturn := fake.createSearchReturnsOnCall[len(fake.createSearchArgsForCall)]
+	fake.createSearchArgsForCall = append(fake.createSearchArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateSavedSearchParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateSearch", []interface{}{arg1, arg2, arg3})
+	fake.createSearchMutex.Unlock()
+	if fake.CreateSearchStub != nil {
+		return fake.CreateSearchStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createSearchReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) CreateSearchCallCount() int {
+	fake.createSearchMutex.RLock()
+	defer fake.createSearchMutex.RUnlock()
+	return len(fake.createSearchArgsForCall)
+}
+
+func (fake *FakeSentryProjects) CreateSearchCalls(stub func(string, string, *sentry.CreateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)) {
+	fake.createSearchMutex.Lock()
+	defer fake.createSearchMutex.Unlock()
+	fake.CreateSearchStub = stub
+}
+
+func (fake *FakeSentryProjects) CreateSearchArgsForCall(i int) (string, string, *sentry.CreateSavedSearchParams) {
+	fake.createSearchMutex.RLock()
+	defer fake.createSearchMutex.RUnlock()
+	argsForCall := fake.createSearchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) CreateSearchReturns(result1 *sentry.SavedSearch, result2 *sentry.Response, result3 error) {
+	fake.createSearchMutex.Lock()
+	defer fake.createSearchMutex.Unlock()
+	fake.CreateSearchStub = nil
+	fake.createSearchReturns = struct {
+		result1 *sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) CreateSearchReturnsOnCall(i int, result1 *sentry.SavedSearch, result2 *sentry.Response, result3 error) {
+	fake.createSearchMutex.Lock()
+	defer fake.createSearchMutex.Unlock()
+	fake.CreateSearchStub = nil
+	if fake.createSearchReturnsOnCall == nil {
+		fake.createSearchReturnsOnCall = make(map[int]struct {
+			result1 *sentry.SavedSearch
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createSearchReturnsOnCall[i] = struct {
+		result1 *sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) Delete(arg1 string, arg2 string) (*sentry.Response, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeSentryProjects) DeleteCalls(stub func(string, string) (*sentry.Response, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeSentryProjects) DeleteArgsForCall(i int) (string, string) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryProjects) DeleteReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteHook(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
+	fake.deleteHookMutex.Lock()
+	ret, specificReturn := fake.deleteHookReturnsOnCall[len(fake.deleteHookArgsForCall)]
+	fake.deleteHookArgsForCall = append(fake.deleteHookArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteHook", []interface{}{arg1, arg2, arg3})
+	fake.deleteHookMutex.Unlock()
+	if fake.DeleteHookStub != nil {
+		return fake.DeleteHookStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteHookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) DeleteHookCallCount() int {
+	fake.deleteHookMutex.RLock()
+	defer fake.deleteHookMutex.RUnlock()
+	return len(fake.deleteHookArgsForCall)
+}
+
+func (fake *FakeSentryProjects) DeleteHookCalls(stub func(string, string, string) (*sentry.Response, error)) {
+	fake.deleteHookMutex.Lock()
+	defer fake.deleteHookMutex.Unlock()
+	fake.DeleteHookStub = stub
+}
+
+func (fake *FakeSentryProjects) DeleteHookArgsForCall(i int) (string, string, string) {
+	fake.deleteHookMutex.RLock()
+	defer fake.deleteHookMutex.RUnlock()
+	argsForCall := fake.deleteHookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) DeleteHookReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteHookMutex.Lock()
+	defer fake.deleteHookMutex.Unlock()
+	fake.DeleteHookStub = nil
+	fake.deleteHookReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteHookReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteHookMutex.Lock()
+	defer fake.deleteHookMutex.Unlock()
+	fake.DeleteHookStub = nil
+	if fake.deleteHookReturnsOnCall == nil {
+		fake.deleteHookReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteHookReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteKey(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
+	fake.deleteKeyMutex.Lock()
+	ret, specificReturn := fake.deleteKeyReturnsOnCall[len(fake.deleteKeyArgsForCall)]
+	fake.deleteKeyArgsForCall = append(fake.deleteKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteKey", []interface{}{arg1, arg2, arg3})
+	fake.deleteKeyMutex.Unlock()
+	if fake.DeleteKeyStub != nil {
+		return fake.DeleteKeyStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) DeleteKeyCallCount() int {
+	fake.deleteKeyMutex.RLock()
+	defer fake.deleteKeyMutex.RUnlock()
+	return len(fake.deleteKeyArgsForCall)
+}
+
+func (fake *FakeSentryProjects) DeleteKeyCalls(stub func(string, string, string) (*sentry.Response, error)) {
+	fake.deleteKeyMutex.Lock()
+	defer fake.deleteKeyMutex.Unlock()
+	fake.DeleteKeyStub = stub
+}
+
+func (fake *FakeSentryProjects) DeleteKeyArgsForCall(i int) (string, string, string) {
+	fake.deleteKeyMutex.RLock()
+	defer fake.deleteKeyMutex.RUnlock()
+	argsForCall := fake.deleteKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) DeleteKeyReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteKeyMutex.Lock()
+	defer fake.deleteKeyMutex.Unlock()
+	fake.DeleteKeyStub = nil
+	fake.deleteKeyReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteKeyReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteKeyMutex.Lock()
+	defer fake.deleteKeyMutex.Unlock()
+	fake.DeleteKeyStub = nil
+	if fake.deleteKeyReturnsOnCall == nil {
+		fake.deleteKeyReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteKeyReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteRule(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
+	fake.deleteRuleMutex.Lock()
+	ret, specificReturn := fake.deleteRuleReturnsOnCall[len(fake.deleteRuleArgsForCall)]
+	fake.deleteRuleArgsForCall = append(fake.deleteRuleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteRule", []interface{}{arg1, arg2, arg3})
+	fake.deleteRuleMutex.Unlock()
+	if fake.DeleteRuleStub != nil {
+		return fake.DeleteRuleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteRuleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) DeleteRuleCallCount() int {
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	return len(fake.deleteRuleArgsForCall)
+}
+
+func (fake *FakeSentryProjects) DeleteRuleCalls(stub func(string, string, string) (*sentry.Response, error)) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = stub
+}
+
+func (fake *FakeSentryProjects) DeleteRuleArgsForCall(i int) (string, string, string) {
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	argsForCall := fake.deleteRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) DeleteRuleReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = nil
+	fake.deleteRuleReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteRuleReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = nil
+	if fake.deleteRuleReturnsOnCall == nil {
+		fake.deleteRuleReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteRuleReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteSearch(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
+	fake.deleteSearchMutex.Lock()
+	ret, specificReturn := fake.deleteSearchReturnsOnCall[len(fake.deleteSearchArgsForCall)]
+	fake.deleteSearchArgsForCall = append(fake.deleteSearchArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteSearch", []interface{}{arg1, arg2, arg3})
+	fake.deleteSearchMutex.Unlock()
+	if fake.DeleteSearchStub != nil {
+		return fake.DeleteSearchStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteSearchReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) DeleteSearchCallCount() int {
+	fake.deleteSearchMutex.RLock()
+	defer fake.deleteSearchMutex.RUnlock()
+	return len(fake.deleteSearchArgsForCall)
+}
+
+func (fake *FakeSentryProjects) DeleteSearchCalls(stub func(string, string, string) (*sentry.Response, error)) {
+	fake.deleteSearchMutex.Lock()
+	defer fake.deleteSearchMutex.Unlock()
+	fake.DeleteSearchStub = stub
+}
+
+func (fake *FakeSentryProjects) DeleteSearchArgsForCall(i int) (string, string, string) {
+	fake.deleteSearchMutex.RLock()
+	defer fake.deleteSearchMutex.RUnlock()
+	argsForCall := fake.deleteSearchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) DeleteSearchReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteSearchMutex.Lock()
+	defer fake.deleteSearchMutex.Unlock()
+	fake.DeleteSearchStub = nil
+	fake.deleteSearchReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DeleteSearchReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteSearchMutex.Lock()
+	defer fake.deleteSearchMutex.Unlock()
+	fake.DeleteSearchStub = nil
+	if fake.deleteSearchReturnsOnCall == nil {
+		fake.deleteSearchReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteSearchReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DisablePlugin(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
+	fake.disablePluginMutex.Lock()
+	ret, specificReturn := fake.disablePluginReturnsOnCall[len(fake.disablePluginArgsForCall)]
+	fake.disablePluginArgsForCall = append(fake.disablePluginArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DisablePlugin", []interface{}{arg1, arg2, arg3})
+	fake.disablePluginMutex.Unlock()
+	if fake.DisablePluginStub != nil {
+		return fake.DisablePluginStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.disablePluginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) DisablePluginCallCount() int {
+	fake.disablePluginMutex.RLock()
+	defer fake.disablePluginMutex.RUnlock()
+	return len(fake.disablePluginArgsForCall)
+}
+
+func (fake *FakeSentryProjects) DisablePluginCalls(stub func(string, string, string) (*sentry.Response, error)) {
+	fake.disablePluginMutex.Lock()
+	defer fake.disablePluginMutex.Unlock()
+	fake.DisablePluginStub = stub
+}
+
+func (fake *FakeSentryProjects) DisablePluginArgsForCall(i int) (string, string, string) {
+	fake.disablePluginMutex.RLock()
+	defer fake.disablePluginMutex.RUnlock()
+	argsForCall := fake.disablePluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) DisablePluginReturns(result1 *sentry.Response, result2 error) {
+	fake.disablePluginMutex.Lock()
+	defer fake.disablePluginMutex.Unlock()
+	fake.DisablePluginStub = nil
+	fake.disablePluginReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) DisablePluginReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.disablePluginMutex.Lock()
+	defer fake.disablePluginMutex.Unlock()
+	fake.DisablePluginStub = nil
+	if fake.disablePluginReturnsOnCall == nil {
+		fake.disablePluginReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.disablePluginReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) EnablePlugin(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
+	fake.enablePluginMutex.Lock()
+	ret, specificReturn := fake.enablePluginReturnsOnCall[len(fake.enablePluginArgsForCall)]
+	fake.enablePluginArgsForCall = append(fake.enablePluginArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("EnablePlugin", []interface{}{arg1, arg2, arg3})
+	fake.enablePluginMutex.Unlock()
+	if fake.EnablePluginStub != nil {
+		return fake.EnablePluginStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.enablePluginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) EnablePluginCallCount() int {
+	fake.enablePluginMutex.RLock()
+	defer fake.enablePluginMutex.RUnlock()
+	return len(fake.enablePluginArgsForCall)
+}
+
+func (fake *FakeSentryProjects) EnablePluginCalls(stub func(string, string, string) (*sentry.Response, error)) {
+	fake.enablePluginMutex.Lock()
+	defer fake.enablePluginMutex.Unlock()
+	fake.EnablePluginStub = stub
+}
+
+func (fake *FakeSentryProjects) EnablePluginArgsForCall(i int) (string, string, string) {
+	fake.enablePluginMutex.RLock()
+	defer fake.enablePluginMutex.RUnlock()
+	argsForCall := fake.enablePluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) EnablePluginReturns(result1 *sentry.Response, result2 error) {
+	fake.enablePluginMutex.Lock()
+	defer fake.enablePluginMutex.Unlock()
+	fake.EnablePluginStub = nil
+	fake.enablePluginReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) EnablePluginReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.enablePluginMutex.Lock()
+	defer fake.enablePluginMutex.Unlock()
+	fake.EnablePluginStub = nil
+	if fake.enablePluginReturnsOnCall == nil {
+		fake.enablePluginReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.enablePluginReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) Get(arg1 string, arg2 string) (*sentry.Project, *sentry.Response, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSentryProjects) GetCalls(stub func(string, string) (*sentry.Project, *sentry.Response, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSentryProjects) GetArgsForCall(i int) (string, string) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryProjects) GetReturns(result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetReturnsOnCall(i int, result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Project
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetEnvironment(arg1 string, arg2 string, arg3 string) (*sentry.ProjectEnvironment, *sentry.Response, error) {
+	fake.getEnvironmentMutex.Lock()
+	ret, specificReturn := fake.getEnvironmentReturnsOnCall[len(fake.getEnvironmentArgsForCall)]
+	fake.getEnvironmentArgsForCall = append(fake.getEnvironmentArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetEnvironment", []interface{}{arg1, arg2, arg3})
+	fake.getEnvironmentMutex.Unlock()
+	if fake.GetEnvironmentStub != nil {
+		return fake.GetEnvironmentStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEnvironmentReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) GetEnvironmentCallCount() int {
+	fake.getEnvironmentMutex.RLock()
+	defer fake.getEnvironmentMutex.RUnlock()
+	return len(fake.getEnvironmentArgsForCall)
+}
+
+func (fake *FakeSentryProjects) GetEnvironmentCalls(stub func(string, string, string) (*sentry.ProjectEnvironment, *sentry.Response, error)) {
+	fake.getEnvironmentMutex.Lock()
+	defer fake.getEnvironmentMutex.Unlock()
+	fake.GetEnvironmentStub = stub
+}
+
+func (fake *FakeSentryProjects) GetEnvironmentArgsForCall(i int) (string, string, string) {
+	fake.getEnvironmentMutex.RLock()
+	defer fake.getEnvironmentMutex.RUnlock()
+	argsForCall := fake.getEnvironmentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) GetEnvironmentReturns(result1 *sentry.ProjectEnvironment, result2 *sentry.Response, result3 error) {
+	fake.getEnvironmentMutex.Lock()
+	defer fake.getEnvironmentMutex.Unlock()
+	fake.GetEnvironmentStub = nil
+	fake.getEnvironmentReturns = struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetEnvironmentReturnsOnCall(i int, result1 *sentry.ProjectEnvironment, result2 *sentry.Response, result3 error) {
+	fake.getEnvironmentMutex.Lock()
+	defer fake.getEnvironmentMutex.Unlock()
+	fake.GetEnvironmentStub = nil
+	if fake.getEnvironmentReturnsOnCall == nil {
+		fake.getEnvironmentReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectEnvironment
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getEnvironmentReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetOwnership(arg1 string, arg2 string) (*sentry.ProjectOwnership, *sentry.Response, error) {
+	fake.getOwnershipMutex.Lock()
+	ret, specificReturn := fake.getOwnershipReturnsOnCall[len(fake.getOwnershipArgsForCall)]
+	fake.getOwnershipArgsForCall = append(fake.getOwnershipArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetOwnership", []interface{}{arg1, arg2})
+	fake.getOwnershipMutex.Unlock()
+	if fake.GetOwnershipStub != nil {
+		return fake.GetOwnershipStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOwnershipReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) GetOwnershipCallCount() int {
+	fake.getOwnershipMutex.RLock()
+	defer fake.getOwnershipMutex.RUnlock()
+	return len(fake.getOwnershipArgsForCall)
+}
+
+func (fake *FakeSentryProjects) GetOwnershipCalls(stub func(string, string) (*sentry.ProjectOwnership, *sentry.Response, error)) {
+	fake.getOwnershipMutex.Lock()
+	defer fake.getOwnershipMutex.Unlock()
+	fake.GetOwnershipStub = stub
+}
+
+func (fake *FakeSentryProjects) GetOwnershipArgsForCall(i int) (string, string) {
+	fake.getOwnershipMutex.RLock()
+	defer fake.getOwnershipMutex.RUnlock()
+	argsForCall := fake.getOwnershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryProjects) GetOwnershipReturns(result1 *sentry.ProjectOwnership, result2 *sentry.Response, result3 error) {
+	fake.getOwnershipMutex.Lock()
+	defer fake.getOwnershipMutex.Unlock()
+	fake.GetOwnershipStub = nil
+	fake.getOwnershipReturns = struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetOwnershipReturnsOnCall(i int, result1 *sentry.ProjectOwnership, result2 *sentry.Response, result3 error) {
+	fake.getOwnershipMutex.Lock()
+	defer fake.getOwnershipMutex.Unlock()
+	fake.GetOwnershipStub = nil
+	if fake.getOwnershipReturnsOnCall == nil {
+		fake.getOwnershipReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectOwnership
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getOwnershipReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetPlugin(arg1 string, arg2 string, arg3 string) (*sentry.ProjectPlugin, *sentry.Response, error) {
+	fake.getPluginMutex.Lock()
+	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
+	fake.getPluginArgsForCall = append(fake.getPluginArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetPlugin", []interface{}{arg1, arg2, arg3})
+	fake.getPluginMutex.Unlock()
+	if fake.GetPluginStub != nil {
+		return fake.GetPluginStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getPluginReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) GetPluginCallCount() int {
+	fake.getPluginMutex.RLock()
+	defer fake.getPluginMutex.RUnlock()
+	return len(fake.getPluginArgsForCall)
+}
+
+func (fake *FakeSentryProjects) GetPluginCalls(stub func(string, string, string) (*sentry.ProjectPlugin, *sentry.Response, error)) {
+	fake.getPluginMutex.Lock()
+	defer fake.getPluginMutex.Unlock()
+	fake.GetPluginStub = stub
+}
+
+func (fake *FakeSentryProjects) GetPluginArgsForCall(i int) (string, string, string) {
+	fake.getPluginMutex.RLock()
+	defer fake.getPluginMutex.RUnlock()
+	argsForCall := fake.getPluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) GetPluginReturns(result1 *sentry.ProjectPlugin, result2 *sentry.Response, result3 error) {
+	fake.getPluginMutex.Lock()
+	defer fake.getPluginMutex.Unlock()
+	fake.GetPluginStub = nil
+	fake.getPluginReturns = struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetPluginReturnsOnCall(i int, result1 *sentry.ProjectPlugin, result2 *sentry.Response, result3 error) {
+	fake.getPluginMutex.Lock()
+	defer fake.getPluginMutex.Unlock()
+	fake.GetPluginStub = nil
+	if fake.getPluginReturnsOnCall == nil {
+		fake.getPluginReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectPlugin
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getPluginReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetRule(arg1 string, arg2 string, arg3 string) (*sentry.IssueAlertRule, *sentry.Response, error) {
+	fake.getRuleMutex.Lock()
+	ret, specificReturn := fake.getRuleReturnsOnCall[len(fake.getRuleArgsForCall)]
+	fake.getRuleArgsForCall = append(fake.getRuleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetRule", []interface{}{arg1, arg2, arg3})
+	fake.getRuleMutex.Unlock()
+	if fake.GetRuleStub != nil {
+		return fake.GetRuleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRuleReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) GetRuleCallCount() int {
+	fake.getRuleMutex.RLock()
+	defer fake.getRuleMutex.RUnlock()
+	return len(fake.getRuleArgsForCall)
+}
+
+func (fake *FakeSentryProjects) GetRuleCalls(stub func(string, string, string) (*sentry.IssueAlertRule, *sentry.Response, error)) {
+	fake.getRuleMutex.Lock()
+	defer fake.getRuleMutex.Unlock()
+	fake.GetRuleStub = stub
+}
+
+func (fake *FakeSentryProjects) GetRuleArgsForCall(i int) (string, string, string) {
+	fake.getRuleMutex.RLock()
+	defer fake.getRuleMutex.RUnlock()
+	argsForCall := fake.getRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) GetRuleReturns(result1 *sentry.IssueAlertRule, result2 *sentry.Response, result3 error) {
+	fake.getRuleMutex.Lock()
+	defer fake.getRuleMutex.Unlock()
+	fake.GetRuleStub = nil
+	fake.getRuleReturns = struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) GetRuleReturnsOnCall(i int, result1 *sentry.IssueAlertRule, result2 *sentry.Response, result3 error) {
+	fake.getRuleMutex.Lock()
+	defer fake.getRuleMutex.Unlock()
+	fake.GetRuleStub = nil
+	if fake.getRuleReturnsOnCall == nil {
+		fake.getRuleReturnsOnCall = make(map[int]struct {
+			result1 *sentry.IssueAlertRule
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getRuleReturnsOnCall[i] = struct {
+		result1 *sentry.IssueAlertRule
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListFilters(arg1 string, arg2 string) ([]sentry.ProjectFilter, *sentry.Response, error) {
+	fake.listFiltersMutex.Lock()
+	ret, specificReturn := fake.listFiltersReturnsOnCall[len(fake.listFiltersArgsForCall)]
+	fake.listFiltersArgsForCall = append(fake.listFiltersArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ListFilters", []interface{}{arg1, arg2})
+	fake.listFiltersMutex.Unlock()
+	if fake.ListFiltersStub != nil {
+		return fake.ListFiltersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listFiltersReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) ListFiltersCallCount() int {
+	fake.listFiltersMutex.RLock()
+	defer fake.listFiltersMutex.RUnlock()
+	return len(fake.listFiltersArgsForCall)
+}
+
+func (fake *FakeSentryProjects) ListFiltersCalls(stub func(string, string) ([]sentry.ProjectFilter, *sentry.Response, error)) {
+	fake.listFiltersMutex.Lock()
+	defer fake.listFiltersMutex.Unlock()
+	fake.ListFiltersStub = stub
+}
+
+func (fake *FakeSentryProjects) ListFiltersArgsForCall(i int) (string, string) {
+	fake.listFiltersMutex.RLock()
+	defer fake.listFiltersMutex.RUnlock()
+	argsForCall := fake.listFiltersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryProjects) ListFiltersReturns(result1 []sentry.ProjectFilter, result2 *sentry.Response, result3 error) {
+	fake.listFiltersMutex.Lock()
+	defer fake.listFiltersMutex.Unlock()
+	fake.ListFiltersStub = nil
+	fake.listFiltersReturns = struct {
+		result1 []sentry.ProjectFilter
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListFiltersReturnsOnCall(i int, result1 []sentry.ProjectFilter, result2 *sentry.Response, result3 error) {
+	fake.listFiltersMutex.Lock()
+	defer fake.listFiltersMutex.Unlock()
+	fake.ListFiltersStub = nil
+	if fake.listFiltersReturnsOnCall == nil {
+		fake.listFiltersReturnsOnCall = make(map[int]struct {
+			result1 []sentry.ProjectFilter
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listFiltersReturnsOnCall[i] = struct {
+		result1 []sentry.ProjectFilter
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListHooks(arg1 string, arg2 string, arg3 *sentry.ListOptions) ([]sentry.ServiceHook, *sentry.Response, error) {
+	fake.listHooksMutex.Lock()
+	ret, specificReturn := fake.listHooksReturnsOnCall[len(fake.listHooksArgsForCall)]
+	fake.listHooksArgsForCall = append(fake.listHooksArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.ListOptions
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ListHooks", []interface{}{arg1, arg2, arg3})
+	fake.listHooksMutex.Unlock()
+	if fake.ListHooksStub != nil {
+		return fake.ListHooksStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listHooksReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) ListHooksCallCount() int {
+	fake.listHooksMutex.RLock()
+	defer fake.listHooksMutex.RUnlock()
+	return len(fake.listHooksArgsForCall)
+}
+
+func (fake *FakeSentryProjects) ListHooksCalls(stub func(string, string, *sentry.ListOptions) ([]sentry.ServiceHook, *sentry.Response, error)) {
+	fake.listHooksMutex.Lock()
+	defer fake.listHooksMutex.Unlock()
+	fake.ListHooksStub = stub
+}
+
+func (fake *FakeSentryProjects) ListHooksArgsForCall(i int) (string, string, *sentry.ListOptions) {
+	fake.listHooksMutex.RLock()
+	defer fake.listHooksMutex.RUnlock()
+	argsForCall := fake.listHooksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) ListHooksReturns(result1 []sentry.ServiceHook, result2 *sentry.Response, result3 error) {
+	fake.listHooksMutex.Lock()
+	defer fake.listHooksMutex.Unlock()
+	fake.ListHooksStub = nil
+	fake.listHooksReturns = struct {
+		result1 []sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListHooksReturnsOnCall(i int, result1 []sentry.ServiceHook, result2 *sentry.Response, result3 error) {
+	fake.listHooksMutex.Lock()
+	defer fake.listHooksMutex.Unlock()
+	fake.ListHooksStub = nil
+	if fake.listHooksReturnsOnCall == nil {
+		fake.listHooksReturnsOnCall = make(map[int]struct {
+			result1 []sentry.ServiceHook
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listHooksReturnsOnCall[i] = struct {
+		result1 []sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListKeys(arg1 string, arg2 string, arg3 *sentry.ListOptions) ([]sentry.ProjectKey, *sentry.Response, error) {
+	fake.listKeysMutex.Lock()
+	ret, specificReturn := fake.listKeysReturnsOnCall[len(fake.listKeysArgsForCall)]
+	fake.listKeysArgsForCall = append(fake.listKeysArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.ListOptions
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ListKeys", []interface{}{arg1, arg2, arg3})
+	fake.listKeysMutex.Unlock()
+	if fake.ListKeysStub != nil {
+		return fake.ListKeysStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listKeysReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) ListKeysCallCount() int {
+	fake.listKeysMutex.RLock()
+	defer fake.listKeysMutex.RUnlock()
+	return len(fake.listKeysArgsForCall)
+}
+
+func (fake *FakeSentryProjects) ListKeysCalls(stub func(string, string, *sentry.ListOptions) ([]sentry.ProjectKey, *sentry.Response, error)) {
+	fake.listKeysMutex.Lock()
+	defer fake.listKeysMutex.Unlock()
+	fake.ListKeysStub = stub
+}
+
+func (fake *FakeSentryProjects) ListKeysArgsForCall(i int) (string, string, *sentry.ListOptions) {
+	fake.listKeysMutex.RLock()
+	defer fake.listKeysMutex.RUnlock()
+	argsForCall := fake.listKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) ListKeysReturns(result1 []sentry.ProjectKey, result2 *sentry.Response, result3 error) {
+	fake.listKeysMutex.Lock()
+	defer fake.listKeysMutex.Unlock()
+	fake.ListKeysStub = nil
+	fake.listKeysReturns = struct {
+		result1 []sentry.ProjectKey
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListKeysReturnsOnCall(i int, result1 []sentry.ProjectKey, result2 *sentry.Response, result3 error) {
+	fake.listKeysMutex.Lock()
+	defer fake.listKeysMutex.Unlock()
+	fake.ListKeysStub = nil
+	if fake.listKeysReturnsOnCall == nil {
+		fake.listKeysReturnsOnCall = make(map[int]struct {
+			result1 []sentry.ProjectKey
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listKeysReturnsOnCall[i] = struct {
+		result1 []sentry.ProjectKey
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListQuotas(arg1 string, arg2 string) ([]sentry.ProjectQuota, *sentry.Response, error) {
+	fake.listQuotasMutex.Lock()
+	ret, specificReturn := fake.listQuotasReturnsOnCall[len(fake.listQuotasArgsForCall)]
+	fake.listQuotasArgsForCall = append(fake.listQuotasArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ListQuotas", []interface{}{arg1, arg2})
+	fake.listQuotasMutex.Unlock()
+	if fake.ListQuotasStub != nil {
+		return fake.ListQuotasStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listQuotasReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) ListQuotasCallCount() int {
+	fake.listQuotasMutex.RLock()
+	defer fake.listQuotasMutex.RUnlock()
+	return len(fake.listQuotasArgsForCall)
+}
+
+func (fake *FakeSentryProjects) ListQuotasCalls(stub func(string, string) ([]sentry.ProjectQuota, *sentry.Response, error)) {
+	fake.listQuotasMutex.Lock()
+	defer fake.listQuotasMutex.Unlock()
+	fake.ListQuotasStub = stub
+}
+
+func (fake *FakeSentryProjects) ListQuotasArgsForCall(i int) (string, string) {
+	fake.listQuotasMutex.RLock()
+	defer fake.listQuotasMutex.RUnlock()
+	argsForCall := fake.listQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryProjects) ListQuotasReturns(result1 []sentry.ProjectQuota, result2 *sentry.Response, result3 error) {
+	fake.listQuotasMutex.Lock()
+	defer fake.listQuotasMutex.Unlock()
+	fake.ListQuotasStub = nil
+	fake.listQuotasReturns = struct {
+		result1 []sentry.ProjectQuota
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListQuotasReturnsOnCall(i int, result1 []sentry.ProjectQuota, result2 *sentry.Response, result3 error) {
+	fake.listQuotasMutex.Lock()
+	defer fake.listQuotasMutex.Unlock()
+	fake.ListQuotasStub = nil
+	if fake.listQuotasReturnsOnCall == nil {
+		fake.listQuotasReturnsOnCall = make(map[int]struct {
+			result1 []sentry.ProjectQuota
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listQuotasReturnsOnCall[i] = struct {
+		result1 []sentry.ProjectQuota
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListSearches(arg1 string, arg2 string) ([]sentry.SavedSearch, *sentry.Response, error) {
+	fake.listSearchesMutex.Lock()
+	ret, specificReturn := fake.listSearchesReturnsOnCall[len(fake.listSearchesArgsForCall)]
+	fake.listSearchesArgsForCall = append(fake.listSearchesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ListSearches", []interface{}{arg1, arg2})
+	fake.listSearchesMutex.Unlock()
+	if fake.ListSearchesStub != nil {
+		return fake.ListSearchesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listSearchesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) ListSearchesCallCount() int {
+	fake.listSearchesMutex.RLock()
+	defer fake.listSearchesMutex.RUnlock()
+	return len(fake.listSearchesArgsForCall)
+}
+
+func (fake *FakeSentryProjects) ListSearchesCalls(stub func(string, string) ([]sentry.SavedSearch, *sentry.Response, error)) {
+	fake.listSearchesMutex.Lock()
+	defer fake.listSearchesMutex.Unlock()
+	fake.ListSearchesStub = stub
+}
+
+func (fake *FakeSentryProjects) ListSearchesArgsForCall(i int) (string, string) {
+	fake.listSearchesMutex.RLock()
+	defer fake.listSearchesMutex.RUnlock()
+	argsForCall := fake.listSearchesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryProjects) ListSearchesReturns(result1 []sentry.SavedSearch, result2 *sentry.Response, result3 error) {
+	fake.listSearchesMutex.Lock()
+	defer fake.listSearchesMutex.Unlock()
+	fake.ListSearchesStub = nil
+	fake.listSearchesReturns = struct {
+		result1 []sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ListSearchesReturnsOnCall(i int, result1 []sentry.SavedSearch, result2 *sentry.Response, result3 error) {
+	fake.listSearchesMutex.Lock()
+	defer fake.listSearchesMutex.Unlock()
+	fake.ListSearchesStub = nil
+	if fake.listSearchesReturnsOnCall == nil {
+		fake.listSearchesReturnsOnCall = make(map[int]struct {
+			result1 []sentry.SavedSearch
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listSearchesReturnsOnCall[i] = struct {
+		result1 []sentry.SavedSearch
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) RemoveTeam(arg1 string, arg2 string, arg3 string) (*sentry.Project, *sentry.Response, error) {
+	fake.removeTeamMutex.Lock()
+	ret, specificReturn := fake.removeTeamReturnsOnCall[len(fake.removeTeamArgsForCall)]
+	fake.removeTeamArgsForCall = append(fake.removeTeamArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RemoveTeam", []interface{}{arg1, arg2, arg3})
+	fake.removeTeamMutex.Unlock()
+	if fake.RemoveTeamStub != nil {
+		return fake.RemoveTeamStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.removeTeamReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) RemoveTeamCallCount() int {
+	fake.removeTeamMutex.RLock()
+	defer fake.removeTeamMutex.RUnlock()
+	return len(fake.removeTeamArgsForCall)
+}
+
+func (fake *FakeSentryProjects) RemoveTeamCalls(stub func(string, string, string) (*sentry.Project, *sentry.Response, error)) {
+	fake.removeTeamMutex.Lock()
+	defer fake.removeTeamMutex.Unlock()
+	fake.RemoveTeamStub = stub
+}
+
+func (fake *FakeSentryProjects) RemoveTeamArgsForCall(i int) (string, string, string) {
+	fake.removeTeamMutex.RLock()
+	defer fake.removeTeamMutex.RUnlock()
+	argsForCall := fake.removeTeamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) RemoveTeamReturns(result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.removeTeamMutex.Lock()
+	defer fake.removeTeamMutex.Unlock()
+	fake.RemoveTeamStub = nil
+	fake.removeTeamReturns = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) RemoveTeamReturnsOnCall(i int, result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.removeTeamMutex.Lock()
+	defer fake.removeTeamMutex.Unlock()
+	fake.RemoveTeamStub = nil
+	if fake.removeTeamReturnsOnCall == nil {
+		fake.removeTeamReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Project
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.removeTeamReturnsOnCall[i] = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ResetPlugin(arg1 string, arg2 string, arg3 string) (*sentry.ProjectPlugin, *sentry.Response, error) {
+	fake.resetPluginMutex.Lock()
+	ret, specificReturn := fake.resetPluginReturnsOnCall[len(fake.resetPluginArgsForCall)]
+	fake.resetPluginArgsForCall = append(fake.resetPluginArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ResetPlugin", []interface{}{arg1, arg2, arg3})
+	fake.resetPluginMutex.Unlock()
+	if fake.ResetPluginStub != nil {
+		return fake.ResetPluginStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.resetPluginReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) ResetPluginCallCount() int {
+	fake.resetPluginMutex.RLock()
+	defer fake.resetPluginMutex.RUnlock()
+	return len(fake.resetPluginArgsForCall)
+}
+
+func (fake *FakeSentryProjects) ResetPluginCalls(stub func(string, string, string) (*sentry.ProjectPlugin, *sentry.Response, error)) {
+	fake.resetPluginMutex.Lock()
+	defer fake.resetPluginMutex.Unlock()
+	fake.ResetPluginStub = stub
+}
+
+func (fake *FakeSentryProjects) ResetPluginArgsForCall(i int) (string, string, string) {
+	fake.resetPluginMutex.RLock()
+	defer fake.resetPluginMutex.RUnlock()
+	argsForCall := fake.resetPluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) ResetPluginReturns(result1 *sentry.ProjectPlugin, result2 *sentry.Response, result3 error) {
+	fake.resetPluginMutex.Lock()
+	defer fake.resetPluginMutex.Unlock()
+	fake.ResetPluginStub = nil
+	fake.resetPluginReturns = struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) ResetPluginReturnsOnCall(i int, result1 *sentry.ProjectPlugin, result2 *sentry.Response, result3 error) {
+	fake.resetPluginMutex.Lock()
+	defer fake.resetPluginMutex.Unlock()
+	fake.ResetPluginStub = nil
+	if fake.resetPluginReturnsOnCall == nil {
+		fake.resetPluginReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectPlugin
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.resetPluginReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) Update(arg1 string, arg2 string, arg3 *sentry.UpdateProjectParams) (*sentry.Project, *sentry.Response, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.UpdateProjectParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeSentryProjects) UpdateCalls(stub func(string, string, *sentry.UpdateProjectParams) (*sentry.Project, *sentry.Response, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeSentryProjects) UpdateArgsForCall(i int) (string, string, *sentry.UpdateProjectParams) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryProjects) UpdateReturns(result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) UpdateReturnsOnCall(i int, result1 *sentry.Project, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Project
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *sentry.Project
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) UpdateEnvironment(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateProjectEnvironmentParams) (*sentry.ProjectEnvironment, *sentry.Response, error) {
+	fake.updateEnvironmentMutex.Lock()
+	ret, specificReturn := fake.updateEnvironmentReturnsOnCall[len(fake.updateEnvironmentArgsForCall)]
+	fake.updateEnvironmentArgsForCall = append(fake.updateEnvironmentArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateProjectEnvironmentParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateEnvironment", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateEnvironmentMutex.Unlock()
+	if fake.UpdateEnvironmentStub != nil {
+		return fake.UpdateEnvironmentStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateEnvironmentReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) UpdateEnvironmentCallCount() int {
+	fake.updateEnvironmentMutex.RLock()
+	defer fake.updateEnvironmentMutex.RUnlock()
+	return len(fake.updateEnvironmentArgsForCall)
+}
+
+func (fake *FakeSentryProjects) UpdateEnvironmentCalls(stub func(string, string, string, *sentry.UpdateProjectEnvironmentParams) (*sentry.ProjectEnvironment, *sentry.Response, error)) {
+	fake.updateEnvironmentMutex.Lock()
+	defer fake.updateEnvironmentMutex.Unlock()
+	fake.UpdateEnvironmentStub = stub
+}
+
+func (fake *FakeSentryProjects) UpdateEnvironmentArgsForCall(i int) (string, string, string, *sentry.UpdateProjectEnvironmentParams) {
+	fake.updateEnvironmentMutex.RLock()
+	defer fake.updateEnvironmentMutex.RUnlock()
+	argsForCall := fake.updateEnvironmentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryProjects) UpdateEnvironmentReturns(result1 *sentry.ProjectEnvironment, result2 *sentry.Response, result3 error) {
+	fake.updateEnvironmentMutex.Lock()
+	defer fake.updateEnvironmentMutex.Unlock()
+	fake.UpdateEnvironmentStub = nil
+	fake.updateEnvironmentReturns = struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) UpdateEnvironmentReturnsOnCall(i int, result1 *sentry.ProjectEnvironment, result2 *sentry.Response, result3 error) {
+	fake.updateEnvironmentMutex.Lock()
+	defer fake.updateEnvironmentMutex.Unlock()
+	fake.UpdateEnvironmentStub = nil
+	if fake.updateEnvironmentReturnsOnCall == nil {
+		fake.updateEnvironmentReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectEnvironment
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateEnvironmentReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectEnvironment
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) UpdateFilter(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateProjectFilterParams) (*sentry.Response, error) {
+	fake.updateFilterMutex.Lock()
+	ret, specificReturn := fake.updateFilterReturnsOnCall[len(fake.updateFilterArgsForCall)]
+	fake.updateFilterArgsForCall = append(fake.updateFilterArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateProjectFilterParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateFilter", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateFilterMutex.Unlock()
+	if fake.UpdateFilterStub != nil {
+		return fake.UpdateFilterStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateFilterReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryProjects) UpdateFilterCallCount() int {
+	fake.updateFilterMutex.RLock()
+	defer fake.updateFilterMutex.RUnlock()
+	return len(fake.updateFilterArgsForCall)
+}
+
+func (fake *FakeSentryProjects) UpdateFilterCalls(stub func(string, string, string, *sentry.UpdateProjectFilterParams) (*sentry.Response, error)) {
+	fake.updateFilterMutex.Lock()
+	defer fake.updateFilterMutex.Unlock()
+	fake.UpdateFilterStub = stub
+}
+
+func (fake *FakeSentryProjects) UpdateFilterArgsForCall(i int) (string, string, string, *sentry.UpdateProjectFilterParams) {
+	fake.updateFilterMutex.RLock()
+	defer fake.updateFilterMutex.RUnlock()
+	argsForCall := fake.updateFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryProjects) UpdateFilterReturns(result1 *sentry.Response, result2 error) {
+	fake.updateFilterMutex.Lock()
+	defer fake.updateFilterMutex.Unlock()
+	fake.UpdateFilterStub = nil
+	fake.updateFilterReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) UpdateFilterReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.updateFilterMutex.Lock()
+	defer fake.updateFilterMutex.Unlock()
+	fake.UpdateFilterStub = nil
+	if fake.updateFilterReturnsOnCall == nil {
+		fake.updateFilterReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.updateFilterReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryProjects) UpdateHook(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error) {
+	fake.updateHookMutex.Lock()
+	ret, specificReturn := fake.updateHookReturnsOnCall[len(fake.updateHookArgsForCall)]
+	fake.updateHookArgsForCall = append(fake.updateHookArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateServiceHookParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateHook", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateHookMutex.Unlock()
+	if fake.UpdateHookStub != nil {
+		return fake.UpdateHookStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateHookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) UpdateHookCallCount() int {
+	fake.updateHookMutex.RLock()
+	defer fake.updateHookMutex.RUnlock()
+	return len(fake.updateHookArgsForCall)
+}
+
+func (fake *FakeSentryProjects) UpdateHookCalls(stub func(string, string, string, *sentry.UpdateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error)) {
+	fake.updateHookMutex.Lock()
+	defer fake.updateHookMutex.Unlock()
+	fake.UpdateHookStub = stub
+}
+
+func (fake *FakeSentryProjects) UpdateHookArgsForCall(i int) (string, string, string, *sentry.UpdateServiceHookParams) {
+	fake.updateHookMutex.RLock()
+	defer fake.updateHookMutex.RUnlock()
+	argsForCall := fake.updateHookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryProjects) UpdateHookReturns(result1 *sentry.ServiceHook, result2 *sentry.Response, result3 error) {
+	fake.updateHookMutex.Lock()
+	defer fake.updateHookMutex.Unlock()
+	fake.UpdateHookStub = nil
+	fake.updateHookReturns = struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) UpdateHookReturnsOnCall(i int, result1 *sentry.ServiceHook, result2 *sentry.Response, result3 error) {
+	fake.updateHookMutex.Lock()
+	defer fake.updateHookMutex.Unlock()
+	fake.UpdateHookStub = nil
+	if fake.updateHookReturnsOnCall == nil {
+		fake.updateHookReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ServiceHook
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateHookReturnsOnCall[i] = struct {
+		result1 *sentry.ServiceHook
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) UpdateKey(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error) {
+	fake.updateKeyMutex.Lock()
+	ret, specificReturn := fake.updateKeyReturnsOnCall[len(fake.updateKeyArgsForCall)]
+	fake.updateKeyArgsForCall = append(fake.updateKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UpdateProjectKeyParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateKey", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateKeyMutex.Unlock()
+	if fake.UpdateKeyStub != nil {
+		return fake.UpdateKeyStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateKeyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryProjects) UpdateKeyCallCount() int {
+	fake.updateKeyMutex.RLock()
+	defer fake.updateKeyMutex.RUnlock()
+	return len(fake.updateKeyArgsForCall)
+}
+
+func (fake *FakeSentryProjects) UpdateKeyCalls(stub func(string, string, string, *sentry.UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error)) {
+	fake.updateKeyMutex.Lock()
+	defer fake.updateKeyMutex.Unlock()
+	fake.UpdateKeyStub = stub
+}
+
+func (fake *FakeSentryProjects) UpdateKeyArgsForCall(i int) (string, string, string, *sentry.UpdateProjectKeyParams) {
+	fake.updateKeyMutex.RLock()
+	defer fake.updateKeyMutex.RUnlock()
+	argsForCall := fake.updateKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryProjects) UpdateKeyReturns(result1 *sentry.ProjectKey, result2 *sentry.Response, result3 error) {
+	fake.updateKeyMutex.Lock()
+	defer fake.updateKeyMutex.Unlock()
+	fake.UpdateKeyStub = nil
+	fake.updateKeyReturns = struct {
+		result1 *sentry.ProjectKey
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryProjects) UpdateKeyReturnsOnCall(i int, result1 *sentry.ProjectKey, result2 *sentry.Response, result3 error) {
+	fake.updateKeyMutex.Lock()
+	defer fake.updateKeyMutex.Unlock()
+	fake.UpdateKeyStub = nil
+	if fake.updateKeyReturnsOnCall == nil {
+		fake.updateKeyReturnsOnCall = make(map[int]struct {
 			result1 *sentry.ProjectKey
 			result2 *sentry.Response
 			result3 error
 		})
 	}
-	fake.createKeyReturnsOnCall[i] = struct {
+	fake.updateKeyReturnsOnCall[i] = struct {
 		result1 *sentry.ProjectKey
 		result2 *sentry.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) Delete(arg1 string, arg2 string) (*sentry.Response, error) {
-	fake.deleteMutex.Lock()
-	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
-	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+func (fake *FakeSentryProjects) UpdateOwnership(arg1 string, arg2 string, arg3 *sentry.UpdateProjectOwnershipParams) (*sentry.ProjectOwnership, *sentry.Response, error) {
+	fake.updateOwnershipMutex.Lock()
+	ret, specificReturn := fake.updateOwnershipReturnsOnCall[len(fake.updateOwnershipArgsForCall)]
+	fake.updateOwnershipArgsForCall = append(fake.updateOwnershipArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
-	fake.deleteMutex.Unlock()
-	if fake.DeleteStub != nil {
-		return fake.DeleteStub(arg1, arg2)
+		arg3 *sentry.UpdateProjectOwnershipParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateOwnership", []interface{}{arg1, arg2, arg3})
+	fake.updateOwnershipMutex.Unlock()
+	if fake.UpdateOwnershipStub != nil {
+		return fake.UpdateOwnershipStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.deleteReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.updateOwnershipReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeSentryProjects) DeleteCallCount() int {
-	fake.deleteMutex.RLock()
-	defer fake.deleteMutex.RUnlock()
-	return len(fake.deleteArgsForCall)
+func (fake *FakeSentryProjects) UpdateOwnershipCallCount() int {
+	fake.updateOwnershipMutex.RLock()
+	defer fake.updateOwnershipMutex.RUnlock()
+	return len(fake.updateOwnershipArgsForCall)
 }
 
-func (fake *FakeSentryProjects) DeleteCalls(stub func(string, string) (*sentry.Response, error)) {
-	fake.deleteMutex.Lock()
-	defer fake.deleteMutex.Unlock()
-	fake.DeleteStub = stub
+func (fake *FakeSentryProjects) UpdateOwnershipCalls(stub func(string, string, *sentry.UpdateProjectOwnershipParams) (*sentry.ProjectOwnership, *sentry.Response, error)) {
+	fake.updateOwnershipMutex.Lock()
+	defer fake.updateOwnershipMutex.Unlock()
+	fake.UpdateOwnershipStub = stub
 }
 
-func (fake *FakeSentryProjects) DeleteArgsForCall(i int) (string, string) {
-	fake.deleteMutex.RLock()
-	defer fake.deleteMutex.RUnlock()
-	argsForCall := fake.deleteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeSentryProjects) UpdateOwnershipArgsForCall(i int) (string, string, *sentry.UpdateProjectOwnershipParams) {
+	fake.updateOwnershipMutex.RLock()
+	defer fake.updateOwnershipMutex.RUnlock()
+	argsForCall := fake.updateOwnershipArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeSentryProjects) DeleteReturns(result1 *sentry.Response, result2 error) {
-	fake.deleteMutex.Lock()
-	defer fake.deleteMutex.Unlock()
-	fake.DeleteStub = nil
-	fake.deleteReturns = struct {
-		result1 *sentry.Response
-		result2 error
-	}{result1, result2}
+func (fake *FakeSentryProjects) UpdateOwnershipReturns(result1 *sentry.ProjectOwnership, result2 *sentry.Response, result3 error) {
+	fake.updateOwnershipMutex.Lock()
+	defer fake.updateOwnershipMutex.Unlock()
+	fake.UpdateOwnershipStub = nil
+	fake.updateOwnershipReturns = struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) DeleteReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
-	fake.deleteMutex.Lock()
-	defer fake.deleteMutex.Unlock()
-	fake.DeleteStub = nil
-	if fake.deleteReturnsOnCall == nil {
-		fake.deleteReturnsOnCall = make(map[int]struct {
-			result1 *sentry.Response
-			result2 error
+func (fake *FakeSentryProjects) UpdateOwnershipReturnsOnCall(i int, result1 *sentry.ProjectOwnership, result2 *sentry.Response, result3 error) {
+	fake.updateOwnershipMutex.Lock()
+	defer fake.updateOwnershipMutex.Unlock()
+	fake.UpdateOwnershipStub = nil
+	if fake.updateOwnershipReturnsOnCall == nil {
+		fake.updateOwnershipReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectOwnership
+			result2 *sentry.Response
+			result3 error
 		})
 	}
-	fake.deleteReturnsOnCall[i] = struct {
-		result1 *sentry.Response
-		result2 error
-	}{result1, result2}
+	fake.updateOwnershipReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectOwnership
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) DeleteKey(arg1 string, arg2 string, arg3 string) (*sentry.Response, error) {
-	fake.deleteKeyMutex.Lock()
-	ret, specificReturn := fake.deleteKeyReturnsOnCall[len(fake.deleteKeyArgsForCall)]
-	fake.deleteKeyArgsForCall = append(fake.deleteKeyArgsForCall, struct {
+func (fake *FakeSentryProjects) UpdatePlugin(arg1 string, arg2 string, arg3 string, arg4 sentry.UpdateProjectPluginParams) (*sentry.ProjectPlugin, *sentry.Response, error) {
+	fake.updatePluginMutex.Lock()
+	ret, specificReturn := fake.updatePluginReturnsOnCall[len(fake.updatePluginArgsForCall)]
+	fake.updatePluginArgsForCall = append(fake.updatePluginArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("DeleteKey", []interface{}{arg1, arg2, arg3})
-	fake.deleteKeyMutex.Unlock()
-	if fake.DeleteKeyStub != nil {
-		return fake.DeleteKeyStub(arg1, arg2, arg3)
+		arg4 sentry.UpdateProjectPluginParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdatePlugin", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updatePluginMutex.Unlock()
+	if fake.UpdatePluginStub != nil {
+		return fake.UpdatePluginStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.deleteKeyReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.updatePluginReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeSentryProjects) DeleteKeyCallCount() int {
-	fake.deleteKeyMutex.RLock()
-	defer fake.deleteKeyMutex.RUnlock()
-	return len(fake.deleteKeyArgsForCall)
+func (fake *FakeSentryProjects) UpdatePluginCallCount() int {
+	fake.updatePluginMutex.RLock()
+	defer fake.updatePluginMutex.RUnlock()
+	return len(fake.updatePluginArgsForCall)
 }
 
-func (fake *FakeSentryProjects) DeleteKeyCalls(stub func(string, string, string) (*sentry.Response, error)) {
-	fake.deleteKeyMutex.Lock()
-	defer fake.deleteKeyMutex.Unlock()
-	fake.DeleteKeyStub = stub
+func (fake *FakeSentryProjects) UpdatePluginCalls(stub func(string, string, string, sentry.UpdateProjectPluginParams) (*sentry.ProjectPlugin, *sentry.Response, error)) {
+	fake.updatePluginMutex.Lock()
+	defer fake.updatePluginMutex.Unlock()
+	fake.UpdatePluginStub = stub
 }
 
-func (fake *FakeSentryProjects) DeleteKeyArgsForCall(i int) (string, string, string) {
-	fake.deleteKeyMutex.RLock()
-	defer fake.deleteKeyMutex.RUnlock()
-	argsForCall := fake.deleteKeyArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeSentryProjects) UpdatePluginArgsForCall(i int) (string, string, string, sentry.UpdateProjectPluginParams) {
+	fake.updatePluginMutex.RLock()
+	defer fake.updatePluginMutex.RUnlock()
+	argsForCall := fake.updatePluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeSentryProjects) DeleteKeyReturns(result1 *sentry.Response, result2 error) {
-	fake.deleteKeyMutex.Lock()
-	defer fake.deleteKeyMutex.Unlock()
-	fake.DeleteKeyStub = nil
-	fake.deleteKeyReturns = struct {
-		result1 *sentry.Response
-		result2 error
-	}{result1, result2}
+func (fake *FakeSentryProjects) UpdatePluginReturns(result1 *sentry.ProjectPlugin, result2 *sentry.Response, result3 error) {
+	fake.updatePluginMutex.Lock()
+	defer fake.updatePluginMutex.Unlock()
+	fake.UpdatePluginStub = nil
+	fake.updatePluginReturns = struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) DeleteKeyReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
-	fake.deleteKeyMutex.Lock()
-	defer fake.deleteKeyMutex.Unlock()
-	fake.DeleteKeyStub = nil
-	if fake.deleteKeyReturnsOnCall == nil {
-		fake.deleteKeyReturnsOnCall = make(map[int]struct {
-			result1 *sentry.Response
-			result2 error
+func (fake *FakeSentryProjects) UpdatePluginReturnsOnCall(i int, result1 *sentry.ProjectPlugin, result2 *sentry.Response, result3 error) {
+	fake.updatePluginMutex.Lock()
+	defer fake.updatePluginMutex.Unlock()
+	fake.UpdatePluginStub = nil
+	if fake.updatePluginReturnsOnCall == nil {
+		fake.updatePluginReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectPlugin
+			result2 *sentry.Response
+			result3 error
 		})
 	}
-	fake.deleteKeyReturnsOnCall[i] = struct {
-		result1 *sentry.Response
-		result2 error
-	}{result1, result2}
+	fake.updatePluginReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectPlugin
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) ListKeys(arg1 string, arg2 string, arg3 *sentry.ListOptions) ([]sentry.ProjectKey, *sentry.Response, error) {
-	fake.listKeysMutex.Lock()
-	ret, specificReturn := fake.listKeysReturnsOnCall[len(fake.listKeysArgsForCall)]
-	fake.listKeysArgsForCall = append(fake.listKeysArgsForCall, struct {
+func (fake *FakeSentryProjects) UpdateQuota(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateProjectQuotaParams) (*sentry.ProjectQuota, *sentry.Response, error) {
+	fake.updateQuotaMutex.Lock()
+	ret, specificReturn := fake.updateQuotaReturnsOnCall[len(fake.updateQuotaArgsForCall)]
+	fake.updateQuotaArgsForCall = append(fake.updateQuotaArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 *sentry.ListOptions
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("ListKeys", []interface{}{arg1, arg2, arg3})
-	fake.listKeysMutex.Unlock()
-	if fake.ListKeysStub != nil {
-		return fake.ListKeysStub(arg1, arg2, arg3)
+		arg3 string
+		arg4 *sentry.UpdateProjectQuotaParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateQuota", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateQuotaMutex.Unlock()
+	if fake.UpdateQuotaStub != nil {
+		return fake.UpdateQuotaStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.listKeysReturns
+	fakeReturns := fake.updateQuotaReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeSentryProjects) ListKeysCallCount() int {
-	fake.listKeysMutex.RLock()
-	defer fake.listKeysMutex.RUnlock()
-	return len(fake.listKeysArgsForCall)
+func (fake *FakeSentryProjects) UpdateQuotaCallCount() int {
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	return len(fake.updateQuotaArgsForCall)
 }
 
-func (fake *FakeSentryProjects) ListKeysCalls(stub func(string, string, *sentry.ListOptions) ([]sentry.ProjectKey, *sentry.Response, error)) {
-	fake.listKeysMutex.Lock()
-	defer fake.listKeysMutex.Unlock()
-	fake.ListKeysStub = stub
+func (fake *FakeSentryProjects) UpdateQuotaCalls(stub func(string, string, string, *sentry.UpdateProjectQuotaParams) (*sentry.ProjectQuota, *sentry.Response, error)) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = stub
 }
 
-func (fake *FakeSentryProjects) ListKeysArgsForCall(i int) (string, string, *sentry.ListOptions) {
-	fake.listKeysMutex.RLock()
-	defer fake.listKeysMutex.RUnlock()
-	argsForCall := fake.listKeysArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeSentryProjects) UpdateQuotaArgsForCall(i int) (string, string, string, *sentry.UpdateProjectQuotaParams) {
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	argsForCall := fake.updateQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeSentryProjects) ListKeysReturns(result1 []sentry.ProjectKey, result2 *sentry.Response, result3 error) {
-	fake.listKeysMutex.Lock()
-	defer fake.listKeysMutex.Unlock()
-	fake.ListKeysStub = nil
-	fake.listKeysReturns = struct {
-		result1 []sentry.ProjectKey
+func (fake *FakeSentryProjects) UpdateQuotaReturns(result1 *sentry.ProjectQuota, result2 *sentry.Response, result3 error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = nil
+	fake.updateQuotaReturns = struct {
+		result1 *sentry.ProjectQuota
 		result2 *sentry.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) ListKeysReturnsOnCall(i int, result1 []sentry.ProjectKey, result2 *sentry.Response, result3 error) {
-	fake.listKeysMutex.Lock()
-	defer fake.listKeysMutex.Unlock()
-	fake.ListKeysStub = nil
-	if fake.listKeysReturnsOnCall == nil {
-		fake.listKeysReturnsOnCall = make(map[int]struct {
-			result1 []sentry.ProjectKey
+func (fake *FakeSentryProjects) UpdateQuotaReturnsOnCall(i int, result1 *sentry.ProjectQuota, result2 *sentry.Response, result3 error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = nil
+	if fake.updateQuotaReturnsOnCall == nil {
+		fake.updateQuotaReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ProjectQuota
 			result2 *sentry.Response
 			result3 error
 		})
 	}
-	fake.listKeysReturnsOnCall[i] = struct {
-		result1 []sentry.ProjectKey
+	fake.updateQuotaReturnsOnCall[i] = struct {
+		result1 *sentry.ProjectQuota
 		result2 *sentry.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) Update(arg1 string, arg2 string, arg3 *sentry.UpdateProjectParams) (*sentry.Project, *sentry.Response, error) {
-	fake.updateMutex.Lock()
-	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
-	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+func (fake *FakeSentryProjects) UpdateRule(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error) {
+	fake.updateRuleMutex.Lock()
+	ret, specificReturn := fake.updateRuleReturnsOnCall[len(fake.updateRuleArgsForCall)]
+	fake.updateRuleArgsForCall = append(fake.updateRuleArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 *sentry.UpdateProjectParams
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
-	fake.updateMutex.Unlock()
-	if fake.UpdateStub != nil {
-		return fake.UpdateStub(arg1, arg2, arg3)
+		arg3 string
+		arg4 *sentry.UpdateIssueAlertRuleParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateRule", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateRuleMutex.Unlock()
+	if fake.UpdateRuleStub != nil {
+		return fake.UpdateRuleStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.updateReturns
+	fakeReturns := fake.updateRuleReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeSentryProjects) UpdateCallCount() int {
-	fake.updateMutex.RLock()
-	defer fake.updateMutex.RUnlock()
-	return len(fake.updateArgsForCall)
+func (fake *FakeSentryProjects) UpdateRuleCallCount() int {
+	fake.updateRuleMutex.RLock()
+	defer fake.updateRuleMutex.RUnlock()
+	return len(fake.updateRuleArgsForCall)
 }
 
-func (fake *FakeSentryProjects) UpdateCalls(stub func(string, string, *sentry.UpdateProjectParams) (*sentry.Project, *sentry.Response, error)) {
-	fake.updateMutex.Lock()
-	defer fake.updateMutex.Unlock()
-	fake.UpdateStub = stub
+func (fake *FakeSentryProjects) UpdateRuleCalls(stub func(string, string, string, *sentry.UpdateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error)) {
+	fake.updateRuleMutex.Lock()
+	defer fake.updateRuleMutex.Unlock()
+	fake.UpdateRuleStub = stub
 }
 
-func (fake *FakeSentryProjects) UpdateArgsForCall(i int) (string, string, *sentry.UpdateProjectParams) {
-	fake.updateMutex.RLock()
-	defer fake.updateMutex.RUnlock()
-	argsForCall := fake.updateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeSentryProjects) UpdateRuleArgsForCall(i int) (string, string, string, *sentry.UpdateIssueAlertRuleParams) {
+	fake.updateRuleMutex.RLock()
+	defer fake.updateRuleMutex.RUnlock()
+	argsForCall := fake.updateRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeSentryProjects) UpdateReturns(result1 *sentry.Project, result2 *sentry.Response, result3 error) {
-	fake.updateMutex.Lock()
-	defer fake.updateMutex.Unlock()
-	fake.UpdateStub = nil
-	fake.updateReturns = struct {
-		result1 *sentry.Project
+func (fake *FakeSentryProjects) UpdateRuleReturns(result1 *sentry.IssueAlertRule, result2 *sentry.Response, result3 error) {
+	fake.updateRuleMutex.Lock()
+	defer fake.updateRuleMutex.Unlock()
+	fake.UpdateRuleStub = nil
+	fake.updateRuleReturns = struct {
+		result1 *sentry.IssueAlertRule
 		result2 *sentry.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) UpdateReturnsOnCall(i int, result1 *sentry.Project, result2 *sentry.Response, result3 error) {
-	fake.updateMutex.Lock()
-	defer fake.updateMutex.Unlock()
-	fake.UpdateStub = nil
-	if fake.updateReturnsOnCall == nil {
-		fake.updateReturnsOnCall = make(map[int]struct {
-			result1 *sentry.Project
+func (fake *FakeSentryProjects) UpdateRuleReturnsOnCall(i int, result1 *sentry.IssueAlertRule, result2 *sentry.Response, result3 error) {
+	fake.updateRuleMutex.Lock()
+	defer fake.updateRuleMutex.Unlock()
+	fake.UpdateRuleStub = nil
+	if fake.updateRuleReturnsOnCall == nil {
+		fake.updateRuleReturnsOnCall = make(map[int]struct {
+			result1 *sentry.IssueAlertRule
 			result2 *sentry.Response
 			result3 error
 		})
 	}
-	fake.updateReturnsOnCall[i] = struct {
-		result1 *sentry.Project
+	fake.updateRuleReturnsOnCall[i] = struct {
+		result1 *sentry.IssueAlertRule
 		result2 *sentry.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) UpdateKey(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error) {
-	fake.updateKeyMutex.Lock()
-	ret, specificReturn := fake.updateKeyReturnsOnCall[len(fake.updateKeyArgsForCall)]
-	fake.updateKeyArgsForCall = append(fake.updateKeyArgsForCall, struct {
+func (fake *FakeSentryProjects) UpdateSearch(arg1 string, arg2 string, arg3 string, arg4 *sentry.UpdateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error) {
+	fake.updateSearchMutex.Lock()
+	ret, specificReturn := fake.updateSearchReturnsOnCall[len(fake.updateSearchArgsForCall)]
+	fake.updateSearchArgsForCall = append(fake.updateSearchArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 string
-		arg4 *sentry.UpdateProjectKeyParams
+		arg4 *sentry.UpdateSavedSearchParams
 	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("UpdateKey", []interface{}{arg1, arg2, arg3, arg4})
-	fake.updateKeyMutex.Unlock()
-	if fake.UpdateKeyStub != nil {
-		return fake.UpdateKeyStub(arg1, arg2, arg3, arg4)
+	fake.recordInvocation("UpdateSearch", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateSearchMutex.Unlock()
+	if fake.UpdateSearchStub != nil {
+		return fake.UpdateSearchStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.updateKeyReturns
+	fakeReturns := fake.updateSearchReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeSentryProjects) UpdateKeyCallCount() int {
-	fake.updateKeyMutex.RLock()
-	defer fake.updateKeyMutex.RUnlock()
-	return len(fake.updateKeyArgsForCall)
+func (fake *FakeSentryProjects) UpdateSearchCallCount() int {
+	fake.updateSearchMutex.RLock()
+	defer fake.updateSearchMutex.RUnlock()
+	return len(fake.updateSearchArgsForCall)
 }
 
-func (fake *FakeSentryProjects) UpdateKeyCalls(stub func(string, string, string, *sentry.UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error)) {
-	fake.updateKeyMutex.Lock()
-	defer fake.updateKeyMutex.Unlock()
-	fake.UpdateKeyStub = stub
+func (fake *FakeSentryProjects) UpdateSearchCalls(stub func(string, string, string, *sentry.UpdateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)) {
+	fake.updateSearchMutex.Lock()
+	defer fake.updateSearchMutex.Unlock()
+	fake.UpdateSearchStub = stub
 }
 
-func (fake *FakeSentryProjects) UpdateKeyArgsForCall(i int) (string, string, string, *sentry.UpdateProjectKeyParams) {
-	fake.updateKeyMutex.RLock()
-	defer fake.updateKeyMutex.RUnlock()
-	argsForCall := fake.updateKeyArgsForCall[i]
+func (fake *FakeSentryProjects) UpdateSearchArgsForCall(i int) (string, string, string, *sentry.UpdateSavedSearchParams) {
+	fake.updateSearchMutex.RLock()
+	defer fake.updateSearchMutex.RUnlock()
+	argsForCall := fake.updateSearchArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeSentryProjects) UpdateKeyReturns(result1 *sentry.ProjectKey, result2 *sentry.Response, result3 error) {
-	fake.updateKeyMutex.Lock()
-	defer fake.updateKeyMutex.Unlock()
-	fake.UpdateKeyStub = nil
-	fake.updateKeyReturns = struct {
-		result1 *sentry.ProjectKey
+func (fake *FakeSentryProjects) UpdateSearchReturns(result1 *sentry.SavedSearch, result2 *sentry.Response, result3 error) {
+	fake.updateSearchMutex.Lock()
+	defer fake.updateSearchMutex.Unlock()
+	fake.UpdateSearchStub = nil
+	fake.updateSearchReturns = struct {
+		result1 *sentry.SavedSearch
 		result2 *sentry.Response
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSentryProjects) UpdateKeyReturnsOnCall(i int, result1 *sentry.ProjectKey, result2 *sentry.Response, result3 error) {
-	fake.updateKeyMutex.Lock()
-	defer fake.updateKeyMutex.Unlock()
-	fake.UpdateKeyStub = nil
-	if fake.updateKeyReturnsOnCall == nil {
-		fake.updateKeyReturnsOnCall = make(map[int]struct {
-			result1 *sentry.ProjectKey
+func (fake *FakeSentryProjects) UpdateSearchReturnsOnCall(i int, result1 *sentry.SavedSearch, result2 *sentry.Response, result3 error) {
+	fake.updateSearchMutex.Lock()
+	defer fake.updateSearchMutex.Unlock()
+	fake.UpdateSearchStub = nil
+	if fake.updateSearchReturnsOnCall == nil {
+		fake.updateSearchReturnsOnCall = make(map[int]struct {
+			result1 *sentry.SavedSearch
 			result2 *sentry.Response
 			result3 error
 		})
 	}
-	fake.updateKeyReturnsOnCall[i] = struct {
-		result1 *sentry.ProjectKey
+	fake.updateSearchReturnsOnCall[i] = struct {
+		result1 *sentry.SavedSearch
 		result2 *sentry.Response
 		result3 error
 	}{result1, result2, result3}
@@ -516,18 +2870,74 @@ func (fake *FakeSentryProjects) UpdateKeyReturnsOnCall(i int, result1 *sentry.Pr
 func (fake *FakeSentryProjects) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addTeamMutex.RLock()
+	defer fake.addTeamMutex.RUnlock()
+	fake.createHookMutex.RLock()
+	defer fake.createHookMutex.RUnlock()
 	fake.createKeyMutex.RLock()
 	defer fake.createKeyMutex.RUnlock()
+	fake.createRuleMutex.RLock()
+	defer fake.createRuleMutex.RUnlock()
+	fake.createSearchMutex.RLock()
+	defer fake.createSearchMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteHookMutex.RLock()
+	defer fake.deleteHookMutex.RUnlock()
 	fake.deleteKeyMutex.RLock()
 	defer fake.deleteKeyMutex.RUnlock()
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	fake.deleteSearchMutex.RLock()
+	defer fake.deleteSearchMutex.RUnlock()
+	fake.disablePluginMutex.RLock()
+	defer fake.disablePluginMutex.RUnlock()
+	fake.enablePluginMutex.RLock()
+	defer fake.enablePluginMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getEnvironmentMutex.RLock()
+	defer fake.getEnvironmentMutex.RUnlock()
+	fake.getOwnershipMutex.RLock()
+	defer fake.getOwnershipMutex.RUnlock()
+	fake.getPluginMutex.RLock()
+	defer fake.getPluginMutex.RUnlock()
+	fake.getRuleMutex.RLock()
+	defer fake.getRuleMutex.RUnlock()
+	fake.listFiltersMutex.RLock()
+	defer fake.listFiltersMutex.RUnlock()
+	fake.listHooksMutex.RLock()
+	defer fake.listHooksMutex.RUnlock()
 	fake.listKeysMutex.RLock()
 	defer fake.listKeysMutex.RUnlock()
+	fake.listQuotasMutex.RLock()
+	defer fake.listQuotasMutex.RUnlock()
+	fake.listSearchesMutex.RLock()
+	defer fake.listSearchesMutex.RUnlock()
+	fake.removeTeamMutex.RLock()
+	defer fake.removeTeamMutex.RUnlock()
+	fake.resetPluginMutex.RLock()
+	defer fake.resetPluginMutex.RUnlock()
 	fake.updateMutex.RLock()
 	defer fake.updateMutex.RUnlock()
+	fake.updateEnvironmentMutex.RLock()
+	defer fake.updateEnvironmentMutex.RUnlock()
+	fake.updateFilterMutex.RLock()
+	defer fake.updateFilterMutex.RUnlock()
+	fake.updateHookMutex.RLock()
+	defer fake.updateHookMutex.RUnlock()
 	fake.updateKeyMutex.RLock()
 	defer fake.updateKeyMutex.RUnlock()
+	fake.updateOwnershipMutex.RLock()
+	defer fake.updateOwnershipMutex.RUnlock()
+	fake.updatePluginMutex.RLock()
+	defer fake.updatePluginMutex.RUnlock()
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	fake.updateRuleMutex.RLock()
+	defer fake.updateRuleMutex.RUnlock()
+	fake.updateSearchMutex.RLock()
+	defer fake.updateSearchMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,899 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package controllersfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+type FakeSentryReleases struct {
+	CreateStub        func(string, *sentry.CreateReleaseParams) (*sentry.Release, *sentry.Response, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.CreateReleaseParams
+	}
+	createReturns struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	CreateDeployStub        func(string, string, *sentry.CreateDeployParams) (*sentry.Deploy, *sentry.Response, error)
+	createDeployMutex       sync.RWMutex
+	createDeployArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateDeployParams
+	}
+	createDeployReturns struct {
+		result1 *sentry.Deploy
+		result2 *sentry.Response
+		result3 error
+	}
+	createDeployReturnsOnCall map[int]struct {
+		result1 *sentry.Deploy
+		result2 *sentry.Response
+		result3 error
+	}
+	DeleteStub        func(string, string) (*sentry.Response, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	DeleteFileStub        func(string, string, string, string) (*sentry.Response, error)
+	deleteFileMutex       sync.RWMutex
+	deleteFileArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	deleteFileReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteFileReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	FinalizeStub        func(string, string, time.Time) (*sentry.Release, *sentry.Response, error)
+	finalizeMutex       sync.RWMutex
+	finalizeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}
+	finalizeReturns struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	finalizeReturnsOnCall map[int]struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	GetStub        func(string, string) (*sentry.Release, *sentry.Response, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getReturns struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	ListFilesStub        func(string, string, string, *sentry.ListOptions) ([]sentry.ReleaseFile, *sentry.Response, error)
+	listFilesMutex       sync.RWMutex
+	listFilesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.ListOptions
+	}
+	listFilesReturns struct {
+		result1 []sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}
+	listFilesReturnsOnCall map[int]struct {
+		result1 []sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}
+	SetCommitsStub        func(string, string, *sentry.SetReleaseCommitsParams) (*sentry.Release, *sentry.Response, error)
+	setCommitsMutex       sync.RWMutex
+	setCommitsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.SetReleaseCommitsParams
+	}
+	setCommitsReturns struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	setCommitsReturnsOnCall map[int]struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateStub        func(string, string, *sentry.UpdateReleaseParams) (*sentry.Release, *sentry.Response, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.UpdateReleaseParams
+	}
+	updateReturns struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}
+	UploadFileStub        func(string, string, string, *sentry.UploadReleaseFileParams) (*sentry.ReleaseFile, *sentry.Response, error)
+	uploadFileMutex       sync.RWMutex
+	uploadFileArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UploadReleaseFileParams
+	}
+	uploadFileReturns struct {
+		result1 *sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}
+	uploadFileReturnsOnCall map[int]struct {
+		result1 *sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSentryReleases) Create(arg1 string, arg2 *sentry.CreateReleaseParams) (*sentry.Release, *sentry.Response, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.CreateReleaseParams
+	}{arg1, arg2})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeSentryReleases) CreateCalls(stub func(string, *sentry.CreateReleaseParams) (*sentry.Release, *sentry.Response, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeSentryReleases) CreateArgsForCall(i int) (string, *sentry.CreateReleaseParams) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryReleases) CreateReturns(result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) CreateReturnsOnCall(i int, result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Release
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) CreateDeploy(arg1 string, arg2 string, arg3 *sentry.CreateDeployParams) (*sentry.Deploy, *sentry.Response, error) {
+	fake.createDeployMutex.Lock()
+	ret, specificReturn := fake.createDeployReturnsOnCall[len(fake.createDeployArgsForCall)]
+	fake.createDeployArgsForCall = append(fake.createDeployArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.CreateDeployParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateDeploy", []interface{}{arg1, arg2, arg3})
+	fake.createDeployMutex.Unlock()
+	if fake.CreateDeployStub != nil {
+		return fake.CreateDeployStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createDeployReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) CreateDeployCallCount() int {
+	fake.createDeployMutex.RLock()
+	defer fake.createDeployMutex.RUnlock()
+	return len(fake.createDeployArgsForCall)
+}
+
+func (fake *FakeSentryReleases) CreateDeployCalls(stub func(string, string, *sentry.CreateDeployParams) (*sentry.Deploy, *sentry.Response, error)) {
+	fake.createDeployMutex.Lock()
+	defer fake.createDeployMutex.Unlock()
+	fake.CreateDeployStub = stub
+}
+
+func (fake *FakeSentryReleases) CreateDeployArgsForCall(i int) (string, string, *sentry.CreateDeployParams) {
+	fake.createDeployMutex.RLock()
+	defer fake.createDeployMutex.RUnlock()
+	argsForCall := fake.createDeployArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryReleases) CreateDeployReturns(result1 *sentry.Deploy, result2 *sentry.Response, result3 error) {
+	fake.createDeployMutex.Lock()
+	defer fake.createDeployMutex.Unlock()
+	fake.CreateDeployStub = nil
+	fake.createDeployReturns = struct {
+		result1 *sentry.Deploy
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) CreateDeployReturnsOnCall(i int, result1 *sentry.Deploy, result2 *sentry.Response, result3 error) {
+	fake.createDeployMutex.Lock()
+	defer fake.createDeployMutex.Unlock()
+	fake.CreateDeployStub = nil
+	if fake.createDeployReturnsOnCall == nil {
+		fake.createDeployReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Deploy
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createDeployReturnsOnCall[i] = struct {
+		result1 *sentry.Deploy
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) Delete(arg1 string, arg2 string) (*sentry.Response, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryReleases) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeSentryReleases) DeleteCalls(stub func(string, string) (*sentry.Response, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeSentryReleases) DeleteArgsForCall(i int) (string, string) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryReleases) DeleteReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryReleases) DeleteReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryReleases) DeleteFile(arg1 string, arg2 string, arg3 string, arg4 string) (*sentry.Response, error) {
+	fake.deleteFileMutex.Lock()
+	ret, specificReturn := fake.deleteFileReturnsOnCall[len(fake.deleteFileArgsForCall)]
+	fake.deleteFileArgsForCall = append(fake.deleteFileArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("DeleteFile", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteFileMutex.Unlock()
+	if fake.DeleteFileStub != nil {
+		return fake.DeleteFileStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteFileReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentryReleases) DeleteFileCallCount() int {
+	fake.deleteFileMutex.RLock()
+	defer fake.deleteFileMutex.RUnlock()
+	return len(fake.deleteFileArgsForCall)
+}
+
+func (fake *FakeSentryReleases) DeleteFileCalls(stub func(string, string, string, string) (*sentry.Response, error)) {
+	fake.deleteFileMutex.Lock()
+	defer fake.deleteFileMutex.Unlock()
+	fake.DeleteFileStub = stub
+}
+
+func (fake *FakeSentryReleases) DeleteFileArgsForCall(i int) (string, string, string, string) {
+	fake.deleteFileMutex.RLock()
+	defer fake.deleteFileMutex.RUnlock()
+	argsForCall := fake.deleteFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryReleases) DeleteFileReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteFileMutex.Lock()
+	defer fake.deleteFileMutex.Unlock()
+	fake.DeleteFileStub = nil
+	fake.deleteFileReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryReleases) DeleteFileReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteFileMutex.Lock()
+	defer fake.deleteFileMutex.Unlock()
+	fake.DeleteFileStub = nil
+	if fake.deleteFileReturnsOnCall == nil {
+		fake.deleteFileReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteFileReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentryReleases) Finalize(arg1 string, arg2 string, arg3 time.Time) (*sentry.Release, *sentry.Response, error) {
+	fake.finalizeMutex.Lock()
+	ret, specificReturn := fake.finalizeReturnsOnCall[len(fake.finalizeArgsForCall)]
+	fake.finalizeArgsForCall = append(fake.finalizeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Finalize", []interface{}{arg1, arg2, arg3})
+	fake.finalizeMutex.Unlock()
+	if fake.FinalizeStub != nil {
+		return fake.FinalizeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.finalizeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) FinalizeCallCount() int {
+	fake.finalizeMutex.RLock()
+	defer fake.finalizeMutex.RUnlock()
+	return len(fake.finalizeArgsForCall)
+}
+
+func (fake *FakeSentryReleases) FinalizeCalls(stub func(string, string, time.Time) (*sentry.Release, *sentry.Response, error)) {
+	fake.finalizeMutex.Lock()
+	defer fake.finalizeMutex.Unlock()
+	fake.FinalizeStub = stub
+}
+
+func (fake *FakeSentryReleases) FinalizeArgsForCall(i int) (string, string, time.Time) {
+	fake.finalizeMutex.RLock()
+	defer fake.finalizeMutex.RUnlock()
+	argsForCall := fake.finalizeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryReleases) FinalizeReturns(result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.finalizeMutex.Lock()
+	defer fake.finalizeMutex.Unlock()
+	fake.FinalizeStub = nil
+	fake.finalizeReturns = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) FinalizeReturnsOnCall(i int, result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.finalizeMutex.Lock()
+	defer fake.finalizeMutex.Unlock()
+	fake.FinalizeStub = nil
+	if fake.finalizeReturnsOnCall == nil {
+		fake.finalizeReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Release
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.finalizeReturnsOnCall[i] = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) Get(arg1 string, arg2 string) (*sentry.Release, *sentry.Response, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSentryReleases) GetCalls(stub func(string, string) (*sentry.Release, *sentry.Response, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSentryReleases) GetArgsForCall(i int) (string, string) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentryReleases) GetReturns(result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) GetReturnsOnCall(i int, result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Release
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) ListFiles(arg1 string, arg2 string, arg3 string, arg4 *sentry.ListOptions) ([]sentry.ReleaseFile, *sentry.Response, error) {
+	fake.listFilesMutex.Lock()
+	ret, specificReturn := fake.listFilesReturnsOnCall[len(fake.listFilesArgsForCall)]
+	fake.listFilesArgsForCall = append(fake.listFilesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.ListOptions
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ListFiles", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listFilesMutex.Unlock()
+	if fake.ListFilesStub != nil {
+		return fake.ListFilesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listFilesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) ListFilesCallCount() int {
+	fake.listFilesMutex.RLock()
+	defer fake.listFilesMutex.RUnlock()
+	return len(fake.listFilesArgsForCall)
+}
+
+func (fake *FakeSentryReleases) ListFilesCalls(stub func(string, string, string, *sentry.ListOptions) ([]sentry.ReleaseFile, *sentry.Response, error)) {
+	fake.listFilesMutex.Lock()
+	defer fake.listFilesMutex.Unlock()
+	fake.ListFilesStub = stub
+}
+
+func (fake *FakeSentryReleases) ListFilesArgsForCall(i int) (string, string, string, *sentry.ListOptions) {
+	fake.listFilesMutex.RLock()
+	defer fake.listFilesMutex.RUnlock()
+	argsForCall := fake.listFilesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryReleases) ListFilesReturns(result1 []sentry.ReleaseFile, result2 *sentry.Response, result3 error) {
+	fake.listFilesMutex.Lock()
+	defer fake.listFilesMutex.Unlock()
+	fake.ListFilesStub = nil
+	fake.listFilesReturns = struct {
+		result1 []sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) ListFilesReturnsOnCall(i int, result1 []sentry.ReleaseFile, result2 *sentry.Response, result3 error) {
+	fake.listFilesMutex.Lock()
+	defer fake.listFilesMutex.Unlock()
+	fake.ListFilesStub = nil
+	if fake.listFilesReturnsOnCall == nil {
+		fake.listFilesReturnsOnCall = make(map[int]struct {
+			result1 []sentry.ReleaseFile
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listFilesReturnsOnCall[i] = struct {
+		result1 []sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) SetCommits(arg1 string, arg2 string, arg3 *sentry.SetReleaseCommitsParams) (*sentry.Release, *sentry.Response, error) {
+	fake.setCommitsMutex.Lock()
+	ret, specificReturn := fake.setCommitsReturnsOnCall[len(fake.setCommitsArgsForCall)]
+	fake.setCommitsArgsForCall = append(fake.setCommitsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.SetReleaseCommitsParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetCommits", []interface{}{arg1, arg2, arg3})
+	fake.setCommitsMutex.Unlock()
+	if fake.SetCommitsStub != nil {
+		return fake.SetCommitsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.setCommitsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) SetCommitsCallCount() int {
+	fake.setCommitsMutex.RLock()
+	defer fake.setCommitsMutex.RUnlock()
+	return len(fake.setCommitsArgsForCall)
+}
+
+func (fake *FakeSentryReleases) SetCommitsCalls(stub func(string, string, *sentry.SetReleaseCommitsParams) (*sentry.Release, *sentry.Response, error)) {
+	fake.setCommitsMutex.Lock()
+	defer fake.setCommitsMutex.Unlock()
+	fake.SetCommitsStub = stub
+}
+
+func (fake *FakeSentryReleases) SetCommitsArgsForCall(i int) (string, string, *sentry.SetReleaseCommitsParams) {
+	fake.setCommitsMutex.RLock()
+	defer fake.setCommitsMutex.RUnlock()
+	argsForCall := fake.setCommitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryReleases) SetCommitsReturns(result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.setCommitsMutex.Lock()
+	defer fake.setCommitsMutex.Unlock()
+	fake.SetCommitsStub = nil
+	fake.setCommitsReturns = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) SetCommitsReturnsOnCall(i int, result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.setCommitsMutex.Lock()
+	defer fake.setCommitsMutex.Unlock()
+	fake.SetCommitsStub = nil
+	if fake.setCommitsReturnsOnCall == nil {
+		fake.setCommitsReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Release
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.setCommitsReturnsOnCall[i] = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) Update(arg1 string, arg2 string, arg3 *sentry.UpdateReleaseParams) (*sentry.Release, *sentry.Response, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *sentry.UpdateReleaseParams
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeSentryReleases) UpdateCalls(stub func(string, string, *sentry.UpdateReleaseParams) (*sentry.Release, *sentry.Response, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeSentryReleases) UpdateArgsForCall(i int) (string, string, *sentry.UpdateReleaseParams) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSentryReleases) UpdateReturns(result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) UpdateReturnsOnCall(i int, result1 *sentry.Release, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Release
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *sentry.Release
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) UploadFile(arg1 string, arg2 string, arg3 string, arg4 *sentry.UploadReleaseFileParams) (*sentry.ReleaseFile, *sentry.Response, error) {
+	fake.uploadFileMutex.Lock()
+	ret, specificReturn := fake.uploadFileReturnsOnCall[len(fake.uploadFileArgsForCall)]
+	fake.uploadFileArgsForCall = append(fake.uploadFileArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 *sentry.UploadReleaseFileParams
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UploadFile", []interface{}{arg1, arg2, arg3, arg4})
+	fake.uploadFileMutex.Unlock()
+	if fake.UploadFileStub != nil {
+		return fake.UploadFileStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.uploadFileReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentryReleases) UploadFileCallCount() int {
+	fake.uploadFileMutex.RLock()
+	defer fake.uploadFileMutex.RUnlock()
+	return len(fake.uploadFileArgsForCall)
+}
+
+func (fake *FakeSentryReleases) UploadFileCalls(stub func(string, string, string, *sentry.UploadReleaseFileParams) (*sentry.ReleaseFile, *sentry.Response, error)) {
+	fake.uploadFileMutex.Lock()
+	defer fake.uploadFileMutex.Unlock()
+	fake.UploadFileStub = stub
+}
+
+func (fake *FakeSentryReleases) UploadFileArgsForCall(i int) (string, string, string, *sentry.UploadReleaseFileParams) {
+	fake.uploadFileMutex.RLock()
+	defer fake.uploadFileMutex.RUnlock()
+	argsForCall := fake.uploadFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSentryReleases) UploadFileReturns(result1 *sentry.ReleaseFile, result2 *sentry.Response, result3 error) {
+	fake.uploadFileMutex.Lock()
+	defer fake.uploadFileMutex.Unlock()
+	fake.UploadFileStub = nil
+	fake.uploadFileReturns = struct {
+		result1 *sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) UploadFileReturnsOnCall(i int, result1 *sentry.ReleaseFile, result2 *sentry.Response, result3 error) {
+	fake.uploadFileMutex.Lock()
+	defer fake.uploadFileMutex.Unlock()
+	fake.UploadFileStub = nil
+	if fake.uploadFileReturnsOnCall == nil {
+		fake.uploadFileReturnsOnCall = make(map[int]struct {
+			result1 *sentry.ReleaseFile
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.uploadFileReturnsOnCall[i] = struct {
+		result1 *sentry.ReleaseFile
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentryReleases) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createDeployMutex.RLock()
+	defer fake.createDeployMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteFileMutex.RLock()
+	defer fake.deleteFileMutex.RUnlock()
+	fake.finalizeMutex.RLock()
+	defer fake.finalizeMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.listFilesMutex.RLock()
+	defer fake.listFilesMutex.RUnlock()
+	fake.setCommitsMutex.RLock()
+	defer fake.setCommitsMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.uploadFileMutex.RLock()
+	defer fake.uploadFileMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSentryReleases) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.SentryReleases = new(FakeSentryReleases)
@@ -0,0 +1,613 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package controllersfakes
+
+import (
+	"sync"
+
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+type FakeSentrySentryApps struct {
+	CreateStub        func(*sentry.CreateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 *sentry.CreateSentryAppParams
+	}
+	createReturns struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}
+	CreateTokenStub        func(string) (*sentry.SentryAppToken, *sentry.Response, error)
+	createTokenMutex       sync.RWMutex
+	createTokenArgsForCall []struct {
+		arg1 string
+	}
+	createTokenReturns struct {
+		result1 *sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}
+	createTokenReturnsOnCall map[int]struct {
+		result1 *sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}
+	DeleteStub        func(string) (*sentry.Response, error)
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+	}
+	deleteReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	DeleteTokenStub        func(string, string) (*sentry.Response, error)
+	deleteTokenMutex       sync.RWMutex
+	deleteTokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteTokenReturns struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	deleteTokenReturnsOnCall map[int]struct {
+		result1 *sentry.Response
+		result2 error
+	}
+	GetStub        func(string) (*sentry.SentryApp, *sentry.Response, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getReturns struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}
+	ListTokensStub        func(string) ([]sentry.SentryAppToken, *sentry.Response, error)
+	listTokensMutex       sync.RWMutex
+	listTokensArgsForCall []struct {
+		arg1 string
+	}
+	listTokensReturns struct {
+		result1 []sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}
+	listTokensReturnsOnCall map[int]struct {
+		result1 []sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}
+	UpdateStub        func(string, *sentry.UpdateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error)
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 string
+		arg2 *sentry.UpdateSentryAppParams
+	}
+	updateReturns struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSentrySentryApps) Create(arg1 *sentry.CreateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 *sentry.CreateSentryAppParams
+	}{arg1})
+	fake.recordInvocation("Create", []interface{}{arg1})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentrySentryApps) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeSentrySentryApps) CreateCalls(stub func(*sentry.CreateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error)) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeSentrySentryApps) CreateArgsForCall(i int) *sentry.CreateSentryAppParams {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSentrySentryApps) CreateReturns(result1 *sentry.SentryApp, result2 *sentry.Response, result3 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) CreateReturnsOnCall(i int, result1 *sentry.SentryApp, result2 *sentry.Response, result3 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 *sentry.SentryApp
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) CreateToken(arg1 string) (*sentry.SentryAppToken, *sentry.Response, error) {
+	fake.createTokenMutex.Lock()
+	ret, specificReturn := fake.createTokenReturnsOnCall[len(fake.createTokenArgsForCall)]
+	fake.createTokenArgsForCall = append(fake.createTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateToken", []interface{}{arg1})
+	fake.createTokenMutex.Unlock()
+	if fake.CreateTokenStub != nil {
+		return fake.CreateTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createTokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentrySentryApps) CreateTokenCallCount() int {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	return len(fake.createTokenArgsForCall)
+}
+
+func (fake *FakeSentrySentryApps) CreateTokenCalls(stub func(string) (*sentry.SentryAppToken, *sentry.Response, error)) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = stub
+}
+
+func (fake *FakeSentrySentryApps) CreateTokenArgsForCall(i int) string {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	argsForCall := fake.createTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSentrySentryApps) CreateTokenReturns(result1 *sentry.SentryAppToken, result2 *sentry.Response, result3 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	fake.createTokenReturns = struct {
+		result1 *sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) CreateTokenReturnsOnCall(i int, result1 *sentry.SentryAppToken, result2 *sentry.Response, result3 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	if fake.createTokenReturnsOnCall == nil {
+		fake.createTokenReturnsOnCall = make(map[int]struct {
+			result1 *sentry.SentryAppToken
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.createTokenReturnsOnCall[i] = struct {
+		result1 *sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) Delete(arg1 string) (*sentry.Response, error) {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Delete", []interface{}{arg1})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentrySentryApps) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeSentrySentryApps) DeleteCalls(stub func(string) (*sentry.Response, error)) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeSentrySentryApps) DeleteArgsForCall(i int) string {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSentrySentryApps) DeleteReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentrySentryApps) DeleteReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentrySentryApps) DeleteToken(arg1 string, arg2 string) (*sentry.Response, error) {
+	fake.deleteTokenMutex.Lock()
+	ret, specificReturn := fake.deleteTokenReturnsOnCall[len(fake.deleteTokenArgsForCall)]
+	fake.deleteTokenArgsForCall = append(fake.deleteTokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteToken", []interface{}{arg1, arg2})
+	fake.deleteTokenMutex.Unlock()
+	if fake.DeleteTokenStub != nil {
+		return fake.DeleteTokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSentrySentryApps) DeleteTokenCallCount() int {
+	fake.deleteTokenMutex.RLock()
+	defer fake.deleteTokenMutex.RUnlock()
+	return len(fake.deleteTokenArgsForCall)
+}
+
+func (fake *FakeSentrySentryApps) DeleteTokenCalls(stub func(string, string) (*sentry.Response, error)) {
+	fake.deleteTokenMutex.Lock()
+	defer fake.deleteTokenMutex.Unlock()
+	fake.DeleteTokenStub = stub
+}
+
+func (fake *FakeSentrySentryApps) DeleteTokenArgsForCall(i int) (string, string) {
+	fake.deleteTokenMutex.RLock()
+	defer fake.deleteTokenMutex.RUnlock()
+	argsForCall := fake.deleteTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentrySentryApps) DeleteTokenReturns(result1 *sentry.Response, result2 error) {
+	fake.deleteTokenMutex.Lock()
+	defer fake.deleteTokenMutex.Unlock()
+	fake.DeleteTokenStub = nil
+	fake.deleteTokenReturns = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentrySentryApps) DeleteTokenReturnsOnCall(i int, result1 *sentry.Response, result2 error) {
+	fake.deleteTokenMutex.Lock()
+	defer fake.deleteTokenMutex.Unlock()
+	fake.DeleteTokenStub = nil
+	if fake.deleteTokenReturnsOnCall == nil {
+		fake.deleteTokenReturnsOnCall = make(map[int]struct {
+			result1 *sentry.Response
+			result2 error
+		})
+	}
+	fake.deleteTokenReturnsOnCall[i] = struct {
+		result1 *sentry.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSentrySentryApps) Get(arg1 string) (*sentry.SentryApp, *sentry.Response, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentrySentryApps) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSentrySentryApps) GetCalls(stub func(string) (*sentry.SentryApp, *sentry.Response, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeSentrySentryApps) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSentrySentryApps) GetReturns(result1 *sentry.SentryApp, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) GetReturnsOnCall(i int, result1 *sentry.SentryApp, result2 *sentry.Response, result3 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 *sentry.SentryApp
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) ListTokens(arg1 string) ([]sentry.SentryAppToken, *sentry.Response, error) {
+	fake.listTokensMutex.Lock()
+	ret, specificReturn := fake.listTokensReturnsOnCall[len(fake.listTokensArgsForCall)]
+	fake.listTokensArgsForCall = append(fake.listTokensArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ListTokens", []interface{}{arg1})
+	fake.listTokensMutex.Unlock()
+	if fake.ListTokensStub != nil {
+		return fake.ListTokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listTokensReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentrySentryApps) ListTokensCallCount() int {
+	fake.listTokensMutex.RLock()
+	defer fake.listTokensMutex.RUnlock()
+	return len(fake.listTokensArgsForCall)
+}
+
+func (fake *FakeSentrySentryApps) ListTokensCalls(stub func(string) ([]sentry.SentryAppToken, *sentry.Response, error)) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = stub
+}
+
+func (fake *FakeSentrySentryApps) ListTokensArgsForCall(i int) string {
+	fake.listTokensMutex.RLock()
+	defer fake.listTokensMutex.RUnlock()
+	argsForCall := fake.listTokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSentrySentryApps) ListTokensReturns(result1 []sentry.SentryAppToken, result2 *sentry.Response, result3 error) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = nil
+	fake.listTokensReturns = struct {
+		result1 []sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) ListTokensReturnsOnCall(i int, result1 []sentry.SentryAppToken, result2 *sentry.Response, result3 error) {
+	fake.listTokensMutex.Lock()
+	defer fake.listTokensMutex.Unlock()
+	fake.ListTokensStub = nil
+	if fake.listTokensReturnsOnCall == nil {
+		fake.listTokensReturnsOnCall = make(map[int]struct {
+			result1 []sentry.SentryAppToken
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.listTokensReturnsOnCall[i] = struct {
+		result1 []sentry.SentryAppToken
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) Update(arg1 string, arg2 *sentry.UpdateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error) {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 string
+		arg2 *sentry.UpdateSentryAppParams
+	}{arg1, arg2})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSentrySentryApps) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeSentrySentryApps) UpdateCalls(stub func(string, *sentry.UpdateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error)) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeSentrySentryApps) UpdateArgsForCall(i int) (string, *sentry.UpdateSentryAppParams) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSentrySentryApps) UpdateReturns(result1 *sentry.SentryApp, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) UpdateReturnsOnCall(i int, result1 *sentry.SentryApp, result2 *sentry.Response, result3 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 *sentry.SentryApp
+			result2 *sentry.Response
+			result3 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 *sentry.SentryApp
+		result2 *sentry.Response
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSentrySentryApps) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.deleteTokenMutex.RLock()
+	defer fake.deleteTokenMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.listTokensMutex.RLock()
+	defer fake.listTokensMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSentrySentryApps) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.SentrySentryApps = new(FakeSentrySentryApps)
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	CredentialsOrganizationKey = "SENTRY_ORGANIZATION"
+	CredentialsTokenKey        = "SENTRY_TOKEN"
+	CredentialsURLKey          = "SENTRY_URL"
+)
+
+// Credentials configures how namespace-scoped SentryCredentials are resolved.
+type Credentials struct {
+	// Namespaced enables namespace-scoped SentryCredentials. If false, SentryCredentials are ignored and are neither
+	// listed nor watched, so that every namespace uses the operator-wide Sentry organization and client.
+	Namespaced bool
+
+	// NewClient creates a client for communicating with the Sentry API using the given token and Sentry URL.
+	NewClient func(token string, sentryURL *url.URL) *SentryClient
+
+	// DefaultNamespaces restricts the namespaces that are allowed to fall back to the operator-wide Sentry
+	// organization and client when they don't contain any SentryCredentials. All namespaces are allowed if empty.
+	DefaultNamespaces []string
+
+	// AdoptNamespaces restricts the namespaces that are allowed to adopt existing Sentry resources using the operator-wide
+	// Sentry organization and client. Namespaces with their own SentryCredentials can always adopt Sentry resources.
+	AdoptNamespaces []string
+
+	// IntegrationScopes restricts the permission scopes that InternalIntegrations are allowed to request using the
+	// operator-wide Sentry organization and client. All scopes except admin scopes are allowed if empty.
+	IntegrationScopes []string
+
+	// DeletionTimeout is how long the deletion of a resource is retried while its Sentry credentials can't be resolved,
+	// before our finalizer is removed without deleting its Sentry resource.
+	DeletionTimeout time.Duration
+}
+
+// finalizedObject is a Custom Resource that carries one of our finalizers.
+type finalizedObject interface {
+	metav1.Object
+	runtime.Object
+}
+
+// forNamespace returns the Sentry organization and client to be used for resources in the given namespace. This is
+// resolved from the namespace's SentryCredentials if one exists and namespace-scoped credentials are enabled, otherwise
+// the operator-wide Sentry organization and client are used if the namespace is allowed to fall back to them.
+func (s *Sentry) forNamespace(ctx context.Context, c client.Reader, namespace string) (*Sentry, error) {
+	if s.Credentials == nil {
+		return s, nil
+	}
+
+	if !s.Credentials.Namespaced {
+		if !s.Credentials.allowsDefault(namespace) {
+			return nil, fmt.Errorf("namespace %s is not allowed to use the default Sentry credentials", namespace)
+		}
+		return s, nil
+	}
+
+	var credentialsList sentryv1alpha1.SentryCredentialsList
+	if err := c.List(ctx, &credentialsList, client.InNamespace(namespace)); err != nil {
+		return nil, retryableError{err}
+	}
+
+	switch len(credentialsList.Items) {
+	case 0:
+		if !s.Credentials.allowsDefault(namespace) {
+			// Retry as the error might get resolved once SentryCredentials are created in the namespace
+			return nil, retryableError{fmt.Errorf("namespace %s has no SentryCredentials and is not allowed to use the default Sentry credentials", namespace)}
+		}
+		return s, nil
+	case 1:
+		return s.fromCredentials(ctx, c, &credentialsList.Items[0])
+	default:
+		return nil, fmt.Errorf("namespace %s has more than one SentryCredentials", namespace)
+	}
+}
+
+// fromCredentials returns the Sentry organization and client configured by the Secret referenced in the given
+// SentryCredentials.
+func (s *Sentry) fromCredentials(ctx context.Context, c client.Reader, credentials *sentryv1alpha1.SentryCredentials) (*Sentry, error) {
+	var secret corev1.Secret
+	key := client.ObjectKey{Namespace: credentials.Namespace, Name: credentials.Spec.SecretRef.Name}
+	if err := c.Get(ctx, key, &secret); err != nil {
+		// Retry as the error might get resolved once the Secret is created
+		return nil, retryableError{fmt.Errorf("failed to fetch Secret for SentryCredentials %s: %w", credentials.Name, err)}
+	}
+
+	organization := string(secret.Data[CredentialsOrganizationKey])
+	if organization == "" {
+		return nil, fmt.Errorf("Secret %s is missing required key %s", secret.Name, CredentialsOrganizationKey)
+	}
+
+	token := string(secret.Data[CredentialsTokenKey])
+	if token == "" {
+		return nil, fmt.Errorf("Secret %s is missing required key %s", secret.Name, CredentialsTokenKey)
+	}
+
+	rawURL := string(secret.Data[CredentialsURLKey])
+	if rawURL == "" {
+		rawURL = sentry.DefaultSentryURL
+	}
+
+	sentryURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("Secret %s contains an invalid %s: %w", secret.Name, CredentialsURLKey, err)
+	}
+
+	return &Sentry{
+		Organization: organization,
+		Client:       s.Credentials.NewClient(token, sentryURL),
+		Credentials:  s.Credentials,
+		namespaced:   true,
+	}, nil
+}
+
+// abandonDeletion handles an error resolving the Sentry credentials of the given object. If the object is being deleted
+// and its Sentry credentials have not been resolved for longer than the deletion timeout, our finalizer is removed
+// without deleting its Sentry resource so that the deletion of the object, and of its namespace, doesn't hang
+// indefinitely. Until then, the error is returned as retryable so that the deletion gets retried. Returns true if our
+// finalizer was removed.
+func (s *Sentry) abandonDeletion(ctx context.Context, c client.Writer, obj finalizedObject, finalizer string, err error) (bool, error) {
+	if s.Credentials == nil || obj.GetDeletionTimestamp().IsZero() || !containsFinalizer(obj.GetFinalizers(), finalizer) {
+		return false, err
+	}
+
+	if time.Since(obj.GetDeletionTimestamp().Time) < s.Credentials.DeletionTimeout {
+		return false, retryableError{err}
+	}
+
+	obj.SetFinalizers(removeFinalizer(obj.GetFinalizers(), finalizer))
+	if err := c.Update(ctx, obj); err != nil {
+		return false, retryableError{err}
+	}
+
+	return true, nil
+}
+
+// allowsScope reports whether InternalIntegrations are allowed to request the given permission scope, whose token can
+// access the whole Sentry organization. Namespaces using their own SentryCredentials can request any scope granted by
+// their own token, while namespaces falling back to the operator-wide Sentry organization are restricted to the allowed
+// scopes, which exclude admin scopes by default.
+func (s *Sentry) allowsScope(scope string) bool {
+	if s.namespaced {
+		return true
+	}
+
+	if s.Credentials == nil || len(s.Credentials.IntegrationScopes) == 0 {
+		return !strings.HasSuffix(scope, ":admin")
+	}
+
+	return containsString(s.Credentials.IntegrationScopes, scope)
+}
+
+func (c *Credentials) allowsDefault(namespace string) bool {
+	if len(c.DefaultNamespaces) == 0 {
+		return true
+	}
+
+	for _, ns := range c.DefaultNamespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+
+	return false
+}
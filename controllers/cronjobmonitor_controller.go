@@ -0,0 +1,202 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+)
+
+// CronJobMonitorReconciler creates a Monitor for each CronJob annotated with a Sentry project, mirroring the CronJob's
+// schedule, timezone and max runtime. The Monitors are owned by the CronJob, and reconciled against Sentry by the
+// MonitorReconciler.
+type CronJobMonitorReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+// CronJobGroupVersionKind is the GroupVersionKind of the CronJobs that we watch. As our Kubernetes dependencies predate
+// batch/v1 CronJobs, they are watched and read as unstructured objects.
+var CronJobGroupVersionKind = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}
+
+// ErrCronJobsNotServed is returned when setting up the CronJobMonitorReconciler against a cluster that doesn't serve
+// batch/v1 CronJobs, which were added in Kubernetes 1.21.
+var ErrCronJobsNotServed = errors.New("batch/v1 CronJobs are not served by the cluster")
+
+func (r *CronJobMonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if _, err := mgr.GetRESTMapper().RESTMapping(CronJobGroupVersionKind.GroupKind(), CronJobGroupVersionKind.Version); err != nil {
+		return fmt.Errorf("%w: %v", ErrCronJobsNotServed, err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newCronJob()).
+		Owns(&sentryv1alpha1.Monitor{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=monitors,verbs=get;list;watch;create;update;patch;delete
+
+func (r *CronJobMonitorReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("cronjob", req.NamespacedName)
+
+	cronjob := newCronJob()
+	if err := r.Get(ctx, req.NamespacedName, cronjob); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch CronJob")
+		return ctrl.Result{}, err
+	}
+
+	// Ignore CronJobs being deleted as their Monitors get garbage collected
+	if !cronjob.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Delete the Monitor of CronJobs that have opted out, or ignore them if they never opted in
+	projectSlug := cronjob.GetAnnotations()[sentryv1alpha1.MonitorProjectAnnotation]
+	if projectSlug == "" {
+		deleted, err := r.deleteMonitor(ctx, cronjob)
+		if err != nil {
+			log.Error(err, "failed to delete Monitor", "monitor", cronjob.GetName())
+			return ctrl.Result{}, err
+		}
+
+		if deleted {
+			log.Info("successfully deleted Monitor", "monitor", cronjob.GetName())
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	cron, _, _ := unstructured.NestedString(cronjob.Object, "spec", "schedule")
+	schedule, timezone := cronJobSchedule(cron)
+	if tz, _, _ := unstructured.NestedString(cronjob.Object, "spec", "timeZone"); tz != "" {
+		timezone = tz
+	}
+	if tz, ok := cronjob.GetAnnotations()[sentryv1alpha1.MonitorTimezoneAnnotation]; ok {
+		timezone = tz
+	}
+
+	monitor := &sentryv1alpha1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cronjob.GetName(),
+			Namespace: cronjob.GetNamespace(),
+		},
+	}
+
+	op, err := ctrl.CreateOrUpdate(ctx, r.Client, monitor, func() error {
+		monitor.Spec.Project = projectSlug
+		monitor.Spec.Name = cronjob.GetNamespace() + "/" + cronjob.GetName()
+		monitor.Spec.Schedule = schedule
+		monitor.Spec.Timezone = timezone
+		monitor.Spec.MaxRuntime = cronJobMaxRuntime(cronjob)
+		return ctrl.SetControllerReference(cronjob, monitor, r.Scheme)
+	})
+	if err != nil {
+		log.Error(err, "failed to reconcile Monitor", "monitor", monitor.Name)
+		return ctrl.Result{}, err
+	}
+
+	log.Info("successfully reconciled Monitor", "monitor", monitor.Name, "operation", op)
+
+	return ctrl.Result{}, nil
+}
+
+// deleteMonitor deletes the Monitor owned by the CronJob, returning whether there was one to delete. Monitors that share
+// the CronJob's name but aren't owned by it are left untouched.
+func (r *CronJobMonitorReconciler) deleteMonitor(ctx context.Context, cronjob *unstructured.Unstructured) (bool, error) {
+	var monitor sentryv1alpha1.Monitor
+	if err := r.Get(ctx, types.NamespacedName{Name: cronjob.GetName(), Namespace: cronjob.GetNamespace()}, &monitor); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	if !metav1.IsControlledBy(&monitor, cronjob) || !monitor.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, &monitor); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return true, nil
+}
+
+// newCronJob returns an empty unstructured batch/v1 CronJob.
+func newCronJob() *unstructured.Unstructured {
+	cronjob := &unstructured.Unstructured{}
+	cronjob.SetGroupVersionKind(CronJobGroupVersionKind)
+	return cronjob
+}
+
+// cronJobSchedule splits the given CronJob schedule into its crontab schedule and the timezone of any CRON_TZ or TZ
+// prefix, which Kubernetes tolerates but Sentry doesn't accept as part of a schedule.
+func cronJobSchedule(schedule string) (string, string) {
+	schedule = strings.TrimSpace(schedule)
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if strings.HasPrefix(schedule, prefix) {
+			fields := strings.SplitN(schedule, " ", 2)
+			if len(fields) < 2 {
+				return schedule, ""
+			}
+
+			return strings.TrimSpace(fields[1]), strings.TrimPrefix(fields[0], prefix)
+		}
+	}
+
+	return schedule, ""
+}
+
+// cronJobMaxRuntime returns the active deadline of the CronJob's jobs rounded up to the nearest minute, or nil if the
+// jobs have no deadline.
+func cronJobMaxRuntime(cronjob *unstructured.Unstructured) *int {
+	deadline, found, err := unstructured.NestedInt64(cronjob.Object, "spec", "jobTemplate", "spec", "activeDeadlineSeconds")
+	if !found || err != nil || deadline <= 0 {
+		return nil
+	}
+
+	minutes := int((deadline + 59) / 60)
+	return &minutes
+}
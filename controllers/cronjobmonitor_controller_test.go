@@ -0,0 +1,171 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("CronJobMonitorReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		cronjobName      = "test-cronjob"
+		cronjobNamespace = "test-cronjob-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		cronjob   *unstructured.Unstructured
+		monitor   *sentryv1alpha1.Monitor
+	)
+
+	ctx := context.Background()
+
+	request := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "batch/v1",
+			"kind":       "CronJob",
+			"metadata": map[string]interface{}{
+				"name":      cronjobName,
+				"namespace": cronjobNamespace,
+				"annotations": map[string]interface{}{
+					sentryv1alpha1.MonitorProjectAnnotation: "test-project",
+				},
+			},
+			"spec": map[string]interface{}{
+				"schedule": "CRON_TZ=Europe/London 0 2 * * *",
+				"jobTemplate": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"activeDeadlineSeconds": int64(1790),
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"restartPolicy": "OnFailure",
+								"containers": []interface{}{
+									map[string]interface{}{
+										"name":  "backup",
+										"image": "backup:1.0.0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		if !cronJobsServed {
+			Skip("batch/v1 CronJobs are not served by the test control plane")
+		}
+
+		lookupKey = types.NamespacedName{Name: cronjobName, Namespace: cronjobNamespace}
+		cronjob = new(unstructured.Unstructured)
+		cronjob.SetGroupVersionKind(controllers.CronJobGroupVersionKind)
+		monitor = new(sentryv1alpha1.Monitor)
+
+		created := testSentryMonitor("d1b7e2a3", "test-cronjob", "test-project", "0 2 * * *")
+		fakeSentryMonitors.CreateReturns(created, newSentryResponse(http.StatusCreated), nil)
+		fakeSentryMonitors.GetReturns(created, newSentryResponse(http.StatusOK), nil)
+		fakeSentryMonitors.UpdateReturns(created, newSentryResponse(http.StatusOK), nil)
+	})
+
+	Context("when creating an annotated CronJob", func() {
+		It("a Monitor gets created for the CronJob's schedule", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected spec")
+			Eventually(func() (*sentryv1alpha1.MonitorSpec, error) {
+				err := k8sClient.Get(ctx, lookupKey, monitor)
+				if err != nil {
+					return nil, err
+				}
+				return &monitor.Spec, nil
+			}, timeout, interval).Should(Equal(&sentryv1alpha1.MonitorSpec{
+				Project:    "test-project",
+				Name:       "test-cronjob-namespace/test-cronjob",
+				Schedule:   "0 2 * * *",
+				Timezone:   "Europe/London",
+				MaxRuntime: sentry.Int(30),
+			}))
+
+			By("owned by the CronJob")
+			Expect(monitor.OwnerReferences).To(HaveLen(1))
+			Expect(monitor.OwnerReferences[0].Name).To(Equal(cronjobName))
+		})
+	})
+
+	Context("when the CronJob's schedule changes", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, cronjob)).To(Succeed())
+
+			Expect(unstructured.SetNestedField(cronjob.Object, "0 4 * * *", "spec", "schedule")).To(Succeed())
+			Expect(unstructured.SetNestedField(cronjob.Object, "America/New_York", "spec", "timeZone")).To(Succeed())
+		})
+
+		It("the Monitor gets updated", func() {
+			Expect(k8sClient.Update(ctx, cronjob)).To(Succeed())
+
+			Eventually(func() ([]string, error) {
+				err := k8sClient.Get(ctx, lookupKey, monitor)
+				if err != nil {
+					return nil, err
+				}
+				return []string{monitor.Spec.Schedule, monitor.Spec.Timezone}, nil
+			}, timeout, interval).Should(Equal([]string{"0 4 * * *", "America/New_York"}))
+		})
+	})
+
+	Context("when the CronJob's timezone annotation is set", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, cronjob)).To(Succeed())
+
+			annotations := cronjob.GetAnnotations()
+			annotations[sentryv1alpha1.MonitorTimezoneAnnotation] = "Asia/Tokyo"
+			cronjob.SetAnnotations(annotations)
+		})
+
+		It("the Monitor's timezone gets overridden", func() {
+			Expect(k8sClient.Update(ctx, cronjob)).To(Succeed())
+
+			Eventually(func() (string, error) {
+				err := k8sClient.Get(ctx, lookupKey, monitor)
+				if err != nil {
+					return "", err
+				}
+				return monitor.Spec.Timezone, nil
+			}, timeout, interval).Should(Equal("Asia/Tokyo"))
+		})
+	})
+
+	Context("when the CronJob's annotation is removed", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, cronjob)).To(Succeed())
+
+			fakeSentryMonitors.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+
+			annotations := cronjob.GetAnnotations()
+			delete(annotations, sentryv1alpha1.MonitorProjectAnnotation)
+			cronjob.SetAnnotations(annotations)
+		})
+
+		It("the Monitor gets deleted", func() {
+			Expect(k8sClient.Update(ctx, cronjob)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, monitor)
+			}, timeout, interval).ShouldNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,508 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	"sigs.k8s.io/yaml"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	DashboardFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/dashboard"
+)
+
+// DashboardReconciler reconciles a Dashboard object
+type DashboardReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *DashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.Dashboard{}).
+		// Apply changes to the ConfigMaps referenced by our widgets
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.dashboardsForConfigMap),
+		}).
+		WithEventFilter(&referenceChangedPredicate{}).
+		Complete(r)
+}
+
+// dashboardsForConfigMap maps a ConfigMap to the Dashboards in its namespace whose widgets reference it.
+func (r *DashboardReconciler) dashboardsForConfigMap(obj handler.MapObject) []reconcile.Request {
+	var dashboardList sentryv1alpha1.DashboardList
+	if err := r.List(context.Background(), &dashboardList, client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list Dashboards", "configmap", obj.Meta.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, dashboard := range dashboardList.Items {
+		source := dashboard.Spec.WidgetsFrom
+		if source != nil && source.ConfigMapKeyRef != nil && source.ConfigMapKeyRef.Name == obj.Meta.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: dashboard.Namespace, Name: dashboard.Name},
+			})
+		}
+	}
+
+	return requests
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=dashboards,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=dashboards/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *DashboardReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("dashboard", req.NamespacedName)
+
+	var dashboard sentryv1alpha1.Dashboard
+	if err := r.Get(ctx, req.NamespacedName, &dashboard); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch Dashboard")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &dashboard, DashboardFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+	}
+
+	hasFinalizer := containsFinalizer(dashboard.GetFinalizers(), DashboardFinalizerName)
+
+	// Create our Sentry resource if we have not been synced before, unless we've been asked to adopt an existing Sentry
+	// resource
+	adoptID, adopt := dashboard.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if dashboard.Status.LastSynced.IsZero() && !adopt {
+		if err := r.handleCreate(ctx, sc, &dashboard, hasFinalizer); err != nil {
+			log.Error(err, "failed to create Dashboard")
+			return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+		}
+
+		log.Info("successfully created Dashboard")
+		return ctrl.Result{}, nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if dashboard.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &dashboard, DashboardFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt Dashboard")
+			return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+		}
+
+		dashboard.Status.ID = adoptID
+		log.Info("adopting existing Sentry dashboard", "id", adoptID)
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, err := r.getExistingState(sc, dashboard)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry dashboard state")
+		return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !dashboard.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &dashboard, existing); err != nil {
+				log.Error(err, "failed to delete Dashboard")
+				return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+			}
+		}
+
+		log.Info("successfully deleted Dashboard")
+		return ctrl.Result{}, nil
+	}
+
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && dashboard.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry dashboard %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt Dashboard")
+		return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		if err := r.handleCreate(ctx, sc, &dashboard, hasFinalizer); err != nil {
+			log.Error(err, "failed to recreate Dashboard")
+			return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+		}
+
+		log.Info("successfully recreated Dashboard")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	if err := r.handleUpdate(ctx, sc, &dashboard, existing); err != nil {
+		log.Error(err, "failed to update Dashboard")
+		return ctrl.Result{}, r.handleError(ctx, &dashboard, err)
+	}
+
+	log.Info("successfully updated Dashboard")
+
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
+// returns an ErrOutOfSync error if the resource cannot be found.
+func (r *DashboardReconciler) getExistingState(sc *Sentry, dashboard sentryv1alpha1.Dashboard) (*sentry.Dashboard, error) {
+	sDashboard, resp, err := sc.Client.Dashboards.Get(sc.Organization, dashboard.Status.ID)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrOutOfSync
+		case resp.StatusCode == http.StatusFound:
+			return nil, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our spec
+			return nil, err
+		}
+	}
+
+	return sDashboard, nil
+}
+
+func (r *DashboardReconciler) handleCreate(ctx context.Context, sc *Sentry, dashboard *sentryv1alpha1.Dashboard, hasFinalizer bool) error {
+	widgets, err := r.dashboardWidgets(ctx, dashboard)
+	if err != nil {
+		return err
+	}
+
+	sDashboard, resp, err := sc.Client.Dashboards.Create(sc.Organization, &sentry.CreateDashboardParams{
+		Title:   dashboard.Spec.Title,
+		Widgets: widgets,
+	})
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		case resp.StatusCode == http.StatusFound:
+			// Retry on 302 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return err
+		}
+	}
+
+	dashboard.Status.Condition = sentryv1alpha1.DashboardConditionCreated
+	dashboard.Status.Message = ""
+	dashboard.Status.ID = sDashboard.ID
+	dashboard.Status.Widgets = len(widgets)
+	dashboard.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, dashboard); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		dashboard.SetFinalizers(append(dashboard.GetFinalizers(), DashboardFinalizerName))
+		if err := r.Update(ctx, dashboard); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *DashboardReconciler) handleDelete(ctx context.Context, sc *Sentry, dashboard *sentryv1alpha1.Dashboard, existing *sentry.Dashboard) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below
+	if existing != nil {
+		resp, err := sc.Client.Dashboards.Delete(sc.Organization, existing.ID)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			case resp.StatusCode == http.StatusFound:
+				// Ignore 302 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	dashboard.SetFinalizers(removeFinalizer(dashboard.GetFinalizers(), DashboardFinalizerName))
+	if err := r.Update(ctx, dashboard); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *DashboardReconciler) handleUpdate(ctx context.Context, sc *Sentry, dashboard *sentryv1alpha1.Dashboard, existing *sentry.Dashboard) error {
+	widgets, err := r.dashboardWidgets(ctx, dashboard)
+	if err != nil {
+		return err
+	}
+
+	// Replace all of the dashboard's widgets if any have drifted, as Sentry doesn't allow widgets to be updated
+	// individually
+	if dashboard.Spec.Title != existing.Title || dashboardWidgetsDrifted(widgets, existing.Widgets) {
+		_, resp, err := sc.Client.Dashboards.Update(sc.Organization, existing.ID, &sentry.UpdateDashboardParams{
+			Title:   dashboard.Spec.Title,
+			Widgets: widgets,
+		})
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+				return retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	dashboard.Status.Condition = sentryv1alpha1.DashboardConditionCreated
+	dashboard.Status.Message = ""
+	dashboard.Status.ID = existing.ID
+	dashboard.Status.Widgets = len(widgets)
+	dashboard.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, dashboard); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// dashboardWidgets returns the widgets of our spec followed by any widgets loaded from the referenced ConfigMap, in
+// the format expected by Sentry.
+func (r *DashboardReconciler) dashboardWidgets(ctx context.Context, dashboard *sentryv1alpha1.Dashboard) ([]sentry.DashboardWidget, error) {
+	widgets := dashboard.Spec.Widgets
+
+	if source := dashboard.Spec.WidgetsFrom; source != nil && source.ConfigMapKeyRef != nil {
+		ref := source.ConfigMapKeyRef
+		optional := ref.Optional != nil && *ref.Optional
+
+		var configMap corev1.ConfigMap
+		err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: dashboard.Namespace}, &configMap)
+		switch {
+		case apierrors.IsNotFound(err) && optional:
+			// Leave out the widgets of an optional ConfigMap that doesn't exist
+		case err != nil:
+			// Retry as the error might get resolved once the ConfigMap is created
+			return nil, retryableError{fmt.Errorf("failed to fetch ConfigMap for Dashboard %s: %w", dashboard.Name, err)}
+		default:
+			data, ok := configMap.Data[ref.Key]
+			if !ok && !optional {
+				return nil, fmt.Errorf("ConfigMap %s is missing required key %s", configMap.Name, ref.Key)
+			}
+
+			var loaded []sentryv1alpha1.DashboardWidget
+			if err := yaml.UnmarshalStrict([]byte(expandVariables(data, source.Variables)), &loaded); err != nil {
+				return nil, fmt.Errorf("ConfigMap %s contains invalid widgets in key %s: %w", configMap.Name, ref.Key, err)
+			}
+
+			widgets = append(append([]sentryv1alpha1.DashboardWidget{}, widgets...), loaded...)
+		}
+	}
+
+	sWidgets := make([]sentry.DashboardWidget, len(widgets))
+	for idx, widget := range widgets {
+		sQueries := make([]sentry.DashboardWidgetQuery, len(widget.Queries))
+		for jdx, query := range widget.Queries {
+			// Sentry rejects null lists, so make sure that these are always set
+			aggregates := append([]string{}, query.Aggregates...)
+			columns := append([]string{}, query.Columns...)
+			fields := append([]string{}, query.Fields...)
+			if len(fields) == 0 {
+				fields = append(append(fields, columns...), aggregates...)
+			}
+
+			sQueries[jdx] = sentry.DashboardWidgetQuery{
+				Aggregates: aggregates,
+				Columns:    columns,
+				Conditions: query.Conditions,
+				Fields:     fields,
+				Name:       query.Name,
+				OrderBy:    query.OrderBy,
+			}
+		}
+
+		sWidgets[idx] = sentry.DashboardWidget{
+			DisplayType: string(widget.DisplayType),
+			Interval:    widget.Interval,
+			Limit:       widget.Limit,
+			Queries:     sQueries,
+			Title:       widget.Title,
+			WidgetType:  widget.WidgetType,
+		}
+
+		if widget.Layout != nil {
+			sWidgets[idx].Layout = &sentry.DashboardWidgetLayout{
+				H:    widget.Layout.H,
+				MinH: widget.Layout.MinH,
+				W:    widget.Layout.W,
+				X:    widget.Layout.X,
+				Y:    widget.Layout.Y,
+			}
+		}
+	}
+
+	return sWidgets, nil
+}
+
+// expandVariables replaces each reference of the form $(NAME) in the given value by the value of the variable NAME.
+// References to undefined variables are left untouched.
+func expandVariables(value string, variables map[string]string) string {
+	if len(variables) == 0 {
+		return value
+	}
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	replacements := make([]string, 0, 2*len(names))
+	for _, name := range names {
+		replacements = append(replacements, fmt.Sprintf("$(%s)", name), variables[name])
+	}
+
+	return strings.NewReplacer(replacements...).Replace(value)
+}
+
+// dashboardWidgetsDrifted reports whether the existing widgets of a dashboard differ from the desired widgets. Optional
+// settings that are left unset in the desired widgets are ignored, as Sentry fills them in with its defaults.
+func dashboardWidgetsDrifted(desired, existing []sentry.DashboardWidget) bool {
+	if len(desired) != len(existing) {
+		return true
+	}
+
+	for idx, d := range desired {
+		e := existing[idx]
+		switch {
+		case d.Title != e.Title || d.DisplayType != e.DisplayType:
+			return true
+		case d.WidgetType != "" && d.WidgetType != e.WidgetType:
+			return true
+		case d.Interval != "" && d.Interval != e.Interval:
+			return true
+		case d.Limit != nil && (e.Limit == nil || *d.Limit != *e.Limit):
+			return true
+		case d.Layout != nil && e.Layout == nil:
+			return true
+		case d.Layout != nil && (d.Layout.X != e.Layout.X || d.Layout.Y != e.Layout.Y || d.Layout.W != e.Layout.W || d.Layout.H != e.Layout.H):
+			return true
+		case d.Layout != nil && d.Layout.MinH != 0 && d.Layout.MinH != e.Layout.MinH:
+			return true
+		case len(d.Queries) != len(e.Queries):
+			return true
+		}
+
+		for jdx, dq := range d.Queries {
+			eq := e.Queries[jdx]
+			if dq.Name != eq.Name || dq.Conditions != eq.Conditions || dq.OrderBy != eq.OrderBy {
+				return true
+			}
+
+			if !stringsEqual(dq.Fields, eq.Fields) || !stringsEqual(dq.Aggregates, eq.Aggregates) || !stringsEqual(dq.Columns, eq.Columns) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *DashboardReconciler) handleError(ctx context.Context, dashboard *sentryv1alpha1.Dashboard, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(dashboard, corev1.EventTypeNormal, "DryRun", de.Error())
+		dashboard.Status.Condition = sentryv1alpha1.DashboardConditionPlanned
+		dashboard.Status.Message = de.Error()
+		return r.Status().Update(ctx, dashboard)
+	}
+
+	dashboard.Status.Condition = sentryv1alpha1.DashboardConditionError
+	dashboard.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, dashboard); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
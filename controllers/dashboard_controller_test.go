@@ -0,0 +1,294 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("DashboardReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		dashboardName      = "test-dashboard"
+		dashboardNamespace = "test-dashboard-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		dashboard *sentryv1alpha1.Dashboard
+	)
+
+	ctx := context.Background()
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dashboard-widgets",
+			Namespace: dashboardNamespace,
+		},
+		Data: map[string]string{
+			"widgets.yaml": `
+- title: Transactions
+  displayType: big_number
+  queries:
+    - aggregates: ["count()"]
+      conditions: event.type:transaction project:$(PROJECT)
+`,
+		},
+	}
+
+	request := &sentryv1alpha1.Dashboard{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "Dashboard",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dashboardName,
+			Namespace: dashboardNamespace,
+		},
+		Spec: sentryv1alpha1.DashboardSpec{
+			Title: "Checkout Service",
+			Widgets: []sentryv1alpha1.DashboardWidget{
+				{
+					Title:       "Errors",
+					DisplayType: "line",
+					Interval:    "5m",
+					Queries: []sentryv1alpha1.DashboardWidgetQuery{
+						{Aggregates: []string{"count()"}, Conditions: "event.type:error"},
+					},
+					Layout: &sentryv1alpha1.DashboardWidgetLayout{X: 0, Y: 0, W: 2, H: 2},
+				},
+			},
+			WidgetsFrom: &sentryv1alpha1.DashboardWidgetsSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "test-dashboard-widgets"},
+					Key:                  "widgets.yaml",
+				},
+				Variables: map[string]string{"PROJECT": "checkout"},
+			},
+		},
+	}
+
+	widgets := []sentry.DashboardWidget{
+		{
+			DisplayType: "line",
+			Interval:    "5m",
+			Layout:      &sentry.DashboardWidgetLayout{H: 2, W: 2},
+			Queries: []sentry.DashboardWidgetQuery{
+				{Aggregates: []string{"count()"}, Columns: []string{}, Conditions: "event.type:error", Fields: []string{"count()"}},
+			},
+			Title: "Errors",
+		},
+		{
+			DisplayType: "big_number",
+			Queries: []sentry.DashboardWidgetQuery{
+				{Aggregates: []string{"count()"}, Columns: []string{}, Conditions: "event.type:transaction project:checkout", Fields: []string{"count()"}},
+			},
+			Title: "Transactions",
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: dashboardName, Namespace: dashboardNamespace}
+		dashboard = new(sentryv1alpha1.Dashboard)
+	})
+
+	Context("when creating a Dashboard", func() {
+		BeforeEach(func() {
+			created := testSentryDashboard("12345", request.Spec.Title, widgets...)
+			fakeSentryDashboards.CreateReturns(created, newSentryResponse(http.StatusCreated), nil)
+			fakeSentryDashboards.GetReturns(created, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the Dashboard gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.DashboardStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, dashboard)
+				if err != nil {
+					return nil, err
+				}
+				return &dashboard.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.DashboardConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+					"Widgets":   Equal(2),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(dashboard.Finalizers).To(ContainElement(controllers.DashboardFinalizerName))
+
+			By("invoked the Sentry client's .Dashboards.Create method")
+			organizationSlug, params := fakeSentryDashboards.CreateArgsForCall(fakeSentryDashboards.CreateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(params).To(Equal(&sentry.CreateDashboardParams{
+				Title:   request.Spec.Title,
+				Widgets: widgets,
+			}))
+		})
+	})
+
+	Context("when updating a Dashboard", func() {
+		var (
+			existing *sentry.Dashboard
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, dashboard)).To(Succeed())
+
+			existing = testSentryDashboard("12345", dashboard.Spec.Title, widgets...)
+			fakeSentryDashboards.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+			dashboard.Spec.Title = "Checkout Service (Production)"
+
+			updated := testSentryDashboard("12345", dashboard.Spec.Title, widgets...)
+			fakeSentryDashboards.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the Dashboard gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, dashboard)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.DashboardStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, dashboard)
+				if err != nil {
+					return nil, err
+				}
+				return &dashboard.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.DashboardConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+				})),
+			)
+
+			By("invoked the Sentry client's .Dashboards.Get method")
+			organizationSlug, dashboardID := fakeSentryDashboards.GetArgsForCall(fakeSentryDashboards.GetCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(dashboardID).To(Equal(existing.ID))
+
+			By("invoked the Sentry client's .Dashboards.Update method")
+			organizationSlug, dashboardID, params := fakeSentryDashboards.UpdateArgsForCall(fakeSentryDashboards.UpdateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(dashboardID).To(Equal(existing.ID))
+			Expect(params).To(Equal(&sentry.UpdateDashboardParams{
+				Title:   "Checkout Service (Production)",
+				Widgets: widgets,
+			}))
+		})
+	})
+
+	Context("when updating the ConfigMap of a Dashboard", func() {
+		var (
+			existing *sentry.Dashboard
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, dashboard)).To(Succeed())
+
+			existing = testSentryDashboard("12345", dashboard.Spec.Title, widgets...)
+			fakeSentryDashboards.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryDashboards.UpdateReturns(existing, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the Dashboard's widgets get updated successfully", func() {
+			updated := new(corev1.ConfigMap)
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: configMap.Name, Namespace: dashboardNamespace}, updated)).To(Succeed())
+
+			updated.Data["widgets.yaml"] = `
+- title: Transactions
+  displayType: big_number
+  queries:
+    - aggregates: ["count()"]
+      conditions: event.type:transaction project:$(PROJECT) environment:production
+`
+			Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+
+			By("invoked the Sentry client's .Dashboards.Update method")
+			Eventually(func() []sentry.DashboardWidget {
+				_, _, params := fakeSentryDashboards.UpdateArgsForCall(fakeSentryDashboards.UpdateCallCount() - 1)
+				return params.Widgets
+			}, timeout, interval).Should(Equal([]sentry.DashboardWidget{
+				widgets[0],
+				{
+					DisplayType: "big_number",
+					Queries: []sentry.DashboardWidgetQuery{
+						{Aggregates: []string{"count()"}, Columns: []string{}, Conditions: "event.type:transaction project:checkout environment:production", Fields: []string{"count()"}},
+					},
+					Title: "Transactions",
+				},
+			}))
+		})
+	})
+
+	Context("when deleting a Dashboard", func() {
+		var (
+			existing *sentry.Dashboard
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, dashboard)).To(Succeed())
+
+			existing = testSentryDashboard("12345", dashboard.Spec.Title, widgets...)
+			fakeSentryDashboards.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryDashboards.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the Dashboard gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, dashboard)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, dashboard)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Dashboards.Delete method")
+			organizationSlug, dashboardID := fakeSentryDashboards.DeleteArgsForCall(fakeSentryDashboards.DeleteCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(dashboardID).To(Equal(existing.ID))
+		})
+	})
+})
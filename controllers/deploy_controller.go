@@ -0,0 +1,396 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+// DeployReconciler records a Sentry deploy whenever a Deployment or StatefulSet that references a Sentry project rolls
+// out a new image. The start of the rollout is recorded in the workload's annotations, and the deploy is created
+// once the rollout has completed, as Sentry doesn't allow deploys to be updated.
+type DeployReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+
+	// DryRun prevents the workloads from being annotated, as the operator must not modify them in dry-run mode.
+	DryRun bool
+
+	// HistoryLimit is the number of Releases kept for each workload, including its current Release. Older Releases are
+	// deleted while their Sentry releases are retained. If zero, all Releases are kept.
+	HistoryLimit int
+}
+
+// workload is a Deployment or StatefulSet whose deploys are tracked.
+type workload interface {
+	metav1.Object
+	runtime.Object
+}
+
+func (r *DeployReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Don't filter on generation changes, as we need to observe updates to the workloads' rollout status
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("deploy-deployment").
+		For(&appsv1.Deployment{}).
+		Owns(&sentryv1alpha1.Release{}).
+		Complete(reconcile.Func(r.reconcileDeployment)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("deploy-statefulset").
+		For(&appsv1.StatefulSet{}).
+		Owns(&sentryv1alpha1.Release{}).
+		Complete(reconcile.Func(r.reconcileStatefulSet))
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projectkeys,verbs=get;list;watch
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=releases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *DeployReconciler) reconcileDeployment(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("deployment", req.NamespacedName)
+
+	var deployment appsv1.Deployment
+	if err := r.Get(ctx, req.NamespacedName, &deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch Deployment")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, r.reconcile(ctx, log, &deployment, &deployment.Spec.Template, deploymentRolledOut(&deployment))
+}
+
+func (r *DeployReconciler) reconcileStatefulSet(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("statefulset", req.NamespacedName)
+
+	var statefulSet appsv1.StatefulSet
+	if err := r.Get(ctx, req.NamespacedName, &statefulSet); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch StatefulSet")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, r.reconcile(ctx, log, &statefulSet, &statefulSet.Spec.Template, statefulSetRolledOut(&statefulSet))
+}
+
+func (r *DeployReconciler) reconcile(ctx context.Context, log logr.Logger, obj workload, template *corev1.PodTemplateSpec, rolledOut bool) error {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	// Ignore workloads that don't reference a Sentry project
+	projectSlug, err := r.resolveProject(ctx, obj, template)
+	if err != nil {
+		log.Error(err, "failed to resolve Sentry project")
+		return err
+	}
+
+	if projectSlug == "" {
+		return nil
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	container, err := releaseContainer(annotations, &template.Spec)
+	if err != nil {
+		// Don't retry as the workload needs to be updated for the error to be resolved
+		log.Error(err, "failed to determine release container")
+		return nil
+	}
+
+	imageRef := imageVersion(container.Image)
+	if imageRef == "" {
+		log.Info("skipping workload as its image has no tag or digest", "image", container.Image)
+		return nil
+	}
+
+	version := releaseVersion(projectSlug, imageRef)
+
+	environment := deployEnvironment(annotations, container)
+	if environment == "" {
+		log.Info("skipping workload as it has no Sentry environment")
+		return nil
+	}
+
+	// A new rollout has begun whenever the release version changes
+	if annotations[sentryv1alpha1.DeployVersionAnnotation] != version {
+		if err := r.annotate(ctx, obj, fmt.Sprintf("record the start of deploy %s", version), map[string]string{
+			sentryv1alpha1.DeployVersionAnnotation:  version,
+			sentryv1alpha1.DeployStartedAnnotation:  time.Now().UTC().Format(time.RFC3339),
+			sentryv1alpha1.DeployFinishedAnnotation: "",
+			sentryv1alpha1.DeployIDAnnotation:       "",
+		}); err != nil {
+			log.Error(err, "failed to record deploy start")
+			return err
+		}
+
+		log.Info("deploy started", "version", version, "environment", environment)
+	}
+
+	annotations = obj.GetAnnotations()
+	if !rolledOut || annotations[sentryv1alpha1.DeployFinishedAnnotation] != "" {
+		return nil
+	}
+
+	// Sentry requires the release to exist before it can be deployed, so wait for the Release of the workload's image
+	// to be created in Sentry first. We get requeued once the Release's status is updated, as it is owned by the
+	// workload.
+	release, _, err := workloadRelease(ctx, r.Client, r.Scheme, obj, projectSlug, imageRef, rolledOut)
+	if err != nil {
+		log.Error(err, "failed to reconcile Release", "release", release.Name)
+		return err
+	}
+
+	if err := pruneReleases(ctx, r.Client, obj, release.Name, r.HistoryLimit); err != nil {
+		log.Error(err, "failed to prune Releases")
+		return err
+	}
+
+	if release.Status.LastSynced.IsZero() && !r.DryRun {
+		log.Info("waiting for Release to be created before creating deploy", "release", release.Name)
+		return nil
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, obj.GetNamespace())
+	if err != nil {
+		log.Error(err, "failed to resolve Sentry credentials")
+		return r.handleError(obj, err)
+	}
+
+	dateStarted, err := time.Parse(time.RFC3339, annotations[sentryv1alpha1.DeployStartedAnnotation])
+	if err != nil {
+		dateStarted = time.Now()
+	}
+
+	deploy, err := r.createDeploy(sc, obj.GetName(), version, environment, dateStarted)
+	if err != nil {
+		log.Error(err, "failed to create Sentry deploy")
+		return r.handleError(obj, err)
+	}
+
+	if err := r.annotate(ctx, obj, fmt.Sprintf("record the finish of deploy %s", version), map[string]string{
+		sentryv1alpha1.DeployFinishedAnnotation: deploy.DateFinished.UTC().Format(time.RFC3339),
+		sentryv1alpha1.DeployIDAnnotation:       deploy.ID,
+	}); err != nil {
+		log.Error(err, "failed to record deploy finish")
+		return err
+	}
+
+	log.Info("deploy finished", "version", version, "environment", environment, "id", deploy.ID)
+
+	return nil
+}
+
+// annotate patches the given annotations onto the workload, removing those with empty values. As the workload belongs
+// to the user, it is patched rather than updated so that we don't overwrite any concurrent changes to it. In dry-run
+// mode, the annotations are only applied to our copy of the workload and the planned action is recorded as an event.
+func (r *DeployReconciler) annotate(ctx context.Context, obj workload, action string, changes map[string]string) error {
+	base := obj.DeepCopyObject()
+
+	annotations := make(map[string]string)
+	for key, value := range obj.GetAnnotations() {
+		annotations[key] = value
+	}
+
+	for key, value := range changes {
+		if value == "" {
+			delete(annotations, key)
+			continue
+		}
+		annotations[key] = value
+	}
+
+	obj.SetAnnotations(annotations)
+	if r.DryRun {
+		r.Recorder.Event(obj, corev1.EventTypeNormal, "DryRun", dryRunError{action}.Error())
+		return nil
+	}
+
+	return r.Patch(ctx, obj, client.MergeFrom(base))
+}
+
+// createDeploy creates a Sentry deploy of the given release version, which needs to exist in Sentry.
+func (r *DeployReconciler) createDeploy(sc *Sentry, name, version, environment string, dateStarted time.Time) (*sentry.Deploy, error) {
+	dateFinished := time.Now()
+	deploy, resp, err := sc.Client.Releases.CreateDeploy(sc.Organization, version, &sentry.CreateDeployParams{
+		DateFinished: &dateFinished,
+		DateStarted:  &dateStarted,
+		Environment:  environment,
+		Name:         name,
+	})
+	if err != nil {
+		return nil, releaseError(resp, err)
+	}
+
+	return deploy, nil
+}
+
+// resolveProject returns the slug of the Sentry project referenced by the workload, either via its annotations or via
+// a ProjectKey's Secret used by its pod template. Returns an empty slug if the workload doesn't reference one.
+func (r *DeployReconciler) resolveProject(ctx context.Context, obj workload, template *corev1.PodTemplateSpec) (string, error) {
+	if projectSlug := obj.GetAnnotations()[sentryv1alpha1.ReleaseProjectAnnotation]; projectSlug != "" {
+		return projectSlug, nil
+	}
+
+	for _, name := range secretNames(&template.Spec) {
+		var secret corev1.Secret
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: obj.GetNamespace()}, &secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+
+		owner := metav1.GetControllerOf(&secret)
+		if owner == nil || owner.Kind != "ProjectKey" || owner.APIVersion != sentryv1alpha1.GroupVersion.String() {
+			continue
+		}
+
+		var projectkey sentryv1alpha1.ProjectKey
+		if err := r.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: obj.GetNamespace()}, &projectkey); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+
+		return projectkey.Spec.Project, nil
+	}
+
+	return "", nil
+}
+
+// handleError records the error as an event on the workload, since it has no status of our own to annotate. It also
+// checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned actions
+// in dry-run mode are recorded as an event instead.
+func (r *DeployReconciler) handleError(obj workload, err error) error {
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(obj, corev1.EventTypeNormal, "DryRun", de.Error())
+		return nil
+	}
+
+	r.Recorder.Event(obj, corev1.EventTypeWarning, "DeployFailed", err.Error())
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// statefulSetRolledOut returns whether all replicas of the StatefulSet have been updated to its latest revision and are
+// ready.
+func statefulSetRolledOut(statefulSet *appsv1.StatefulSet) bool {
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return false
+	}
+
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+
+	status := statefulSet.Status
+	return status.UpdateRevision != "" &&
+		status.CurrentRevision == status.UpdateRevision &&
+		status.ReadyReplicas >= replicas
+}
+
+// deployEnvironment returns the Sentry environment of a workload's deploys, based on the workload's annotations and its
+// release container.
+func deployEnvironment(annotations map[string]string, container *corev1.Container) string {
+	if environment := annotations[sentryv1alpha1.DeployEnvironmentAnnotation]; environment != "" {
+		return environment
+	}
+
+	for _, env := range container.Env {
+		if env.Name == "SENTRY_ENVIRONMENT" {
+			return env.Value
+		}
+	}
+
+	return ""
+}
+
+// secretNames returns the names of the Secrets that the pod spec's containers get environment variables from.
+func secretNames(spec *corev1.PodSpec) []string {
+	var names []string
+	for _, container := range spec.Containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil && !containsString(names, envFrom.SecretRef.Name) {
+				names = append(names, envFrom.SecretRef.Name)
+			}
+		}
+
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && !containsString(names, env.ValueFrom.SecretKeyRef.Name) {
+				names = append(names, env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+
+	return names
+}
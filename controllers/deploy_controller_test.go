@@ -0,0 +1,149 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("DeployReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		statefulSetName      = "test-statefulset"
+		statefulSetNamespace = "test-statefulset-namespace"
+	)
+
+	var (
+		lookupKey   types.NamespacedName
+		statefulSet *appsv1.StatefulSet
+	)
+
+	ctx := context.Background()
+
+	labels := map[string]string{"app": statefulSetName}
+	request := &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "StatefulSet",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      statefulSetName,
+			Namespace: statefulSetNamespace,
+			Annotations: map[string]string{
+				sentryv1alpha1.ReleaseProjectAnnotation: "test-project",
+			},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			ServiceName: statefulSetName,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "app",
+							Image: "registry.example.com/app:1.0.0",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "SENTRY_ENVIRONMENT",
+									Value: "production",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: statefulSetName, Namespace: statefulSetNamespace}
+		statefulSet = new(appsv1.StatefulSet)
+	})
+
+	Context("when a rollout begins", func() {
+		It("the deploy start gets recorded on the StatefulSet", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			Eventually(func() (map[string]string, error) {
+				err := k8sClient.Get(ctx, lookupKey, statefulSet)
+				if err != nil {
+					return nil, err
+				}
+				return statefulSet.Annotations, nil
+			}, timeout, interval).Should(SatisfyAll(
+				HaveKeyWithValue(sentryv1alpha1.DeployVersionAnnotation, "test-project@1.0.0"),
+				HaveKey(sentryv1alpha1.DeployStartedAnnotation),
+				Not(HaveKey(sentryv1alpha1.DeployFinishedAnnotation)),
+			))
+		})
+	})
+
+	Context("when the rollout completes", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, statefulSet)).To(Succeed())
+
+			fakeSentryReleases.CreateReturns(testSentryRelease("test-project@1.0.0", "test-project"), newSentryResponse(http.StatusCreated), nil)
+			fakeSentryReleases.CreateDeployReturns(&sentry.Deploy{
+				DateFinished: time.Now(),
+				Environment:  "production",
+				ID:           "12345",
+				Name:         statefulSetName,
+			}, newSentryResponse(http.StatusCreated), nil)
+
+			statefulSet.Status.ObservedGeneration = statefulSet.Generation
+			statefulSet.Status.Replicas = 1
+			statefulSet.Status.ReadyReplicas = 1
+			statefulSet.Status.CurrentRevision = "test-statefulset-1"
+			statefulSet.Status.UpdateRevision = "test-statefulset-1"
+		})
+
+		It("the Sentry deploy gets created", func() {
+			Expect(k8sClient.Status().Update(ctx, statefulSet)).To(Succeed())
+
+			By("with the deploy finish recorded on the StatefulSet")
+			Eventually(func() (map[string]string, error) {
+				err := k8sClient.Get(ctx, lookupKey, statefulSet)
+				if err != nil {
+					return nil, err
+				}
+				return statefulSet.Annotations, nil
+			}, timeout, interval).Should(SatisfyAll(
+				HaveKey(sentryv1alpha1.DeployFinishedAnnotation),
+				HaveKeyWithValue(sentryv1alpha1.DeployIDAnnotation, "12345"),
+			))
+
+			By("with a Release owned by the StatefulSet")
+			release := new(sentryv1alpha1.Release)
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-statefulset-1.0.0", Namespace: statefulSetNamespace}, release)).To(Succeed())
+			Expect(release.Spec.Version).To(Equal("test-project@1.0.0"))
+			Expect(release.OwnerReferences).To(HaveLen(1))
+			Expect(release.OwnerReferences[0].Name).To(Equal(statefulSetName))
+
+			By("invoked the Sentry client's .Releases.CreateDeploy method")
+			organizationSlug, version, params := fakeSentryReleases.CreateDeployArgsForCall(fakeSentryReleases.CreateDeployCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(version).To(Equal("test-project@1.0.0"))
+			Expect(params.Environment).To(Equal("production"))
+			Expect(params.Name).To(Equal(statefulSetName))
+			Expect(params.DateStarted).ToNot(BeNil())
+			Expect(params.DateFinished).ToNot(BeNil())
+		})
+	})
+})
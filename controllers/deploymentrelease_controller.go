@@ -0,0 +1,288 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+)
+
+// DeploymentReleaseReconciler creates a Release for each image rolled out by Deployments annotated with a Sentry
+// project, and finalizes the Release once the Deployment's rollout has completed. The Releases are owned by the
+// Deployment, and reconciled against Sentry by the ReleaseReconciler.
+type DeploymentReleaseReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+
+	// HistoryLimit is the number of Releases kept for each Deployment, including its current Release. Older Releases
+	// are deleted while their Sentry releases are retained. If zero, all Releases are kept.
+	HistoryLimit int
+}
+
+func (r *DeploymentReleaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Don't filter on generation changes, as we need to observe updates to the Deployment's rollout status
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appsv1.Deployment{}).
+		Owns(&sentryv1alpha1.Release{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=releases,verbs=get;list;watch;create;update;patch;delete
+
+func (r *DeploymentReleaseReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("deployment", req.NamespacedName)
+
+	var deployment appsv1.Deployment
+	if err := r.Get(ctx, req.NamespacedName, &deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch Deployment")
+		return ctrl.Result{}, err
+	}
+
+	// Ignore Deployments that haven't opted in, and those being deleted as their Releases get garbage collected
+	projectSlug := deployment.Annotations[sentryv1alpha1.ReleaseProjectAnnotation]
+	if projectSlug == "" || !deployment.ObjectMeta.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	container, err := releaseContainer(deployment.Annotations, &deployment.Spec.Template.Spec)
+	if err != nil {
+		// Don't retry as the Deployment needs to be updated for the error to be resolved
+		log.Error(err, "failed to determine release container")
+		return ctrl.Result{}, nil
+	}
+
+	imageRef := imageVersion(container.Image)
+	if imageRef == "" {
+		log.Info("skipping Deployment as its image has no tag or digest", "image", container.Image)
+		return ctrl.Result{}, nil
+	}
+
+	release, op, err := workloadRelease(ctx, r.Client, r.Scheme, &deployment, projectSlug, imageRef, deploymentRolledOut(&deployment))
+	if err != nil {
+		log.Error(err, "failed to reconcile Release", "release", release.Name)
+		return ctrl.Result{}, err
+	}
+
+	log.Info("successfully reconciled Release", "release", release.Name, "operation", op)
+
+	if err := pruneReleases(ctx, r.Client, &deployment, release.Name, r.HistoryLimit); err != nil {
+		log.Error(err, "failed to prune Releases")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// workloadRelease creates or updates the Release of the given image rolled out by the workload. The Release is owned
+// by the workload, so that it gets garbage collected along with it.
+func workloadRelease(ctx context.Context, c client.Client, scheme *runtime.Scheme, obj workload, projectSlug, imageRef string, rolledOut bool) (*sentryv1alpha1.Release, controllerutil.OperationResult, error) {
+	release := &sentryv1alpha1.Release{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseName(obj.GetName(), imageRef),
+			Namespace: obj.GetNamespace(),
+		},
+	}
+
+	op, err := ctrl.CreateOrUpdate(ctx, c, release, func() error {
+		release.Spec.Version = releaseVersion(projectSlug, imageRef)
+		if !containsString(release.Spec.Projects, projectSlug) {
+			release.Spec.Projects = append(release.Spec.Projects, projectSlug)
+		}
+
+		// Releases stay finalized once the rollout has completed, even if the workload is later scaled or rolled back
+		if rolledOut {
+			release.Spec.Finalized = true
+		}
+
+		return ctrl.SetControllerReference(obj, release, scheme)
+	})
+
+	return release, op, err
+}
+
+// pruneReleases deletes the oldest Releases owned by the workload beyond the given history limit, never deleting the
+// workload's current Release. Their Sentry releases are left in place, as Releases are retained by default. All
+// Releases are kept if the limit is zero.
+func pruneReleases(ctx context.Context, c client.Client, obj workload, current string, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	var releases sentryv1alpha1.ReleaseList
+	if err := c.List(ctx, &releases, client.InNamespace(obj.GetNamespace())); err != nil {
+		return err
+	}
+
+	var previous []sentryv1alpha1.Release
+	for _, release := range releases.Items {
+		if release.Name != current && metav1.IsControlledBy(&release, obj) {
+			previous = append(previous, release)
+		}
+	}
+
+	if len(previous) < limit {
+		return nil
+	}
+
+	// Order the previous Releases from newest to oldest, so that the newest are kept alongside the current Release
+	sort.Slice(previous, func(i, j int) bool {
+		ti, tj := previous[i].CreationTimestamp, previous[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return previous[i].Name > previous[j].Name
+		}
+		return tj.Before(&ti)
+	})
+
+	for idx := range previous[limit-1:] {
+		release := &previous[limit-1+idx]
+		if err := c.Delete(ctx, release); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseContainer returns the container whose image is used as a workload's release version, based on the workload's
+// annotations and pod spec.
+func releaseContainer(annotations map[string]string, spec *corev1.PodSpec) (*corev1.Container, error) {
+	containers := spec.Containers
+	name, ok := annotations[sentryv1alpha1.ReleaseContainerAnnotation]
+	if !ok {
+		if len(containers) == 0 {
+			return nil, errors.New("pod template has no containers")
+		}
+
+		return &containers[0], nil
+	}
+
+	for idx, container := range containers {
+		if container.Name == name {
+			return &containers[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf("pod template has no container named %s", name)
+}
+
+// deploymentRolledOut returns whether all replicas of the Deployment have been updated to its latest pod template and
+// are available, following the same logic as `kubectl rollout status`.
+func deploymentRolledOut(deployment *appsv1.Deployment) bool {
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	status := deployment.Status
+	return status.UpdatedReplicas >= replicas &&
+		status.Replicas <= status.UpdatedReplicas &&
+		status.AvailableReplicas >= status.UpdatedReplicas
+}
+
+// imageVersion returns the tag or digest of the given container image reference, or an empty string if it has neither.
+// Images pinned by digest include a short form of the digest after their tag, so that a mutable tag such as "latest"
+// results in a new version whenever the image it points to changes.
+func imageVersion(image string) string {
+	var digest string
+	if idx := strings.Index(image, "@"); idx >= 0 {
+		image, digest = image[:idx], image[idx+1:]
+	}
+
+	// The tag comes after the last colon, as long as that colon isn't part of a registry's host and port
+	var tag string
+	if idx := strings.LastIndex(image, ":"); idx >= 0 && !strings.Contains(image[idx:], "/") {
+		tag = image[idx+1:]
+	}
+
+	switch {
+	case tag == "":
+		return digest
+	case digest == "":
+		return tag
+	default:
+		return fmt.Sprintf("%s+%s", tag, shortDigest(digest))
+	}
+}
+
+// shortDigest returns the first 12 characters of the hex-encoded hash of the given image digest.
+func shortDigest(digest string) string {
+	if idx := strings.Index(digest, ":"); idx >= 0 {
+		digest = digest[idx+1:]
+	}
+
+	if len(digest) > 12 {
+		return digest[:12]
+	}
+
+	return digest
+}
+
+// releaseVersion returns the version of the Sentry release for the given image of a workload referencing the Sentry
+// project. Sentry release versions are unique within the Sentry organization, so they are prefixed by the project to
+// avoid common tags such as "latest" or "1.0" colliding across unrelated projects.
+func releaseVersion(projectSlug, imageRef string) string {
+	return fmt.Sprintf("%s@%s", projectSlug, imageRef)
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// releaseName returns a valid resource name for the Release of the given Deployment and image tag or digest.
+func releaseName(deploymentName, imageRef string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(fmt.Sprintf("%s-%s", deploymentName, imageRef)), "-")
+	if len(name) > 253 {
+		name = name[:253]
+	}
+
+	return strings.Trim(name, ".-")
+}
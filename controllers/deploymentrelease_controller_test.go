@@ -0,0 +1,161 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+)
+
+var _ = Describe("DeploymentReleaseReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		deploymentName      = "test-deployment"
+		deploymentNamespace = "test-deployment-namespace"
+	)
+
+	var (
+		lookupKey  types.NamespacedName
+		deployment *appsv1.Deployment
+		release    *sentryv1alpha1.Release
+	)
+
+	ctx := context.Background()
+
+	labels := map[string]string{"app": deploymentName}
+	request := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploymentName,
+			Namespace: deploymentNamespace,
+			Annotations: map[string]string{
+				sentryv1alpha1.ReleaseProjectAnnotation:   "test-project",
+				sentryv1alpha1.ReleaseContainerAnnotation: "app",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "sidecar",
+							Image: "registry.example.com:5000/sidecar:2.0.0",
+						},
+						{
+							Name:  "app",
+							Image: "registry.example.com:5000/app:1.0.0",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: "test-deployment-1.0.0", Namespace: deploymentNamespace}
+		deployment = new(appsv1.Deployment)
+		release = new(sentryv1alpha1.Release)
+
+		fakeSentryReleases.CreateReturns(testSentryRelease("test-project@1.0.0", "test-project"), newSentryResponse(http.StatusCreated), nil)
+	})
+
+	Context("when creating an annotated Deployment", func() {
+		It("a Release gets created for the Deployment's image", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected spec")
+			Eventually(func() (*sentryv1alpha1.ReleaseSpec, error) {
+				err := k8sClient.Get(ctx, lookupKey, release)
+				if err != nil {
+					return nil, err
+				}
+				return &release.Spec, nil
+			}, timeout, interval).Should(Equal(&sentryv1alpha1.ReleaseSpec{
+				Version:  "test-project@1.0.0",
+				Projects: []string{"test-project"},
+			}))
+
+			By("owned by the Deployment")
+			Expect(release.OwnerReferences).To(HaveLen(1))
+			Expect(release.OwnerReferences[0].Name).To(Equal(deploymentName))
+		})
+	})
+
+	Context("when the Deployment's rollout completes", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: deploymentNamespace}, deployment)).To(Succeed())
+
+			deployment.Status.ObservedGeneration = deployment.Generation
+			deployment.Status.Replicas = 1
+			deployment.Status.UpdatedReplicas = 1
+			deployment.Status.AvailableReplicas = 1
+		})
+
+		It("the Release gets finalized", func() {
+			Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			Eventually(func() (bool, error) {
+				err := k8sClient.Get(ctx, lookupKey, release)
+				if err != nil {
+					return false, err
+				}
+				return release.Spec.Finalized, nil
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	Context("when the Deployment's image changes", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: deploymentNamespace}, deployment)).To(Succeed())
+		})
+
+		It("a Release gets created for each image, pruning the oldest Releases", func() {
+			deployment.Spec.Template.Spec.Containers[1].Image = "registry.example.com:5000/app:2.0.0"
+			Expect(k8sClient.Update(ctx, deployment)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: "test-deployment-2.0.0", Namespace: deploymentNamespace}, release)
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: deploymentNamespace}, deployment)).To(Succeed())
+			deployment.Spec.Template.Spec.Containers[1].Image = "registry.example.com:5000/app:latest@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+			Expect(k8sClient.Update(ctx, deployment)).To(Succeed())
+
+			By("with the expected spec")
+			Eventually(func() (*sentryv1alpha1.ReleaseSpec, error) {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-deployment-latest-0123456789ab", Namespace: deploymentNamespace}, release)
+				if err != nil {
+					return nil, err
+				}
+				return &release.Spec, nil
+			}, timeout, interval).Should(Equal(&sentryv1alpha1.ReleaseSpec{
+				Version:  "test-project@latest+0123456789ab",
+				Projects: []string{"test-project"},
+			}))
+
+			By("deleted the oldest Release")
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, release)
+			}, timeout, interval).ShouldNot(Succeed())
+		})
+	})
+})
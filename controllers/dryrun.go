@@ -0,0 +1,336 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+// dryRunError is returned by a dry-run Sentry client in place of performing a mutating request against the Sentry API.
+// It describes the action that would have been performed.
+type dryRunError struct {
+	action string
+}
+
+func (e dryRunError) Error() string {
+	return fmt.Sprintf("dry run: would %s", e.action)
+}
+
+// NewDryRunClient wraps the given client such that only GET requests are performed against the Sentry API. Mutating
+// methods return a dryRunError describing the planned action instead, which our reconcilers record in the Custom
+// Resource's status and events. Any mutating methods added to our Sentry client interfaces need to be overridden below.
+func NewDryRunClient(client *SentryClient) *SentryClient {
+	return &SentryClient{
+		Dashboards:       &dryRunDashboards{client.Dashboards},
+		Members:          &dryRunMembers{client.Members},
+		MetricAlertRules: &dryRunMetricAlertRules{client.MetricAlertRules},
+		Monitors:         &dryRunMonitors{client.Monitors},
+		Organizations:    &dryRunOrganizations{client.Organizations},
+		Projects:         &dryRunProjects{client.Projects},
+		Releases:         &dryRunReleases{client.Releases},
+		SavedSearches:    &dryRunSavedSearches{client.SavedSearches},
+		SentryApps:       &dryRunSentryApps{client.SentryApps},
+		Teams:            &dryRunTeams{client.Teams},
+	}
+}
+
+// dryRunResponse returns a response with a 4XX status code, which our reconcilers' error handling treats as an error
+// that isn't retried. Its status code must not be a 404, as that is treated as the Sentry resource not existing.
+func dryRunResponse() *sentry.Response {
+	return &sentry.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusPreconditionFailed,
+		},
+	}
+}
+
+type dryRunDashboards struct {
+	SentryDashboards
+}
+
+func (d *dryRunDashboards) Create(organizationSlug string, params *sentry.CreateDashboardParams) (*sentry.Dashboard, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create dashboard %s", params.Title)}
+}
+
+func (d *dryRunDashboards) Update(organizationSlug, dashboardID string, params *sentry.UpdateDashboardParams) (*sentry.Dashboard, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update dashboard %s", dashboardID)}
+}
+
+func (d *dryRunDashboards) Delete(organizationSlug, dashboardID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete dashboard %s", dashboardID)}
+}
+
+type dryRunMembers struct {
+	SentryMembers
+}
+
+func (m *dryRunMembers) Invite(organizationSlug string, params *sentry.InviteMemberParams) (*sentry.Member, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("invite member %s", params.Email)}
+}
+
+func (m *dryRunMembers) Update(organizationSlug, memberID string, params *sentry.UpdateMemberParams) (*sentry.Member, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update role of member %s to %s", memberID, params.Role)}
+}
+
+func (m *dryRunMembers) Delete(organizationSlug, memberID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete member %s", memberID)}
+}
+
+func (m *dryRunMembers) AddTeam(organizationSlug, memberID, teamSlug string) (*sentry.Team, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("add member %s to team %s", memberID, teamSlug)}
+}
+
+func (m *dryRunMembers) UpdateTeam(organizationSlug, memberID, teamSlug string, params *sentry.UpdateMemberTeamParams) (*sentry.MemberTeam, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update role of member %s in team %s to %s", memberID, teamSlug, params.TeamRole)}
+}
+
+func (m *dryRunMembers) RemoveTeam(organizationSlug, memberID, teamSlug string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("remove member %s from team %s", memberID, teamSlug)}
+}
+
+type dryRunMetricAlertRules struct {
+	SentryMetricAlertRules
+}
+
+func (a *dryRunMetricAlertRules) Create(organizationSlug string, params *sentry.CreateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create metric alert rule %s", params.Name)}
+}
+
+func (a *dryRunMetricAlertRules) Update(organizationSlug, ruleID string, params *sentry.UpdateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update metric alert rule %s", ruleID)}
+}
+
+func (a *dryRunMetricAlertRules) Delete(organizationSlug, ruleID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete metric alert rule %s", ruleID)}
+}
+
+type dryRunMonitors struct {
+	SentryMonitors
+}
+
+func (m *dryRunMonitors) Create(organizationSlug string, params *sentry.CreateMonitorParams) (*sentry.Monitor, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create monitor %s for project %s", params.Name, params.Project)}
+}
+
+func (m *dryRunMonitors) Update(organizationSlug, monitorSlug string, params *sentry.UpdateMonitorParams) (*sentry.Monitor, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update monitor %s", monitorSlug)}
+}
+
+func (m *dryRunMonitors) Delete(organizationSlug, monitorSlug string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete monitor %s", monitorSlug)}
+}
+
+type dryRunOrganizations struct {
+	SentryOrganizations
+}
+
+func (o *dryRunOrganizations) Update(organizationSlug string, params *sentry.UpdateOrganizationParams) (*sentry.Organization, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update settings of organization %s", organizationSlug)}
+}
+
+func (o *dryRunOrganizations) EnableSpikeProtection(organizationSlug string, params *sentry.SpikeProtectionParams) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("enable spike protection for projects %s", strings.Join(params.Projects, ", "))}
+}
+
+func (o *dryRunOrganizations) DisableSpikeProtection(organizationSlug string, params *sentry.SpikeProtectionParams) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("disable spike protection for projects %s", strings.Join(params.Projects, ", "))}
+}
+
+type dryRunProjects struct {
+	SentryProjects
+}
+
+func (p *dryRunProjects) Update(organizationSlug, projectSlug string, params *sentry.UpdateProjectParams) (*sentry.Project, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update project %s", projectSlug)}
+}
+
+func (p *dryRunProjects) Delete(organizationSlug, projectSlug string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete project %s", projectSlug)}
+}
+
+func (p *dryRunProjects) AddTeam(organizationSlug, projectSlug, teamSlug string) (*sentry.Project, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("add team %s to project %s", teamSlug, projectSlug)}
+}
+
+func (p *dryRunProjects) RemoveTeam(organizationSlug, projectSlug, teamSlug string) (*sentry.Project, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("remove team %s from project %s", teamSlug, projectSlug)}
+}
+
+func (p *dryRunProjects) CreateKey(organizationSlug, projectSlug string, params *sentry.CreateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create key %s for project %s", params.Name, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateKey(organizationSlug, projectSlug, keyID string, params *sentry.UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update key %s for project %s", keyID, projectSlug)}
+}
+
+func (p *dryRunProjects) DeleteKey(organizationSlug, projectSlug, keyID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete key %s for project %s", keyID, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateEnvironment(organizationSlug, projectSlug, environmentName string, params *sentry.UpdateProjectEnvironmentParams) (*sentry.ProjectEnvironment, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update visibility of environment %s for project %s", environmentName, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateOwnership(organizationSlug, projectSlug string, params *sentry.UpdateProjectOwnershipParams) (*sentry.ProjectOwnership, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update ownership rules of project %s", projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateFilter(organizationSlug, projectSlug, filterID string, params *sentry.UpdateProjectFilterParams) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("update filter %s of project %s", filterID, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateQuota(organizationSlug, projectSlug, category string, params *sentry.UpdateProjectQuotaParams) (*sentry.ProjectQuota, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update %s quota of project %s", category, projectSlug)}
+}
+
+func (p *dryRunProjects) CreateRule(organizationSlug, projectSlug string, params *sentry.CreateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create issue alert rule %s for project %s", params.Name, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateRule(organizationSlug, projectSlug, ruleID string, params *sentry.UpdateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update issue alert rule %s for project %s", ruleID, projectSlug)}
+}
+
+func (p *dryRunProjects) DeleteRule(organizationSlug, projectSlug, ruleID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete issue alert rule %s for project %s", ruleID, projectSlug)}
+}
+
+func (p *dryRunProjects) CreateHook(organizationSlug, projectSlug string, params *sentry.CreateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create service hook %s for project %s", params.URL, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateHook(organizationSlug, projectSlug, hookID string, params *sentry.UpdateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update service hook %s for project %s", hookID, projectSlug)}
+}
+
+func (p *dryRunProjects) DeleteHook(organizationSlug, projectSlug, hookID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete service hook %s for project %s", hookID, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdatePlugin(organizationSlug, projectSlug, pluginID string, params sentry.UpdateProjectPluginParams) (*sentry.ProjectPlugin, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update config of plugin %s for project %s", pluginID, projectSlug)}
+}
+
+func (p *dryRunProjects) EnablePlugin(organizationSlug, projectSlug, pluginID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("enable plugin %s for project %s", pluginID, projectSlug)}
+}
+
+func (p *dryRunProjects) DisablePlugin(organizationSlug, projectSlug, pluginID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("disable plugin %s for project %s", pluginID, projectSlug)}
+}
+
+func (p *dryRunProjects) ResetPlugin(organizationSlug, projectSlug, pluginID string) (*sentry.ProjectPlugin, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("reset config of plugin %s for project %s", pluginID, projectSlug)}
+}
+
+func (p *dryRunProjects) CreateSearch(organizationSlug, projectSlug string, params *sentry.CreateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create saved search %s for project %s", params.Name, projectSlug)}
+}
+
+func (p *dryRunProjects) UpdateSearch(organizationSlug, projectSlug, searchID string, params *sentry.UpdateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update saved search %s for project %s", searchID, projectSlug)}
+}
+
+func (p *dryRunProjects) DeleteSearch(organizationSlug, projectSlug, searchID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete saved search %s for project %s", searchID, projectSlug)}
+}
+
+type dryRunReleases struct {
+	SentryReleases
+}
+
+func (r *dryRunReleases) Create(organizationSlug string, params *sentry.CreateReleaseParams) (*sentry.Release, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create release %s", params.Version)}
+}
+
+func (r *dryRunReleases) Update(organizationSlug, version string, params *sentry.UpdateReleaseParams) (*sentry.Release, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update release %s", version)}
+}
+
+func (r *dryRunReleases) Finalize(organizationSlug, version string, dateReleased time.Time) (*sentry.Release, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("finalize release %s", version)}
+}
+
+func (r *dryRunReleases) SetCommits(organizationSlug, version string, params *sentry.SetReleaseCommitsParams) (*sentry.Release, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("set commits for release %s", version)}
+}
+
+func (r *dryRunReleases) Delete(organizationSlug, version string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete release %s", version)}
+}
+
+func (r *dryRunReleases) CreateDeploy(organizationSlug, version string, params *sentry.CreateDeployParams) (*sentry.Deploy, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create deploy of release %s to %s", version, params.Environment)}
+}
+
+func (r *dryRunReleases) UploadFile(organizationSlug, projectSlug, version string, params *sentry.UploadReleaseFileParams) (*sentry.ReleaseFile, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("upload file %s to release %s", params.Name, version)}
+}
+
+func (r *dryRunReleases) DeleteFile(organizationSlug, projectSlug, version, fileID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete file %s from release %s", fileID, version)}
+}
+
+type dryRunSavedSearches struct {
+	SentrySavedSearches
+}
+
+func (s *dryRunSavedSearches) Create(organizationSlug string, params *sentry.CreateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create saved search %s", params.Name)}
+}
+
+func (s *dryRunSavedSearches) Update(organizationSlug, searchID string, params *sentry.UpdateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update saved search %s", searchID)}
+}
+
+func (s *dryRunSavedSearches) Delete(organizationSlug, searchID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete saved search %s", searchID)}
+}
+
+type dryRunSentryApps struct {
+	SentrySentryApps
+}
+
+func (a *dryRunSentryApps) Create(params *sentry.CreateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create internal integration %s", params.Name)}
+}
+
+func (a *dryRunSentryApps) Update(sentryAppSlug string, params *sentry.UpdateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update internal integration %s", sentryAppSlug)}
+}
+
+func (a *dryRunSentryApps) Delete(sentryAppSlug string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete internal integration %s", sentryAppSlug)}
+}
+
+func (a *dryRunSentryApps) CreateToken(sentryAppSlug string) (*sentry.SentryAppToken, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create token for internal integration %s", sentryAppSlug)}
+}
+
+func (a *dryRunSentryApps) DeleteToken(sentryAppSlug, tokenID string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete token %s for internal integration %s", tokenID, sentryAppSlug)}
+}
+
+type dryRunTeams struct {
+	SentryTeams
+}
+
+func (t *dryRunTeams) Create(organizationSlug string, params *sentry.CreateTeamParams) (*sentry.Team, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create team %s", params.Slug)}
+}
+
+func (t *dryRunTeams) Update(organizationSlug, teamSlug string, params *sentry.UpdateTeamParams) (*sentry.Team, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("update team %s", teamSlug)}
+}
+
+func (t *dryRunTeams) Delete(organizationSlug, teamSlug string) (*sentry.Response, error) {
+	return dryRunResponse(), dryRunError{fmt.Sprintf("delete team %s", teamSlug)}
+}
+
+func (t *dryRunTeams) CreateProject(organizationSlug, teamSlug string, params *sentry.CreateProjectParams) (*sentry.Project, *sentry.Response, error) {
+	return nil, dryRunResponse(), dryRunError{fmt.Sprintf("create project %s under team %s", params.Slug, teamSlug)}
+}
@@ -0,0 +1,385 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/controllers/controllersfakes"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("NewDryRunClient", func() {
+	var (
+		fakeProjects *controllersfakes.FakeSentryProjects
+		fakeTeams    *controllersfakes.FakeSentryTeams
+		client       *controllers.SentryClient
+	)
+
+	BeforeEach(func() {
+		fakeProjects = new(controllersfakes.FakeSentryProjects)
+		fakeTeams = new(controllersfakes.FakeSentryTeams)
+
+		client = controllers.NewDryRunClient(&controllers.SentryClient{
+			Dashboards:       new(controllersfakes.FakeSentryDashboards),
+			Members:          new(controllersfakes.FakeSentryMembers),
+			MetricAlertRules: new(controllersfakes.FakeSentryMetricAlertRules),
+			Monitors:         new(controllersfakes.FakeSentryMonitors),
+			Organizations:    new(controllersfakes.FakeSentryOrganizations),
+			Projects:         fakeProjects,
+			Releases:         new(controllersfakes.FakeSentryReleases),
+			SavedSearches:    new(controllersfakes.FakeSentrySavedSearches),
+			SentryApps:       new(controllersfakes.FakeSentrySentryApps),
+			Teams:            fakeTeams,
+		})
+	})
+
+	It("passes through read-only methods", func() {
+		existing := testSentryTeam("12345", "test-team")
+		fakeTeams.ListReturns([]sentry.Team{*existing}, newSentryResponse(http.StatusOK), nil)
+
+		teams, _, err := client.Teams.List("organization", &sentry.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(teams).To(Equal([]sentry.Team{*existing}))
+		Expect(fakeTeams.ListCallCount()).To(Equal(1))
+	})
+
+	It("plans mutating methods instead of performing them", func() {
+		_, resp, err := client.Teams.Create("organization", &sentry.CreateTeamParams{Name: "test-team", Slug: "test-team"})
+		Expect(err).To(MatchError("dry run: would create team test-team"))
+		Expect(resp.StatusCode).To(Equal(http.StatusPreconditionFailed))
+		Expect(fakeTeams.CreateCallCount()).To(Equal(0))
+
+		_, resp, err = client.Projects.UpdateKey("organization", "test-project", "12345", &sentry.UpdateProjectKeyParams{Name: "test-key"})
+		Expect(err).To(MatchError("dry run: would update key 12345 for project test-project"))
+		Expect(resp.StatusCode).To(Equal(http.StatusPreconditionFailed))
+		Expect(fakeProjects.UpdateKeyCallCount()).To(Equal(0))
+
+		resp, err = client.Projects.Delete("organization", "test-project")
+		Expect(err).To(MatchError("dry run: would delete project test-project"))
+		Expect(resp.StatusCode).To(Equal(http.StatusPreconditionFailed))
+		Expect(fakeProjects.DeleteCallCount()).To(Equal(0))
+	})
+})
+
+var _ = Describe("Reconciling in dry-run mode", func() {
+	const (
+		namespace = "test-dryrun-namespace"
+	)
+
+	var (
+		fakeOrganizations *controllersfakes.FakeSentryOrganizations
+		fakeProjects      *controllersfakes.FakeSentryProjects
+		fakeTeams         *controllersfakes.FakeSentryTeams
+		recorder          *record.FakeRecorder
+		sentryDryRun      *controllers.Sentry
+	)
+
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		fakeOrganizations = new(controllersfakes.FakeSentryOrganizations)
+		fakeProjects = new(controllersfakes.FakeSentryProjects)
+		fakeTeams = new(controllersfakes.FakeSentryTeams)
+		recorder = record.NewFakeRecorder(10)
+
+		sentryDryRun = &controllers.Sentry{
+			Organization: "organization",
+			Client: controllers.NewDryRunClient(&controllers.SentryClient{
+				Dashboards:       new(controllersfakes.FakeSentryDashboards),
+				Members:          new(controllersfakes.FakeSentryMembers),
+				MetricAlertRules: new(controllersfakes.FakeSentryMetricAlertRules),
+				Monitors:         new(controllersfakes.FakeSentryMonitors),
+				Organizations:    fakeOrganizations,
+				Projects:         fakeProjects,
+				Releases:         new(controllersfakes.FakeSentryReleases),
+				SavedSearches:    new(controllersfakes.FakeSentrySavedSearches),
+				SentryApps:       new(controllersfakes.FakeSentrySentryApps),
+				Teams:            fakeTeams,
+			}),
+		}
+	})
+
+	Context("when creating a Team", func() {
+		It("the Team's creation gets planned", func() {
+			team := &sentryv1alpha1.Team{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-team", Namespace: namespace},
+				Spec:       sentryv1alpha1.TeamSpec{Name: "test-team", Slug: "test-team"},
+			}
+
+			k8sClient := fake.NewFakeClientWithScheme(scheme.Scheme, team)
+			reconciler := &controllers.TeamReconciler{
+				Client:   k8sClient,
+				Log:      ctrl.Log.WithName("controllers").WithName("Team"),
+				Scheme:   scheme.Scheme,
+				Recorder: recorder,
+				Sentry:   sentryDryRun,
+			}
+
+			lookupKey := types.NamespacedName{Name: team.Name, Namespace: namespace}
+			Expect(reconciler.Reconcile(ctrl.Request{NamespacedName: lookupKey})).To(Equal(ctrl.Result{}))
+
+			By("with the expected status")
+			Expect(k8sClient.Get(ctx, lookupKey, team)).To(Succeed())
+			Expect(team.Status.Condition).To(Equal(sentryv1alpha1.TeamConditionPlanned))
+			Expect(team.Status.Message).To(Equal("dry run: would create team test-team"))
+
+			By("with the expected event")
+			Expect(recorder.Events).To(Receive(Equal("Normal DryRun dry run: would create team test-team")))
+
+			By("did not invoke the Sentry client's .Teams.Create method")
+			Expect(fakeTeams.CreateCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when updating a Team", func() {
+		It("the Team's update gets planned", func() {
+			team := &sentryv1alpha1.Team{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-team",
+					Namespace:  namespace,
+					Finalizers: []string{controllers.TeamFinalizerName},
+				},
+				Spec: sentryv1alpha1.TeamSpec{Name: "test-team-update", Slug: "test-team"},
+				Status: sentryv1alpha1.TeamStatus{
+					Condition:  sentryv1alpha1.TeamConditionCreated,
+					ID:         "12345",
+					LastSynced: &metav1.Time{Time: time.Now()},
+				},
+			}
+			fakeTeams.ListReturns([]sentry.Team{*testSentryTeam("12345", "test-team")}, newSentryResponse(http.StatusOK), nil)
+
+			k8sClient := fake.NewFakeClientWithScheme(scheme.Scheme, team)
+			reconciler := &controllers.TeamReconciler{
+				Client:   k8sClient,
+				Log:      ctrl.Log.WithName("controllers").WithName("Team"),
+				Scheme:   scheme.Scheme,
+				Recorder: recorder,
+				Sentry:   sentryDryRun,
+			}
+
+			lookupKey := types.NamespacedName{Name: team.Name, Namespace: namespace}
+			Expect(reconciler.Reconcile(ctrl.Request{NamespacedName: lookupKey})).To(Equal(ctrl.Result{}))
+
+			By("with the expected status")
+			Expect(k8sClient.Get(ctx, lookupKey, team)).To(Succeed())
+			Expect(team.Status.Condition).To(Equal(sentryv1alpha1.TeamConditionPlanned))
+			Expect(team.Status.Message).To(Equal("dry run: would update team test-team"))
+
+			By("did not invoke the Sentry client's .Teams.Update method")
+			Expect(fakeTeams.UpdateCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when creating a Project", func() {
+		It("the Project's creation gets planned", func() {
+			project := &sentryv1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-project", Namespace: namespace},
+				Spec: sentryv1alpha1.ProjectSpec{
+					Name:  "test-project",
+					Slug:  "test-project",
+					Teams: []string{"test-team"},
+				},
+			}
+
+			k8sClient := fake.NewFakeClientWithScheme(scheme.Scheme, project)
+			reconciler := &controllers.ProjectReconciler{
+				Client:   k8sClient,
+				Log:      ctrl.Log.WithName("controllers").WithName("Project"),
+				Scheme:   scheme.Scheme,
+				Recorder: recorder,
+				Sentry:   sentryDryRun,
+			}
+
+			lookupKey := types.NamespacedName{Name: project.Name, Namespace: namespace}
+			Expect(reconciler.Reconcile(ctrl.Request{NamespacedName: lookupKey})).To(Equal(ctrl.Result{}))
+
+			By("with the expected status")
+			Expect(k8sClient.Get(ctx, lookupKey, project)).To(Succeed())
+			Expect(project.Status.Condition).To(Equal(sentryv1alpha1.ProjectConditionPlanned))
+			Expect(project.Status.Message).To(Equal("dry run: would create project test-project under team test-team"))
+
+			By("did not invoke the Sentry client's .Teams.CreateProject method")
+			Expect(fakeTeams.CreateProjectCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when deleting a Project", func() {
+		It("the Project's deletion gets planned without removing its finalizer", func() {
+			project := &sentryv1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "test-project",
+					Namespace:         namespace,
+					Finalizers:        []string{controllers.ProjectFinalizerName},
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				},
+				Spec: sentryv1alpha1.ProjectSpec{
+					Name:  "test-project",
+					Slug:  "test-project",
+					Teams: []string{"test-team"},
+				},
+				Status: sentryv1alpha1.ProjectStatus{
+					Condition:  sentryv1alpha1.ProjectConditionCreated,
+					ID:         "12345",
+					LastSynced: &metav1.Time{Time: time.Now()},
+				},
+			}
+			fakeOrganizations.ListProjectsReturns([]sentry.Project{*testSentryProject("12345", "test-team", "test-project")}, newSentryResponse(http.StatusOK), nil)
+
+			k8sClient := fake.NewFakeClientWithScheme(scheme.Scheme, project)
+			reconciler := &controllers.ProjectReconciler{
+				Client:   k8sClient,
+				Log:      ctrl.Log.WithName("controllers").WithName("Project"),
+				Scheme:   scheme.Scheme,
+				Recorder: recorder,
+				Sentry:   sentryDryRun,
+			}
+
+			lookupKey := types.NamespacedName{Name: project.Name, Namespace: namespace}
+			Expect(reconciler.Reconcile(ctrl.Request{NamespacedName: lookupKey})).To(Equal(ctrl.Result{}))
+
+			By("with the expected status")
+			Expect(k8sClient.Get(ctx, lookupKey, project)).To(Succeed())
+			Expect(project.Status.Condition).To(Equal(sentryv1alpha1.ProjectConditionPlanned))
+			Expect(project.Status.Message).To(Equal("dry run: would delete project test-project"))
+
+			By("with the finalizer retained")
+			Expect(project.Finalizers).To(ContainElement(controllers.ProjectFinalizerName))
+
+			By("did not invoke the Sentry client's .Projects.Delete method")
+			Expect(fakeProjects.DeleteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a Project's quotas have drifted", func() {
+		It("the Project's quota update gets planned", func() {
+			project := &sentryv1alpha1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-project",
+					Namespace:  namespace,
+					Finalizers: []string{controllers.ProjectFinalizerName},
+				},
+				Spec: sentryv1alpha1.ProjectSpec{
+					Name:  "test-project",
+					Slug:  "test-project",
+					Teams: []string{"test-team"},
+					Quotas: &sentryv1alpha1.ProjectQuotas{
+						Transactions: &sentryv1alpha1.ProjectQuota{Limit: 50000, Window: 3600},
+					},
+				},
+				Status: sentryv1alpha1.ProjectStatus{
+					Condition:  sentryv1alpha1.ProjectConditionCreated,
+					ID:         "12345",
+					LastSynced: &metav1.Time{Time: time.Now()},
+				},
+			}
+			existing := testSentryProject("12345", "test-team", "test-project")
+			fakeOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+			fakeProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeProjects.ListQuotasReturns([]sentry.ProjectQuota{{Category: "transaction"}}, newSentryResponse(http.StatusOK), nil)
+
+			k8sClient := fake.NewFakeClientWithScheme(scheme.Scheme, project)
+			reconciler := &controllers.ProjectReconciler{
+				Client:   k8sClient,
+				Log:      ctrl.Log.WithName("controllers").WithName("Project"),
+				Scheme:   scheme.Scheme,
+				Recorder: recorder,
+				Sentry:   sentryDryRun,
+			}
+
+			lookupKey := types.NamespacedName{Name: project.Name, Namespace: namespace}
+			Expect(reconciler.Reconcile(ctrl.Request{NamespacedName: lookupKey})).To(Equal(ctrl.Result{}))
+
+			By("with the expected status")
+			Expect(k8sClient.Get(ctx, lookupKey, project)).To(Succeed())
+			Expect(project.Status.Condition).To(Equal(sentryv1alpha1.ProjectConditionPlanned))
+			Expect(project.Status.Message).To(Equal("dry run: would update transaction quota of project test-project"))
+
+			By("did not invoke the Sentry client's .Projects.UpdateQuota method")
+			Expect(fakeProjects.UpdateQuotaCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a StatefulSet finishes rolling out", func() {
+		var (
+			fakeReleases *controllersfakes.FakeSentryReleases
+		)
+
+		BeforeEach(func() {
+			fakeReleases = new(controllersfakes.FakeSentryReleases)
+			sentryDryRun.Client = controllers.NewDryRunClient(&controllers.SentryClient{
+				Releases: fakeReleases,
+			})
+		})
+
+		It("the Sentry deploy's creation gets planned without annotating the StatefulSet", func() {
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-statefulset",
+					Namespace: namespace,
+					Annotations: map[string]string{
+						sentryv1alpha1.ReleaseProjectAnnotation: "test-project",
+					},
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "app",
+									Image: "registry.example.com/app:1.0.0",
+									Env: []corev1.EnvVar{
+										{Name: "SENTRY_ENVIRONMENT", Value: "production"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					Replicas:        1,
+					ReadyReplicas:   1,
+					CurrentRevision: "test-statefulset-1",
+					UpdateRevision:  "test-statefulset-1",
+				},
+			}
+
+			k8sClient := fake.NewFakeClientWithScheme(scheme.Scheme, statefulSet)
+			reconciler := &controllers.DeployReconciler{
+				Client:   k8sClient,
+				Log:      ctrl.Log.WithName("controllers").WithName("Deploy"),
+				Scheme:   scheme.Scheme,
+				Recorder: recorder,
+				Sentry:   sentryDryRun,
+				DryRun:   true,
+			}
+
+			lookupKey := types.NamespacedName{Name: statefulSet.Name, Namespace: namespace}
+			Expect(controllers.ReconcileStatefulSet(reconciler, ctrl.Request{NamespacedName: lookupKey})).To(Equal(ctrl.Result{}))
+
+			By("with the expected events")
+			Expect(recorder.Events).To(Receive(Equal("Normal DryRun dry run: would record the start of deploy test-project@1.0.0")))
+			Expect(recorder.Events).To(Receive(Equal("Normal DryRun dry run: would create deploy of release test-project@1.0.0 to production")))
+
+			By("without annotating the StatefulSet")
+			Expect(k8sClient.Get(ctx, lookupKey, statefulSet)).To(Succeed())
+			Expect(statefulSet.Annotations).ToNot(HaveKey(sentryv1alpha1.DeployVersionAnnotation))
+
+			By("did not invoke the Sentry client's .Releases.CreateDeploy method")
+			Expect(fakeReleases.CreateDeployCallCount()).To(Equal(0))
+		})
+	})
+})
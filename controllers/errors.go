@@ -6,6 +6,10 @@ import (
 
 var (
 	ErrOutOfSync = errors.New("out of sync")
+
+	// ErrProjectTeamsEmpty is returned when a Project doesn't specify any Sentry teams, as reconciling it would leave
+	// the Sentry project without any teams.
+	ErrProjectTeamsEmpty = errors.New("Project must specify at least one Sentry team")
 )
 
 type retryableError struct {
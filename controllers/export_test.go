@@ -0,0 +1,5 @@
+package controllers
+
+// ReconcileStatefulSet exposes the reconciliation of StatefulSets by the DeployReconciler, which is otherwise only
+// registered with the manager, so that it can be tested against a fake client.
+var ReconcileStatefulSet = (*DeployReconciler).reconcileStatefulSet
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// SentryHealthChecker verifies that the operator-wide Sentry organization can be accessed using our Sentry client, such
+// as to detect a revoked or expired token. The result of each verification is cached for the given interval, so that
+// frequent health probes don't result in excessive requests to the Sentry API.
+type SentryHealthChecker struct {
+	Sentry   *Sentry
+	Interval time.Duration
+
+	// Timeout bounds how long a verification can take before the Sentry organization is reported as unreachable, so
+	// that a hung request to the Sentry API doesn't block health probes indefinitely.
+	Timeout time.Duration
+
+	mu          sync.Mutex
+	lastChecked time.Time
+	lastErr     error
+	pending     chan struct{}
+}
+
+// Check implements the healthz.Checker function signature.
+func (c *SentryHealthChecker) Check(_ *http.Request) error {
+	c.mu.Lock()
+	// Verify our Sentry organization in the background, so that we never hold our lock while waiting on the Sentry API
+	if c.pending == nil && (c.lastChecked.IsZero() || time.Since(c.lastChecked) >= c.Interval) {
+		c.pending = make(chan struct{})
+		go c.refresh(c.pending)
+	}
+	pending := c.pending
+	c.mu.Unlock()
+
+	// A pending verification always completes within our timeout, as refresh gives up on it after that
+	if pending != nil {
+		<-pending
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lastErr
+}
+
+// refresh verifies our Sentry organization and records the result, recording a verification that takes longer than our
+// timeout as a failure. A hung request is abandoned rather than waited on, so that later checks can start a new
+// verification, and is eventually ended by the Sentry client's own timeout.
+func (c *SentryHealthChecker) refresh(done chan struct{}) {
+	result := make(chan error, 1)
+	go func() {
+		result <- c.verify()
+	}()
+
+	var err error
+	select {
+	case err = <-result:
+	case <-time.After(c.Timeout):
+		err = fmt.Errorf("failed to verify Sentry organization: timed out after %s", c.Timeout)
+	}
+
+	c.mu.Lock()
+	c.lastErr = err
+	c.lastChecked = time.Now()
+	c.pending = nil
+	c.mu.Unlock()
+
+	close(done)
+}
+
+func (c *SentryHealthChecker) verify() error {
+	organization, _, err := c.Sentry.Client.Organizations.Get(c.Sentry.Organization)
+	if err != nil {
+		return fmt.Errorf("failed to verify Sentry organization: %w", err)
+	}
+
+	if organization.Slug != c.Sentry.Organization {
+		return fmt.Errorf("failed to verify Sentry organization: expected %s but got %s", c.Sentry.Organization, organization.Slug)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package controllers_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/controllers/controllersfakes"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("SentryHealthChecker", func() {
+	var (
+		fakeOrganizations *controllersfakes.FakeSentryOrganizations
+		checker           *controllers.SentryHealthChecker
+	)
+
+	BeforeEach(func() {
+		fakeOrganizations = new(controllersfakes.FakeSentryOrganizations)
+		fakeOrganizations.GetReturns(&sentry.Organization{Slug: "organization"}, newSentryResponse(http.StatusOK), nil)
+
+		checker = &controllers.SentryHealthChecker{
+			Sentry: &controllers.Sentry{
+				Organization: "organization",
+				Client: &controllers.SentryClient{
+					Organizations: fakeOrganizations,
+				},
+			},
+			Interval: time.Hour,
+			Timeout:  time.Second,
+		}
+	})
+
+	It("verifies the Sentry organization", func() {
+		Expect(checker.Check(nil)).To(Succeed())
+
+		By("invoked the Sentry client's .Organizations.Get method")
+		Expect(fakeOrganizations.GetCallCount()).To(Equal(1))
+		Expect(fakeOrganizations.GetArgsForCall(0)).To(Equal("organization"))
+	})
+
+	It("caches the result until the interval has passed", func() {
+		Expect(checker.Check(nil)).To(Succeed())
+
+		fakeOrganizations.GetReturns(nil, newSentryResponse(http.StatusUnauthorized), errors.New("an error occurred"))
+		Expect(checker.Check(nil)).To(Succeed())
+		Expect(fakeOrganizations.GetCallCount()).To(Equal(1))
+
+		checker.Interval = 0
+		Expect(checker.Check(nil)).To(MatchError("failed to verify Sentry organization: an error occurred"))
+		Expect(fakeOrganizations.GetCallCount()).To(Equal(2))
+	})
+
+	It("reports an error if the verification takes longer than the timeout", func() {
+		unblock := make(chan struct{})
+		defer close(unblock)
+
+		fakeOrganizations.GetStub = func(string) (*sentry.Organization, *sentry.Response, error) {
+			<-unblock
+			return &sentry.Organization{Slug: "organization"}, newSentryResponse(http.StatusOK), nil
+		}
+
+		checker.Timeout = 10 * time.Millisecond
+		Expect(checker.Check(nil)).To(MatchError("failed to verify Sentry organization: timed out after 10ms"))
+
+		By("caching the timed out verification as a failure")
+		Expect(checker.Check(nil)).To(MatchError("failed to verify Sentry organization: timed out after 10ms"))
+		Expect(fakeOrganizations.GetCallCount()).To(Equal(1))
+
+		By("starting another verification once the interval has passed")
+		checker.Interval = 0
+		Expect(checker.Check(nil)).To(HaveOccurred())
+		Expect(fakeOrganizations.GetCallCount()).To(Equal(2))
+	})
+})
@@ -1,31 +1,169 @@
 package controllers
 
-import "github.com/jace-ys/sentry-operator/pkg/sentry"
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
 
 type Sentry struct {
 	Organization string
 	Client       *SentryClient
+
+	// Credentials enables the use of namespace-scoped SentryCredentials. If nil, the organization and client above are
+	// used for resources in all namespaces.
+	Credentials *Credentials
+
+	// namespaced is set if the organization and client above were resolved from a namespace's SentryCredentials.
+	namespaced bool
 }
 
 type SentryClient struct {
-	Organizations SentryOrganizations
-	Projects      SentryProjects
-	Teams         SentryTeams
+	Dashboards       SentryDashboards
+	Members          SentryMembers
+	MetricAlertRules SentryMetricAlertRules
+	Monitors         SentryMonitors
+	Organizations    SentryOrganizations
+	Projects         SentryProjects
+	Releases         SentryReleases
+	SavedSearches    SentrySavedSearches
+	SentryApps       SentrySentryApps
+	Teams            SentryTeams
+}
+
+func NewSentryClient(client *sentry.Client) *SentryClient {
+	return &SentryClient{
+		Dashboards:       client.Dashboards,
+		Members:          client.Members,
+		MetricAlertRules: client.MetricAlertRules,
+		Monitors:         client.Monitors,
+		Organizations:    client.Organizations,
+		Projects:         client.Projects,
+		Releases:         client.Releases,
+		SavedSearches:    client.SavedSearches,
+		SentryApps:       client.SentryApps,
+		Teams:            client.Teams,
+	}
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryDashboards
+type SentryDashboards interface {
+	Get(organizationSlug, dashboardID string) (*sentry.Dashboard, *sentry.Response, error)
+	Create(organizationSlug string, params *sentry.CreateDashboardParams) (*sentry.Dashboard, *sentry.Response, error)
+	Update(organizationSlug, dashboardID string, params *sentry.UpdateDashboardParams) (*sentry.Dashboard, *sentry.Response, error)
+	Delete(organizationSlug, dashboardID string) (*sentry.Response, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryMembers
+type SentryMembers interface {
+	List(organizationSlug string, opts *sentry.ListOptions) ([]sentry.Member, *sentry.Response, error)
+	Get(organizationSlug, memberID string) (*sentry.Member, *sentry.Response, error)
+	Invite(organizationSlug string, params *sentry.InviteMemberParams) (*sentry.Member, *sentry.Response, error)
+	Update(organizationSlug, memberID string, params *sentry.UpdateMemberParams) (*sentry.Member, *sentry.Response, error)
+	Delete(organizationSlug, memberID string) (*sentry.Response, error)
+	AddTeam(organizationSlug, memberID, teamSlug string) (*sentry.Team, *sentry.Response, error)
+	UpdateTeam(organizationSlug, memberID, teamSlug string, params *sentry.UpdateMemberTeamParams) (*sentry.MemberTeam, *sentry.Response, error)
+	RemoveTeam(organizationSlug, memberID, teamSlug string) (*sentry.Response, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryMetricAlertRules
+type SentryMetricAlertRules interface {
+	Get(organizationSlug, ruleID string) (*sentry.MetricAlertRule, *sentry.Response, error)
+	Create(organizationSlug string, params *sentry.CreateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error)
+	Update(organizationSlug, ruleID string, params *sentry.UpdateMetricAlertRuleParams) (*sentry.MetricAlertRule, *sentry.Response, error)
+	Delete(organizationSlug, ruleID string) (*sentry.Response, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryMonitors
+type SentryMonitors interface {
+	Get(organizationSlug, monitorSlug string) (*sentry.Monitor, *sentry.Response, error)
+	Create(organizationSlug string, params *sentry.CreateMonitorParams) (*sentry.Monitor, *sentry.Response, error)
+	Update(organizationSlug, monitorSlug string, params *sentry.UpdateMonitorParams) (*sentry.Monitor, *sentry.Response, error)
+	Delete(organizationSlug, monitorSlug string) (*sentry.Response, error)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryOrganizations
 type SentryOrganizations interface {
+	Get(organizationSlug string) (*sentry.Organization, *sentry.Response, error)
+	Update(organizationSlug string, params *sentry.UpdateOrganizationParams) (*sentry.Organization, *sentry.Response, error)
 	ListProjects(organizationSlug string, opts *sentry.ListOptions) ([]sentry.Project, *sentry.Response, error)
+	EnableSpikeProtection(organizationSlug string, params *sentry.SpikeProtectionParams) (*sentry.Response, error)
+	DisableSpikeProtection(organizationSlug string, params *sentry.SpikeProtectionParams) (*sentry.Response, error)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryProjects
 type SentryProjects interface {
+	Get(organizationSlug, projectSlug string) (*sentry.Project, *sentry.Response, error)
 	Update(organizationSlug, projectSlug string, params *sentry.UpdateProjectParams) (*sentry.Project, *sentry.Response, error)
 	Delete(organizationSlug, projectSlug string) (*sentry.Response, error)
+	AddTeam(organizationSlug, projectSlug, teamSlug string) (*sentry.Project, *sentry.Response, error)
+	RemoveTeam(organizationSlug, projectSlug, teamSlug string) (*sentry.Project, *sentry.Response, error)
 	ListKeys(organizationSlug, projectSlug string, opts *sentry.ListOptions) ([]sentry.ProjectKey, *sentry.Response, error)
 	CreateKey(organizationSlug, projectSlug string, params *sentry.CreateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error)
 	UpdateKey(organizationSlug, projectSlug, keyID string, params *sentry.UpdateProjectKeyParams) (*sentry.ProjectKey, *sentry.Response, error)
 	DeleteKey(organizationSlug, projectSlug, keyID string) (*sentry.Response, error)
+	GetEnvironment(organizationSlug, projectSlug, environmentName string) (*sentry.ProjectEnvironment, *sentry.Response, error)
+	UpdateEnvironment(organizationSlug, projectSlug, environmentName string, params *sentry.UpdateProjectEnvironmentParams) (*sentry.ProjectEnvironment, *sentry.Response, error)
+	GetOwnership(organizationSlug, projectSlug string) (*sentry.ProjectOwnership, *sentry.Response, error)
+	UpdateOwnership(organizationSlug, projectSlug string, params *sentry.UpdateProjectOwnershipParams) (*sentry.ProjectOwnership, *sentry.Response, error)
+	ListFilters(organizationSlug, projectSlug string) ([]sentry.ProjectFilter, *sentry.Response, error)
+	UpdateFilter(organizationSlug, projectSlug, filterID string, params *sentry.UpdateProjectFilterParams) (*sentry.Response, error)
+	ListQuotas(organizationSlug, projectSlug string) ([]sentry.ProjectQuota, *sentry.Response, error)
+	UpdateQuota(organizationSlug, projectSlug, category string, params *sentry.UpdateProjectQuotaParams) (*sentry.ProjectQuota, *sentry.Response, error)
+	GetRule(organizationSlug, projectSlug, ruleID string) (*sentry.IssueAlertRule, *sentry.Response, error)
+	CreateRule(organizationSlug, projectSlug string, params *sentry.CreateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error)
+	UpdateRule(organizationSlug, projectSlug, ruleID string, params *sentry.UpdateIssueAlertRuleParams) (*sentry.IssueAlertRule, *sentry.Response, error)
+	DeleteRule(organizationSlug, projectSlug, ruleID string) (*sentry.Response, error)
+	ListHooks(organizationSlug, projectSlug string, opts *sentry.ListOptions) ([]sentry.ServiceHook, *sentry.Response, error)
+	CreateHook(organizationSlug, projectSlug string, params *sentry.CreateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error)
+	UpdateHook(organizationSlug, projectSlug, hookID string, params *sentry.UpdateServiceHookParams) (*sentry.ServiceHook, *sentry.Response, error)
+	DeleteHook(organizationSlug, projectSlug, hookID string) (*sentry.Response, error)
+	GetPlugin(organizationSlug, projectSlug, pluginID string) (*sentry.ProjectPlugin, *sentry.Response, error)
+	UpdatePlugin(organizationSlug, projectSlug, pluginID string, params sentry.UpdateProjectPluginParams) (*sentry.ProjectPlugin, *sentry.Response, error)
+	EnablePlugin(organizationSlug, projectSlug, pluginID string) (*sentry.Response, error)
+	DisablePlugin(organizationSlug, projectSlug, pluginID string) (*sentry.Response, error)
+	ResetPlugin(organizationSlug, projectSlug, pluginID string) (*sentry.ProjectPlugin, *sentry.Response, error)
+	ListSearches(organizationSlug, projectSlug string) ([]sentry.SavedSearch, *sentry.Response, error)
+	CreateSearch(organizationSlug, projectSlug string, params *sentry.CreateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)
+	UpdateSearch(organizationSlug, projectSlug, searchID string, params *sentry.UpdateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)
+	DeleteSearch(organizationSlug, projectSlug, searchID string) (*sentry.Response, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryReleases
+type SentryReleases interface {
+	Get(organizationSlug, version string) (*sentry.Release, *sentry.Response, error)
+	Create(organizationSlug string, params *sentry.CreateReleaseParams) (*sentry.Release, *sentry.Response, error)
+	Update(organizationSlug, version string, params *sentry.UpdateReleaseParams) (*sentry.Release, *sentry.Response, error)
+	Finalize(organizationSlug, version string, dateReleased time.Time) (*sentry.Release, *sentry.Response, error)
+	SetCommits(organizationSlug, version string, params *sentry.SetReleaseCommitsParams) (*sentry.Release, *sentry.Response, error)
+	Delete(organizationSlug, version string) (*sentry.Response, error)
+	CreateDeploy(organizationSlug, version string, params *sentry.CreateDeployParams) (*sentry.Deploy, *sentry.Response, error)
+	ListFiles(organizationSlug, projectSlug, version string, opts *sentry.ListOptions) ([]sentry.ReleaseFile, *sentry.Response, error)
+	UploadFile(organizationSlug, projectSlug, version string, params *sentry.UploadReleaseFileParams) (*sentry.ReleaseFile, *sentry.Response, error)
+	DeleteFile(organizationSlug, projectSlug, version, fileID string) (*sentry.Response, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentrySavedSearches
+type SentrySavedSearches interface {
+	List(organizationSlug string) ([]sentry.SavedSearch, *sentry.Response, error)
+	Create(organizationSlug string, params *sentry.CreateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)
+	Update(organizationSlug, searchID string, params *sentry.UpdateSavedSearchParams) (*sentry.SavedSearch, *sentry.Response, error)
+	Delete(organizationSlug, searchID string) (*sentry.Response, error)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentrySentryApps
+type SentrySentryApps interface {
+	Get(sentryAppSlug string) (*sentry.SentryApp, *sentry.Response, error)
+	Create(params *sentry.CreateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error)
+	Update(sentryAppSlug string, params *sentry.UpdateSentryAppParams) (*sentry.SentryApp, *sentry.Response, error)
+	Delete(sentryAppSlug string) (*sentry.Response, error)
+	ListTokens(sentryAppSlug string) ([]sentry.SentryAppToken, *sentry.Response, error)
+	CreateToken(sentryAppSlug string) (*sentry.SentryAppToken, *sentry.Response, error)
+	DeleteToken(sentryAppSlug, tokenID string) (*sentry.Response, error)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SentryTeams
@@ -37,6 +175,22 @@ type SentryTeams interface {
 	CreateProject(organizationSlug, teamSlug string, params *sentry.CreateProjectParams) (*sentry.Project, *sentry.Response, error)
 }
 
+// referenceChangedPredicate filters out updates to our Custom Resources that don't change their generation, like
+// predicate.GenerationChangedPredicate, but lets through all updates to the Secrets and ConfigMaps they reference as
+// these never change their generation.
+type referenceChangedPredicate struct {
+	predicate.GenerationChangedPredicate
+}
+
+func (p referenceChangedPredicate) Update(e event.UpdateEvent) bool {
+	switch e.ObjectNew.(type) {
+	case *corev1.Secret, *corev1.ConfigMap:
+		return true
+	default:
+		return p.GenerationChangedPredicate.Update(e)
+	}
+}
+
 func removeFinalizer(finalizers []string, name string) []string {
 	var result []string
 	for _, item := range finalizers {
@@ -59,3 +213,48 @@ func containsFinalizer(finalizers []string, name string) bool {
 
 	return false
 }
+
+func containsString(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringSetsEqual returns whether the given slices contain the same strings, regardless of their order.
+func stringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int)
+	for _, item := range a {
+		counts[item]++
+	}
+
+	for _, item := range b {
+		if counts[item] == 0 {
+			return false
+		}
+		counts[item]--
+	}
+
+	return true
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,442 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	InternalIntegrationFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/internalintegration"
+)
+
+// InternalIntegrationReconciler reconciles a InternalIntegration object
+type InternalIntegrationReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *InternalIntegrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.InternalIntegration{}).
+		Owns(&corev1.Secret{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=internalintegrations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=internalintegrations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+func (r *InternalIntegrationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("internalintegration", req.NamespacedName)
+
+	var integration sentryv1alpha1.InternalIntegration
+	if err := r.Get(ctx, req.NamespacedName, &integration); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch InternalIntegration")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &integration, InternalIntegrationFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &integration, err)
+	}
+
+	hasFinalizer := containsFinalizer(integration.GetFinalizers(), InternalIntegrationFinalizerName)
+
+	// Create our Sentry resource and secret if we have not been synced before
+	if integration.Status.LastSynced.IsZero() {
+		if err := r.handleCreate(ctx, sc, &integration, hasFinalizer); err != nil {
+			log.Error(err, "failed to create InternalIntegration")
+			return ctrl.Result{}, r.handleError(ctx, &integration, err)
+		}
+
+		if err := r.reconcileToken(ctx, sc, &integration); err != nil {
+			log.Error(err, "failed to create Secret for InternalIntegration")
+			return ctrl.Result{}, r.handleError(ctx, &integration, err)
+		}
+
+		log.Info("successfully created InternalIntegration")
+		return ctrl.Result{}, nil
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, err := r.getExistingState(sc, integration)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry internal integration state")
+		return ctrl.Result{}, r.handleError(ctx, &integration, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !integration.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &integration, existing); err != nil {
+				log.Error(err, "failed to delete InternalIntegration")
+				return ctrl.Result{}, r.handleError(ctx, &integration, err)
+			}
+		}
+
+		log.Info("successfully deleted InternalIntegration")
+		return ctrl.Result{}, nil
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		if err := r.handleCreate(ctx, sc, &integration, hasFinalizer); err != nil {
+			log.Error(err, "failed to recreate InternalIntegration")
+			return ctrl.Result{}, r.handleError(ctx, &integration, err)
+		}
+
+		log.Info("successfully recreated InternalIntegration")
+
+		// Reconcile our secret as the tokens of the previous internal integration will have been revoked along with it
+		if err := r.reconcileToken(ctx, sc, &integration); err != nil {
+			log.Error(err, "failed to reconcile Secret for InternalIntegration")
+			return ctrl.Result{}, r.handleError(ctx, &integration, err)
+		}
+
+		log.Info("successfully reconciled Secret for InternalIntegration")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	if err := r.handleUpdate(ctx, sc, &integration, existing); err != nil {
+		log.Error(err, "failed to update InternalIntegration")
+		return ctrl.Result{}, r.handleError(ctx, &integration, err)
+	}
+
+	log.Info("successfully updated InternalIntegration")
+
+	// Reconcile our secret to ensure that it holds a valid token of our Sentry internal integration
+	if err := r.reconcileToken(ctx, sc, &integration); err != nil {
+		log.Error(err, "failed to reconcile Secret for InternalIntegration")
+		return ctrl.Result{}, r.handleError(ctx, &integration, err)
+	}
+
+	log.Info("successfully reconciled Secret for InternalIntegration")
+
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource slug,
+// and returns an ErrOutOfSync error if the resource cannot be found.
+func (r *InternalIntegrationReconciler) getExistingState(sc *Sentry, integration sentryv1alpha1.InternalIntegration) (*sentry.SentryApp, error) {
+	sApp, resp, err := sc.Client.SentryApps.Get(integration.Status.Slug)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+			return nil, err
+		}
+	}
+
+	return sApp, nil
+}
+
+func (r *InternalIntegrationReconciler) handleCreate(ctx context.Context, sc *Sentry, integration *sentryv1alpha1.InternalIntegration, hasFinalizer bool) error {
+	if err := checkScopes(sc, integration); err != nil {
+		return err
+	}
+
+	sApp, resp, err := sc.Client.SentryApps.Create(&sentry.CreateSentryAppParams{
+		Events:       []string{},
+		IsInternal:   true,
+		Name:         integration.Spec.Name,
+		Organization: sc.Organization,
+		Scopes:       internalIntegrationScopes(integration.Spec.Scopes),
+	})
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		default:
+			// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+			return err
+		}
+	}
+
+	integration.Status.Condition = sentryv1alpha1.InternalIntegrationConditionCreated
+	integration.Status.Message = ""
+	integration.Status.Slug = sApp.Slug
+	integration.Status.TokenID = ""
+	integration.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, integration); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		integration.SetFinalizers(append(integration.GetFinalizers(), InternalIntegrationFinalizerName))
+		if err := r.Update(ctx, integration); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+// reconcileToken ensures that our secret holds a valid token of our Sentry internal integration, issuing a new token
+// if it doesn't. Any other tokens of the internal integration, such as the one issued by Sentry when the internal
+// integration was created, are revoked so that our secret holds its only token.
+func (r *InternalIntegrationReconciler) reconcileToken(ctx context.Context, sc *Sentry, integration *sentryv1alpha1.InternalIntegration) error {
+	tokens, resp, err := sc.Client.SentryApps.ListTokens(integration.Status.Slug)
+	if err != nil {
+		return internalIntegrationError(resp, err)
+	}
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("sentry-internalintegration-%s", integration.Name),
+			Namespace:   integration.Namespace,
+			Labels:      make(map[string]string),
+			Annotations: make(map[string]string),
+		},
+		Data: make(map[string][]byte),
+	}
+
+	var existing corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, &existing); err != nil && !apierrors.IsNotFound(err) {
+		return retryableError{err}
+	}
+
+	// Sentry only returns a token's value in full when it is issued, so issue a new token if our secret doesn't hold the
+	// token that we have previously issued
+	token := existing.Data["SENTRY_AUTH_TOKEN"]
+	if len(token) == 0 || !containsToken(tokens, integration.Status.TokenID) {
+		sToken, resp, err := sc.Client.SentryApps.CreateToken(integration.Status.Slug)
+		if err != nil {
+			return internalIntegrationError(resp, err)
+		}
+
+		token = []byte(sToken.Token)
+		integration.Status.TokenID = sToken.ID
+	}
+
+	if err := ctrl.SetControllerReference(integration, secret, r.Scheme); err != nil {
+		return err
+	}
+
+	if _, err := ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		for k, v := range integration.Labels {
+			secret.Labels[k] = v
+		}
+
+		for k, v := range integration.Annotations {
+			secret.Annotations[k] = v
+		}
+
+		secret.Data["SENTRY_AUTH_TOKEN"] = token
+		secret.Data["SENTRY_ORG"] = []byte(sc.Organization)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := r.Status().Update(ctx, integration); err != nil {
+		return retryableError{err}
+	}
+
+	for _, sToken := range tokens {
+		if sToken.ID == integration.Status.TokenID {
+			continue
+		}
+
+		resp, err := sc.Client.SentryApps.DeleteToken(integration.Status.Slug, sToken.ID)
+		if err != nil && resp.StatusCode != http.StatusNotFound {
+			return internalIntegrationError(resp, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *InternalIntegrationReconciler) handleDelete(ctx context.Context, sc *Sentry, integration *sentryv1alpha1.InternalIntegration, existing *sentry.SentryApp) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below. Deleting the internal
+	// integration also revokes all of its tokens.
+	if existing != nil {
+		resp, err := sc.Client.SentryApps.Delete(existing.Slug)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	integration.SetFinalizers(removeFinalizer(integration.GetFinalizers(), InternalIntegrationFinalizerName))
+	if err := r.Update(ctx, integration); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *InternalIntegrationReconciler) handleUpdate(ctx context.Context, sc *Sentry, integration *sentryv1alpha1.InternalIntegration, existing *sentry.SentryApp) error {
+	if err := checkScopes(sc, integration); err != nil {
+		return err
+	}
+
+	scopes := internalIntegrationScopes(integration.Spec.Scopes)
+	if integration.Spec.Name != existing.Name || !stringSetsEqual(scopes, existing.Scopes) {
+		_, resp, err := sc.Client.SentryApps.Update(existing.Slug, &sentry.UpdateSentryAppParams{
+			Name:   integration.Spec.Name,
+			Scopes: scopes,
+		})
+		if err != nil {
+			return internalIntegrationError(resp, err)
+		}
+	}
+
+	integration.Status.Condition = sentryv1alpha1.InternalIntegrationConditionCreated
+	integration.Status.Message = ""
+	integration.Status.Slug = existing.Slug
+	integration.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, integration); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *InternalIntegrationReconciler) handleError(ctx context.Context, integration *sentryv1alpha1.InternalIntegration, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(integration, corev1.EventTypeNormal, "DryRun", de.Error())
+		integration.Status.Condition = sentryv1alpha1.InternalIntegrationConditionPlanned
+		integration.Status.Message = de.Error()
+		return r.Status().Update(ctx, integration)
+	}
+
+	integration.Status.Condition = sentryv1alpha1.InternalIntegrationConditionError
+	integration.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, integration); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// internalIntegrationError wraps errors from requests against an existing Sentry internal integration, retrying on 5XX
+// and 404 errors.
+func internalIntegrationError(resp *sentry.Response, err error) error {
+	switch {
+	case resp.StatusCode >= 500:
+		return retryableError{err}
+	case resp.StatusCode == http.StatusNotFound:
+		// Retry on 404 errors as our Sentry internal integration will get recreated if it was deleted
+		return retryableError{err}
+	default:
+		// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+		return err
+	}
+}
+
+// checkScopes returns an error if our spec requests a permission scope that our namespace is not allowed to request.
+func checkScopes(sc *Sentry, integration *sentryv1alpha1.InternalIntegration) error {
+	for _, scope := range integration.Spec.Scopes {
+		if !sc.allowsScope(string(scope)) {
+			return fmt.Errorf("namespace %s is not allowed to request the %s scope using the default Sentry credentials", integration.Namespace, scope)
+		}
+	}
+
+	return nil
+}
+
+func internalIntegrationScopes(scopes []sentryv1alpha1.InternalIntegrationScope) []string {
+	sScopes := make([]string, len(scopes))
+	for idx, scope := range scopes {
+		sScopes[idx] = string(scope)
+	}
+	return sScopes
+}
+
+func containsToken(tokens []sentry.SentryAppToken, id string) bool {
+	for _, token := range tokens {
+		if token.ID == id {
+			return true
+		}
+	}
+	return false
+}
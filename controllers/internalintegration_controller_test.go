@@ -0,0 +1,272 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("InternalIntegrationReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		integrationName      = "test-internalintegration"
+		integrationNamespace = "test-internalintegration-namespace"
+	)
+
+	var (
+		lookupKey       types.NamespacedName
+		secretLookupKey types.NamespacedName
+		integration     *sentryv1alpha1.InternalIntegration
+		secret          *corev1.Secret
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.InternalIntegration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "InternalIntegration",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      integrationName,
+			Namespace: integrationNamespace,
+			Labels: map[string]string{
+				"label": "test-label",
+			},
+		},
+		Spec: sentryv1alpha1.InternalIntegrationSpec{
+			Name:   "Test Integration",
+			Scopes: []sentryv1alpha1.InternalIntegrationScope{"project:read", "event:write"},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: integrationName, Namespace: integrationNamespace}
+		secretLookupKey = types.NamespacedName{Name: fmt.Sprintf("sentry-internalintegration-%s", integrationName), Namespace: integrationNamespace}
+
+		integration = new(sentryv1alpha1.InternalIntegration)
+		secret = new(corev1.Secret)
+	})
+
+	Context("when creating an InternalIntegration", func() {
+		var (
+			created *sentry.SentryAppToken
+		)
+
+		BeforeEach(func() {
+			fakeSentrySentryApps.CreateReturns(testSentrySentryApp("test-integration-1a2b3c", "Test Integration", "project:read", "event:write"), newSentryResponse(http.StatusCreated), nil)
+
+			// Sentry issues a token when an internal integration is created, which should get revoked in favour of ours
+			issued := testSentrySentryAppToken("1", "test-issued-token")
+			fakeSentrySentryApps.ListTokensReturns([]sentry.SentryAppToken{*issued}, newSentryResponse(http.StatusOK), nil)
+
+			created = testSentrySentryAppToken("2", "test-token")
+			fakeSentrySentryApps.CreateTokenReturns(created, newSentryResponse(http.StatusCreated), nil)
+			fakeSentrySentryApps.DeleteTokenReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the InternalIntegration gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.InternalIntegrationStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, integration)
+				if err != nil {
+					return nil, err
+				}
+				return &integration.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.InternalIntegrationConditionCreated),
+					"Message":   BeEmpty(),
+					"Slug":      Equal("test-integration-1a2b3c"),
+					"TokenID":   Equal("2"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(integration.Finalizers).To(ContainElement(controllers.InternalIntegrationFinalizerName))
+
+			By("invoked the Sentry client's .SentryApps.Create method")
+			params := fakeSentrySentryApps.CreateArgsForCall(fakeSentrySentryApps.CreateCallCount() - 1)
+			Expect(params).To(Equal(&sentry.CreateSentryAppParams{
+				Events:       []string{},
+				IsInternal:   true,
+				Name:         "Test Integration",
+				Organization: "organization",
+				Scopes:       []string{"project:read", "event:write"},
+			}))
+
+			By("invoked the Sentry client's .SentryApps.DeleteToken method")
+			sentryAppSlug, tokenID := fakeSentrySentryApps.DeleteTokenArgsForCall(fakeSentrySentryApps.DeleteTokenCallCount() - 1)
+			Expect(sentryAppSlug).To(Equal("test-integration-1a2b3c"))
+			Expect(tokenID).To(Equal("1"))
+		})
+
+		It("the Secret gets created successfully", func() {
+			Eventually(func() (map[string][]byte, error) {
+				err := k8sClient.Get(ctx, secretLookupKey, secret)
+				if err != nil {
+					return nil, err
+				}
+				return secret.Data, nil
+			}, timeout, interval).Should(And(
+				HaveKeyWithValue("SENTRY_AUTH_TOKEN", []byte(created.Token)),
+				HaveKeyWithValue("SENTRY_ORG", []byte("organization")),
+			))
+
+			By("with the desired labels")
+			Expect(secret.Labels).To(HaveKeyWithValue("label", "test-label"))
+
+			By("with the expected owner reference")
+			Expect(secret.ObjectMeta.OwnerReferences).To(
+				ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"APIVersion": Equal("sentry.kubernetes.jaceys.me/v1alpha1"),
+						"Kind":       Equal("InternalIntegration"),
+						"Name":       Equal(request.GetName()),
+						"UID":        Equal(request.GetUID()),
+					}),
+				),
+			)
+		})
+	})
+
+	Context("when updating an InternalIntegration", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, integration)).To(Succeed())
+
+			existing := testSentrySentryApp("test-integration-1a2b3c", "Test Integration", "project:read", "event:write")
+			fakeSentrySentryApps.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentrySentryApps.ListTokensReturns([]sentry.SentryAppToken{*testSentrySentryAppToken("2", "test-token")}, newSentryResponse(http.StatusOK), nil)
+
+			integration.Spec.Scopes = []sentryv1alpha1.InternalIntegrationScope{"project:read", "project:releases"}
+
+			updated := testSentrySentryApp("test-integration-1a2b3c", "Test Integration", "project:read", "project:releases")
+			fakeSentrySentryApps.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the InternalIntegration gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, integration)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.InternalIntegrationStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, integration)
+				if err != nil {
+					return nil, err
+				}
+				return &integration.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.InternalIntegrationConditionCreated),
+					"Message":   BeEmpty(),
+					"Slug":      Equal("test-integration-1a2b3c"),
+					"TokenID":   Equal("2"),
+				})),
+			)
+
+			By("invoked the Sentry client's .SentryApps.Update method")
+			sentryAppSlug, params := fakeSentrySentryApps.UpdateArgsForCall(fakeSentrySentryApps.UpdateCallCount() - 1)
+			Expect(sentryAppSlug).To(Equal("test-integration-1a2b3c"))
+			Expect(params).To(Equal(&sentry.UpdateSentryAppParams{
+				Name:   "Test Integration",
+				Scopes: []string{"project:read", "project:releases"},
+			}))
+		})
+	})
+
+	Context("when updating an InternalIntegration with an admin scope", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, integration)).To(Succeed())
+
+			existing := testSentrySentryApp("test-integration-1a2b3c", "Test Integration", "project:read", "project:releases")
+			fakeSentrySentryApps.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentrySentryApps.ListTokensReturns([]sentry.SentryAppToken{*testSentrySentryAppToken("2", "test-token")}, newSentryResponse(http.StatusOK), nil)
+
+			integration.Spec.Scopes = []sentryv1alpha1.InternalIntegrationScope{"project:read", "org:admin"}
+		})
+
+		It("the InternalIntegration fails to request a scope that isn't allowed", func() {
+			updates := fakeSentrySentryApps.UpdateCallCount()
+			Expect(k8sClient.Update(ctx, integration)).To(Succeed())
+
+			Eventually(func() (*sentryv1alpha1.InternalIntegrationStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, integration)
+				if err != nil {
+					return nil, err
+				}
+				return &integration.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.InternalIntegrationConditionError),
+					"Message":   Equal("namespace test-internalintegration-namespace is not allowed to request the org:admin scope using the default Sentry credentials"),
+				})),
+			)
+
+			By("did not invoke the Sentry client's .SentryApps.Update method")
+			Expect(fakeSentrySentryApps.UpdateCallCount()).To(Equal(updates))
+		})
+	})
+
+	Context("when deleting an InternalIntegration", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, integration)).To(Succeed())
+
+			existing := testSentrySentryApp("test-integration-1a2b3c", "Test Integration", "project:read", "project:releases")
+			fakeSentrySentryApps.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentrySentryApps.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the InternalIntegration gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, integration)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, integration)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .SentryApps.Delete method")
+			sentryAppSlug := fakeSentrySentryApps.DeleteArgsForCall(fakeSentrySentryApps.DeleteCallCount() - 1)
+			Expect(sentryAppSlug).To(Equal("test-integration-1a2b3c"))
+		})
+	})
+})
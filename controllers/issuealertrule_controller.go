@@ -0,0 +1,432 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	IssueAlertRuleFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/issuealertrule"
+)
+
+// IssueAlertRuleReconciler reconciles a IssueAlertRule object
+type IssueAlertRuleReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *IssueAlertRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.IssueAlertRule{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=issuealertrules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=issuealertrules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *IssueAlertRuleReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("issuealertrule", req.NamespacedName)
+
+	var rule sentryv1alpha1.IssueAlertRule
+	if err := r.Get(ctx, req.NamespacedName, &rule); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch IssueAlertRule")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &rule, IssueAlertRuleFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	hasFinalizer := containsFinalizer(rule.GetFinalizers(), IssueAlertRuleFinalizerName)
+
+	// Create our Sentry resource if we have not been synced before, unless we've been asked to adopt an existing Sentry
+	// resource
+	adoptID, adopt := rule.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if rule.Status.LastSynced.IsZero() && !adopt {
+		if err := r.handleCreate(ctx, sc, &rule, hasFinalizer); err != nil {
+			log.Error(err, "failed to create IssueAlertRule")
+			return ctrl.Result{}, r.handleError(ctx, &rule, err)
+		}
+
+		log.Info("successfully created IssueAlertRule")
+		return ctrl.Result{}, nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if rule.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &rule, IssueAlertRuleFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt IssueAlertRule")
+			return ctrl.Result{}, r.handleError(ctx, &rule, err)
+		}
+
+		rule.Status.ID = adoptID
+		log.Info("adopting existing Sentry issue alert rule", "id", adoptID)
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, sProject, err := r.getExistingState(sc, rule)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry issue alert rule state")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !rule.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &rule, existing, sProject); err != nil {
+				log.Error(err, "failed to delete IssueAlertRule")
+				return ctrl.Result{}, r.handleError(ctx, &rule, err)
+			}
+		}
+
+		log.Info("successfully deleted IssueAlertRule")
+		return ctrl.Result{}, nil
+	}
+
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && rule.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry issue alert rule %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt IssueAlertRule")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		if err := r.handleCreate(ctx, sc, &rule, hasFinalizer); err != nil {
+			log.Error(err, "failed to recreate IssueAlertRule")
+			return ctrl.Result{}, r.handleError(ctx, &rule, err)
+		}
+
+		log.Info("successfully recreated IssueAlertRule")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	if err := r.handleUpdate(ctx, sc, &rule, existing, sProject); err != nil {
+		log.Error(err, "failed to update IssueAlertRule")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	log.Info("successfully updated IssueAlertRule")
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
+// returns an ErrOutOfSync error if the resource cannot be found. It also returns our associated project, as an issue
+// alert rule can only be retrieved through the project it belongs to.
+func (r *IssueAlertRuleReconciler) getExistingState(sc *Sentry, rule sentryv1alpha1.IssueAlertRule) (*sentry.IssueAlertRule, *sentry.Project, error) {
+	listProjectsOpts := &sentry.ListOptions{}
+	var sProjects []sentry.Project
+	for {
+		projects, resp, err := sc.Client.Organizations.ListProjects(sc.Organization, listProjectsOpts)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return nil, nil, retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that there might be an issue with our organization
+				return nil, nil, err
+			}
+		}
+
+		sProjects = append(sProjects, projects...)
+		if !resp.NextPage.Results {
+			break
+		}
+		listProjectsOpts.Cursor = resp.NextPage.Cursor
+	}
+
+	var sProject *sentry.Project
+	for idx := range sProjects {
+		if sProjects[idx].ID == rule.Status.ProjectID {
+			sProject = &sProjects[idx]
+			break
+		}
+
+		// Fall back to our spec's project if we are adopting an existing Sentry issue alert rule, as we won't know the
+		// ID of its project yet
+		if rule.Status.ProjectID == "" && sProjects[idx].Slug == rule.Spec.Project {
+			sProject = &sProjects[idx]
+			break
+		}
+	}
+
+	if sProject == nil {
+		return nil, nil, ErrOutOfSync
+	}
+
+	sRule, resp, err := sc.Client.Projects.GetRule(sc.Organization, sProject.Slug, rule.Status.ID)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, sProject, ErrOutOfSync
+		case resp.StatusCode == http.StatusFound:
+			return nil, sProject, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our spec
+			return nil, nil, err
+		}
+	}
+
+	return sRule, sProject, nil
+}
+
+func (r *IssueAlertRuleReconciler) handleCreate(ctx context.Context, sc *Sentry, rule *sentryv1alpha1.IssueAlertRule, hasFinalizer bool) error {
+	// Fetch our Sentry project first, as its ID is not part of the payload returned when creating an issue alert rule
+	sProject, resp, err := sc.Client.Projects.Get(sc.Organization, rule.Spec.Project)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		case resp.StatusCode == http.StatusFound:
+			// Retry on 302 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return err
+		}
+	}
+
+	params := issueAlertRuleParams(rule.Spec)
+	sRule, resp, err := sc.Client.Projects.CreateRule(sc.Organization, sProject.Slug, (*sentry.CreateIssueAlertRuleParams)(params))
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec, such as
+			// validation errors for our conditions, filters or actions
+			return err
+		}
+	}
+
+	rule.Status.Condition = sentryv1alpha1.IssueAlertRuleConditionCreated
+	rule.Status.Message = ""
+	rule.Status.ID = sRule.ID
+	rule.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	rule.Status.ProjectID = sProject.ID
+	if err := r.Status().Update(ctx, rule); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		rule.SetFinalizers(append(rule.GetFinalizers(), IssueAlertRuleFinalizerName))
+		if err := r.Update(ctx, rule); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *IssueAlertRuleReconciler) handleDelete(ctx context.Context, sc *Sentry, rule *sentryv1alpha1.IssueAlertRule, existing *sentry.IssueAlertRule, sProject *sentry.Project) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below
+	if existing != nil {
+		resp, err := sc.Client.Projects.DeleteRule(sc.Organization, sProject.Slug, existing.ID)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			case resp.StatusCode == http.StatusFound:
+				// Ignore 302 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	rule.SetFinalizers(removeFinalizer(rule.GetFinalizers(), IssueAlertRuleFinalizerName))
+	if err := r.Update(ctx, rule); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *IssueAlertRuleReconciler) handleUpdate(ctx context.Context, sc *Sentry, rule *sentryv1alpha1.IssueAlertRule, existing *sentry.IssueAlertRule, sProject *sentry.Project) error {
+	// Error if our spec's project doesn't match reality as moving an issue alert rule to another project is not a valid
+	// operation. This helps highlight configuration drift where a user forgets to update our spec's project after
+	// modifying the associated project's slug.
+	if rule.Spec.Project != sProject.Slug {
+		return retryableError{fmt.Errorf("%w: IssueAlertRule's project could not be updated", ErrOutOfSync)}
+	}
+
+	params := issueAlertRuleParams(rule.Spec)
+	sRule, resp, err := sc.Client.Projects.UpdateRule(sc.Organization, sProject.Slug, existing.ID, params)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		case resp.StatusCode == http.StatusFound:
+			// Retry on 302 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec, such as
+			// validation errors for our conditions, filters or actions
+			return err
+		}
+	}
+
+	rule.Status.Condition = sentryv1alpha1.IssueAlertRuleConditionCreated
+	rule.Status.Message = ""
+	rule.Status.ID = sRule.ID
+	rule.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	rule.Status.ProjectID = sProject.ID
+	if err := r.Status().Update(ctx, rule); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *IssueAlertRuleReconciler) handleError(ctx context.Context, rule *sentryv1alpha1.IssueAlertRule, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(rule, corev1.EventTypeNormal, "DryRun", de.Error())
+		rule.Status.Condition = sentryv1alpha1.IssueAlertRuleConditionPlanned
+		rule.Status.Message = de.Error()
+		return r.Status().Update(ctx, rule)
+	}
+
+	rule.Status.Condition = sentryv1alpha1.IssueAlertRuleConditionError
+	rule.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, rule); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// issueAlertRuleParams returns the parameters for creating or updating a Sentry issue alert rule from our spec,
+// applying Sentry's defaults for any fields that have not been set.
+func issueAlertRuleParams(spec sentryv1alpha1.IssueAlertRuleSpec) *sentry.UpdateIssueAlertRuleParams {
+	params := &sentry.UpdateIssueAlertRuleParams{
+		ActionMatch: spec.ActionMatch,
+		Actions:     issueAlertRuleComponents(spec.Actions),
+		Conditions:  issueAlertRuleComponents(spec.Conditions),
+		Environment: spec.Environment,
+		FilterMatch: spec.FilterMatch,
+		Filters:     issueAlertRuleComponents(spec.Filters),
+		Frequency:   spec.Frequency,
+		Name:        spec.Name,
+	}
+
+	if params.ActionMatch == "" {
+		params.ActionMatch = "all"
+	}
+
+	if params.FilterMatch == "" {
+		params.FilterMatch = "all"
+	}
+
+	if params.Frequency == 0 {
+		params.Frequency = 30
+	}
+
+	return params
+}
+
+// issueAlertRuleComponents converts our spec's components into Sentry issue alert rule components, flattening their
+// attributes alongside their ID.
+func issueAlertRuleComponents(components []sentryv1alpha1.IssueAlertRuleComponent) []sentry.IssueAlertRuleComponent {
+	result := make([]sentry.IssueAlertRuleComponent, len(components))
+	for idx, component := range components {
+		result[idx] = sentry.IssueAlertRuleComponent{"id": component.ID}
+		for k, v := range component.Attributes {
+			// Don't allow attributes to override the component's ID
+			if k == "id" {
+				continue
+			}
+
+			result[idx][k] = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,231 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("IssueAlertRuleReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		ruleName      = "test-issuealertrule"
+		ruleNamespace = "test-issuealertrule-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		rule      *sentryv1alpha1.IssueAlertRule
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.IssueAlertRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "IssueAlertRule",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ruleName,
+			Namespace: ruleNamespace,
+		},
+		Spec: sentryv1alpha1.IssueAlertRuleSpec{
+			Project: "test-project",
+			Name:    "test-issuealertrule",
+			Conditions: []sentryv1alpha1.IssueAlertRuleComponent{
+				{
+					ID: "sentry.rules.conditions.event_frequency.EventFrequencyCondition",
+					Attributes: map[string]string{
+						"interval": "1h",
+						"value":    "100",
+					},
+				},
+			},
+			Actions: []sentryv1alpha1.IssueAlertRuleComponent{
+				{
+					ID: "sentry.mail.actions.NotifyEmailAction",
+					Attributes: map[string]string{
+						"targetType": "IssueOwners",
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: ruleName, Namespace: ruleNamespace}
+		rule = new(sentryv1alpha1.IssueAlertRule)
+	})
+
+	Context("when creating an IssueAlertRule", func() {
+		BeforeEach(func() {
+			project := testSentryProject("0", "test-team", request.Spec.Project)
+			fakeSentryProjects.GetReturns(project, newSentryResponse(http.StatusOK), nil)
+			fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*project}, newSentryResponse(http.StatusOK), nil)
+
+			created := testSentryIssueAlertRule("12345", request.Spec.Project, request.Spec.Name)
+			fakeSentryProjects.CreateRuleReturns(created, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.GetRuleReturns(created, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.UpdateRuleReturns(created, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the IssueAlertRule gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.IssueAlertRuleStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, rule)
+				if err != nil {
+					return nil, err
+				}
+				return &rule.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.IssueAlertRuleConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+					"ProjectID": Equal("0"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(rule.Finalizers).To(ContainElement(controllers.IssueAlertRuleFinalizerName))
+
+			By("invoked the Sentry client's .Projects.CreateRule method")
+			organizationSlug, projectSlug, params := fakeSentryProjects.CreateRuleArgsForCall(fakeSentryProjects.CreateRuleCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal(request.Spec.Project))
+			Expect(params).To(Equal(&sentry.CreateIssueAlertRuleParams{
+				ActionMatch: "all",
+				Actions: []sentry.IssueAlertRuleComponent{
+					{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"},
+				},
+				Conditions: []sentry.IssueAlertRuleComponent{
+					{"id": "sentry.rules.conditions.event_frequency.EventFrequencyCondition", "interval": "1h", "value": "100"},
+				},
+				FilterMatch: "all",
+				Filters:     []sentry.IssueAlertRuleComponent{},
+				Frequency:   30,
+				Name:        "test-issuealertrule",
+			}))
+		})
+	})
+
+	Context("when updating an IssueAlertRule", func() {
+		var (
+			existing *sentry.IssueAlertRule
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, rule)).To(Succeed())
+
+			existing = testSentryIssueAlertRule("12345", rule.Spec.Project, rule.Spec.Name)
+			fakeSentryProjects.GetRuleReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+			rule.Spec.Name = "test-issuealertrule-update"
+			rule.Spec.Frequency = 60
+
+			updated := testSentryIssueAlertRule("12345", rule.Spec.Project, rule.Spec.Name)
+			fakeSentryProjects.UpdateRuleReturns(updated, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the IssueAlertRule gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, rule)).To(Succeed())
+
+			By("invoked the Sentry client's .Projects.UpdateRule method")
+			Eventually(func() (*sentry.UpdateIssueAlertRuleParams, error) {
+				if fakeSentryProjects.UpdateRuleCallCount() == 0 {
+					return nil, nil
+				}
+				_, _, _, params := fakeSentryProjects.UpdateRuleArgsForCall(fakeSentryProjects.UpdateRuleCallCount() - 1)
+				return params, nil
+			}, timeout, interval).Should(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal("test-issuealertrule-update"),
+				"Frequency": Equal(60),
+			})))
+
+			organizationSlug, projectSlug, ruleID, _ := fakeSentryProjects.UpdateRuleArgsForCall(fakeSentryProjects.UpdateRuleCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(ruleID).To(Equal("12345"))
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.IssueAlertRuleStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, rule)
+				if err != nil {
+					return nil, err
+				}
+				return &rule.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.IssueAlertRuleConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+				})),
+			)
+		})
+
+		Context("the Sentry client returns a validation error", func() {
+			BeforeEach(func() {
+				rule.Spec.Name = "test-issuealertrule-error"
+				apiErr := sentry.APIError{"conditions": []interface{}{"Invalid condition"}}
+				fakeSentryProjects.UpdateRuleReturns(nil, newSentryResponse(http.StatusBadRequest), apiErr)
+			})
+
+			It("the IssueAlertRule gets updated unsuccessfully", func() {
+				Expect(k8sClient.Update(ctx, rule)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.IssueAlertRuleStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, rule)
+					if err != nil {
+						return nil, err
+					}
+					return &rule.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.IssueAlertRuleConditionError),
+						"Message":   ContainSubstring("Invalid condition"),
+						"ID":        Equal("12345"),
+					})),
+				)
+			})
+		})
+	})
+
+	Context("when deleting an IssueAlertRule", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, rule)).To(Succeed())
+
+			existing := testSentryIssueAlertRule("12345", rule.Spec.Project, rule.Spec.Name)
+			fakeSentryProjects.GetRuleReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.DeleteRuleReturns(newSentryResponse(http.StatusAccepted), nil)
+		})
+
+		It("the IssueAlertRule gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, rule)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, rule)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Projects.DeleteRule method")
+			organizationSlug, projectSlug, ruleID := fakeSentryProjects.DeleteRuleArgsForCall(fakeSentryProjects.DeleteRuleCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(ruleID).To(Equal("12345"))
+		})
+	})
+})
@@ -0,0 +1,385 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	MetricAlertRuleFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/metricalertrule"
+)
+
+// MetricAlertRuleReconciler reconciles a MetricAlertRule object
+type MetricAlertRuleReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *MetricAlertRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.MetricAlertRule{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=metricalertrules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=metricalertrules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *MetricAlertRuleReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("metricalertrule", req.NamespacedName)
+
+	var rule sentryv1alpha1.MetricAlertRule
+	if err := r.Get(ctx, req.NamespacedName, &rule); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch MetricAlertRule")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &rule, MetricAlertRuleFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	hasFinalizer := containsFinalizer(rule.GetFinalizers(), MetricAlertRuleFinalizerName)
+
+	// Create our Sentry resource if we have not been synced before, unless we've been asked to adopt an existing Sentry
+	// resource
+	adoptID, adopt := rule.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if rule.Status.LastSynced.IsZero() && !adopt {
+		if err := r.handleCreate(ctx, sc, &rule, hasFinalizer); err != nil {
+			log.Error(err, "failed to create MetricAlertRule")
+			return ctrl.Result{}, r.handleError(ctx, &rule, err)
+		}
+
+		log.Info("successfully created MetricAlertRule")
+		return ctrl.Result{}, nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if rule.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &rule, MetricAlertRuleFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt MetricAlertRule")
+			return ctrl.Result{}, r.handleError(ctx, &rule, err)
+		}
+
+		rule.Status.ID = adoptID
+		log.Info("adopting existing Sentry metric alert rule", "id", adoptID)
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, err := r.getExistingState(sc, rule)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry metric alert rule state")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !rule.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &rule, existing); err != nil {
+				log.Error(err, "failed to delete MetricAlertRule")
+				return ctrl.Result{}, r.handleError(ctx, &rule, err)
+			}
+		}
+
+		log.Info("successfully deleted MetricAlertRule")
+		return ctrl.Result{}, nil
+	}
+
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && rule.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry metric alert rule %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt MetricAlertRule")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		if err := r.handleCreate(ctx, sc, &rule, hasFinalizer); err != nil {
+			log.Error(err, "failed to recreate MetricAlertRule")
+			return ctrl.Result{}, r.handleError(ctx, &rule, err)
+		}
+
+		log.Info("successfully recreated MetricAlertRule")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	if err := r.handleUpdate(ctx, sc, &rule, existing); err != nil {
+		log.Error(err, "failed to update MetricAlertRule")
+		return ctrl.Result{}, r.handleError(ctx, &rule, err)
+	}
+
+	log.Info("successfully updated MetricAlertRule")
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
+// returns an ErrOutOfSync error if the resource cannot be found.
+func (r *MetricAlertRuleReconciler) getExistingState(sc *Sentry, rule sentryv1alpha1.MetricAlertRule) (*sentry.MetricAlertRule, error) {
+	sRule, resp, err := sc.Client.MetricAlertRules.Get(sc.Organization, rule.Status.ID)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+			return nil, err
+		}
+	}
+
+	return sRule, nil
+}
+
+func (r *MetricAlertRuleReconciler) handleCreate(ctx context.Context, sc *Sentry, rule *sentryv1alpha1.MetricAlertRule, hasFinalizer bool) error {
+	params, err := metricAlertRuleParams(rule.Spec, nil)
+	if err != nil {
+		return err
+	}
+
+	sRule, resp, err := sc.Client.MetricAlertRules.Create(sc.Organization, (*sentry.CreateMetricAlertRuleParams)(params))
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec, such as
+			// validation errors for our aggregate, query or triggers
+			return err
+		}
+	}
+
+	rule.Status.Condition = sentryv1alpha1.MetricAlertRuleConditionCreated
+	rule.Status.Message = ""
+	rule.Status.ID = sRule.ID
+	rule.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, rule); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		rule.SetFinalizers(append(rule.GetFinalizers(), MetricAlertRuleFinalizerName))
+		if err := r.Update(ctx, rule); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *MetricAlertRuleReconciler) handleDelete(ctx context.Context, sc *Sentry, rule *sentryv1alpha1.MetricAlertRule, existing *sentry.MetricAlertRule) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below
+	if existing != nil {
+		resp, err := sc.Client.MetricAlertRules.Delete(sc.Organization, existing.ID)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	rule.SetFinalizers(removeFinalizer(rule.GetFinalizers(), MetricAlertRuleFinalizerName))
+	if err := r.Update(ctx, rule); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *MetricAlertRuleReconciler) handleUpdate(ctx context.Context, sc *Sentry, rule *sentryv1alpha1.MetricAlertRule, existing *sentry.MetricAlertRule) error {
+	params, err := metricAlertRuleParams(rule.Spec, existing)
+	if err != nil {
+		return err
+	}
+
+	sRule, resp, err := sc.Client.MetricAlertRules.Update(sc.Organization, existing.ID, params)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec, such as
+			// validation errors for our aggregate, query or triggers
+			return err
+		}
+	}
+
+	rule.Status.Condition = sentryv1alpha1.MetricAlertRuleConditionCreated
+	rule.Status.Message = ""
+	rule.Status.ID = sRule.ID
+	rule.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, rule); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *MetricAlertRuleReconciler) handleError(ctx context.Context, rule *sentryv1alpha1.MetricAlertRule, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(rule, corev1.EventTypeNormal, "DryRun", de.Error())
+		rule.Status.Condition = sentryv1alpha1.MetricAlertRuleConditionPlanned
+		rule.Status.Message = de.Error()
+		return r.Status().Update(ctx, rule)
+	}
+
+	rule.Status.Condition = sentryv1alpha1.MetricAlertRuleConditionError
+	rule.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, rule); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// metricAlertRuleParams returns the parameters for creating or updating a Sentry metric alert rule from our spec. The
+// IDs of the existing Sentry metric alert rule's triggers and actions are carried over where their labels and positions
+// match, so that Sentry updates them in place instead of recreating them.
+func metricAlertRuleParams(spec sentryv1alpha1.MetricAlertRuleSpec, existing *sentry.MetricAlertRule) (*sentry.UpdateMetricAlertRuleParams, error) {
+	params := &sentry.UpdateMetricAlertRuleParams{
+		Aggregate:   spec.Aggregate,
+		Dataset:     spec.Dataset,
+		Environment: spec.Environment,
+		Name:        spec.Name,
+		Projects:    spec.Projects,
+		Query:       spec.Query,
+		TimeWindow:  spec.TimeWindow,
+		Triggers:    make([]sentry.MetricAlertRuleTriggerParams, len(spec.Triggers)),
+	}
+
+	if spec.ThresholdType == sentryv1alpha1.MetricAlertRuleThresholdTypeBelow {
+		params.ThresholdType = 1
+	}
+
+	if spec.ResolveThreshold != nil {
+		threshold, err := strconv.ParseFloat(*spec.ResolveThreshold, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resolve threshold: %w", err)
+		}
+		params.ResolveThreshold = &threshold
+	}
+
+	existingTriggers := make(map[string]sentry.MetricAlertRuleTrigger)
+	if existing != nil {
+		for _, sTrigger := range existing.Triggers {
+			existingTriggers[sTrigger.Label] = sTrigger
+		}
+	}
+
+	for idx, trigger := range spec.Triggers {
+		threshold, err := strconv.ParseFloat(trigger.AlertThreshold, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid alert threshold for %s trigger: %w", trigger.Label, err)
+		}
+
+		sTrigger, exists := existingTriggers[trigger.Label]
+		params.Triggers[idx] = sentry.MetricAlertRuleTriggerParams{
+			Actions:          make([]sentry.MetricAlertRuleActionParams, len(trigger.Actions)),
+			AlertThreshold:   threshold,
+			Label:            trigger.Label,
+			ResolveThreshold: params.ResolveThreshold,
+		}
+		if exists {
+			params.Triggers[idx].ID = sTrigger.ID
+		}
+
+		for actionIdx, action := range trigger.Actions {
+			params.Triggers[idx].Actions[actionIdx] = sentry.MetricAlertRuleActionParams{
+				IntegrationID:    action.IntegrationID,
+				TargetIdentifier: action.TargetIdentifier,
+				TargetType:       action.TargetType,
+				Type:             action.Type,
+			}
+			if exists && actionIdx < len(sTrigger.Actions) {
+				params.Triggers[idx].Actions[actionIdx].ID = sTrigger.Actions[actionIdx].ID
+			}
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,202 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("MetricAlertRuleReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		ruleName      = "test-metricalertrule"
+		ruleNamespace = "test-metricalertrule-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		rule      *sentryv1alpha1.MetricAlertRule
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.MetricAlertRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "MetricAlertRule",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ruleName,
+			Namespace: ruleNamespace,
+		},
+		Spec: sentryv1alpha1.MetricAlertRuleSpec{
+			Name:       "test-metricalertrule",
+			Projects:   []string{"test-project"},
+			Aggregate:  "count()",
+			TimeWindow: 60,
+			Triggers: []sentryv1alpha1.MetricAlertRuleTrigger{
+				{
+					Label:          "critical",
+					AlertThreshold: "100",
+					Actions: []sentryv1alpha1.MetricAlertRuleAction{
+						{
+							Type:             "email",
+							TargetType:       "team",
+							TargetIdentifier: "2",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: ruleName, Namespace: ruleNamespace}
+		rule = new(sentryv1alpha1.MetricAlertRule)
+	})
+
+	Context("when creating a MetricAlertRule", func() {
+		BeforeEach(func() {
+			created := testSentryMetricAlertRule("12345", request.Spec.Name, request.Spec.Projects...)
+			fakeSentryMetricAlertRules.CreateReturns(created, newSentryResponse(http.StatusCreated), nil)
+			fakeSentryMetricAlertRules.GetReturns(created, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMetricAlertRules.UpdateReturns(created, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the MetricAlertRule gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.MetricAlertRuleStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, rule)
+				if err != nil {
+					return nil, err
+				}
+				return &rule.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.MetricAlertRuleConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(rule.Finalizers).To(ContainElement(controllers.MetricAlertRuleFinalizerName))
+
+			By("invoked the Sentry client's .MetricAlertRules.Create method")
+			organizationSlug, params := fakeSentryMetricAlertRules.CreateArgsForCall(fakeSentryMetricAlertRules.CreateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(params).To(Equal(&sentry.CreateMetricAlertRuleParams{
+				Aggregate:  "count()",
+				Name:       "test-metricalertrule",
+				Projects:   []string{"test-project"},
+				TimeWindow: 60,
+				Triggers: []sentry.MetricAlertRuleTriggerParams{
+					{
+						Label:          "critical",
+						AlertThreshold: 100,
+						Actions: []sentry.MetricAlertRuleActionParams{
+							{
+								Type:             "email",
+								TargetType:       "team",
+								TargetIdentifier: "2",
+							},
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Context("when updating a MetricAlertRule", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, rule)).To(Succeed())
+
+			existing := testSentryMetricAlertRule("12345", rule.Spec.Name, rule.Spec.Projects...)
+			fakeSentryMetricAlertRules.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+			rule.Spec.TimeWindow = 30
+			rule.Spec.ThresholdType = sentryv1alpha1.MetricAlertRuleThresholdTypeBelow
+			rule.Spec.Triggers[0].AlertThreshold = "0.5"
+
+			updated := testSentryMetricAlertRule("12345", rule.Spec.Name, rule.Spec.Projects...)
+			fakeSentryMetricAlertRules.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the MetricAlertRule gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, rule)).To(Succeed())
+
+			By("invoked the Sentry client's .MetricAlertRules.Update method")
+			Eventually(func() (*sentry.UpdateMetricAlertRuleParams, error) {
+				if fakeSentryMetricAlertRules.UpdateCallCount() == 0 {
+					return nil, nil
+				}
+				_, _, params := fakeSentryMetricAlertRules.UpdateArgsForCall(fakeSentryMetricAlertRules.UpdateCallCount() - 1)
+				return params, nil
+			}, timeout, interval).Should(PointTo(MatchFields(IgnoreExtras, Fields{
+				"TimeWindow":    Equal(30),
+				"ThresholdType": Equal(1),
+				"Triggers": ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":             Equal("1"),
+					"Label":          Equal("critical"),
+					"AlertThreshold": Equal(0.5),
+				})),
+			})))
+
+			organizationSlug, ruleID, _ := fakeSentryMetricAlertRules.UpdateArgsForCall(fakeSentryMetricAlertRules.UpdateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(ruleID).To(Equal("12345"))
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.MetricAlertRuleStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, rule)
+				if err != nil {
+					return nil, err
+				}
+				return &rule.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.MetricAlertRuleConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+				})),
+			)
+		})
+	})
+
+	Context("when deleting a MetricAlertRule", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, rule)).To(Succeed())
+
+			existing := testSentryMetricAlertRule("12345", rule.Spec.Name, rule.Spec.Projects...)
+			fakeSentryMetricAlertRules.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMetricAlertRules.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the MetricAlertRule gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, rule)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, rule)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .MetricAlertRules.Delete method")
+			organizationSlug, ruleID := fakeSentryMetricAlertRules.DeleteArgsForCall(fakeSentryMetricAlertRules.DeleteCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(ruleID).To(Equal("12345"))
+		})
+	})
+})
@@ -0,0 +1,448 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	MonitorFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/monitor"
+)
+
+// MonitorReconciler reconciles a Monitor object
+type MonitorReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *MonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.Monitor{}).
+		Owns(&corev1.Secret{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=monitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=monitors/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+func (r *MonitorReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("monitor", req.NamespacedName)
+
+	var monitor sentryv1alpha1.Monitor
+	if err := r.Get(ctx, req.NamespacedName, &monitor); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch Monitor")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &monitor, MonitorFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+	}
+
+	hasFinalizer := containsFinalizer(monitor.GetFinalizers(), MonitorFinalizerName)
+
+	// Create our Sentry resource and secret if we have not been synced before, unless we've been asked to adopt an
+	// existing Sentry resource
+	adoptSlug, adopt := monitor.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if monitor.Status.LastSynced.IsZero() && !adopt {
+		sMonitor, err := r.handleCreate(ctx, sc, &monitor, hasFinalizer)
+		if err != nil {
+			log.Error(err, "failed to create Monitor")
+			return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+		}
+
+		if err := r.reconcileSecret(ctx, sc, &monitor, sMonitor); err != nil {
+			log.Error(err, "failed to create Secret for Monitor")
+			return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+		}
+
+		log.Info("successfully created Monitor")
+		return ctrl.Result{}, nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if monitor.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &monitor, MonitorFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt Monitor")
+			return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+		}
+
+		monitor.Status.Slug = adoptSlug
+		log.Info("adopting existing Sentry monitor", "slug", adoptSlug)
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, err := r.getExistingState(sc, monitor)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry monitor state")
+		return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !monitor.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &monitor, existing); err != nil {
+				log.Error(err, "failed to delete Monitor")
+				return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+			}
+		}
+
+		log.Info("successfully deleted Monitor")
+		return ctrl.Result{}, nil
+	}
+
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && monitor.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry monitor %s to be adopted could not be found", ErrOutOfSync, adoptSlug)
+		log.Error(err, "failed to adopt Monitor")
+		return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		sMonitor, err := r.handleCreate(ctx, sc, &monitor, hasFinalizer)
+		if err != nil {
+			log.Error(err, "failed to recreate Monitor")
+			return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+		}
+
+		log.Info("successfully recreated Monitor")
+
+		// Reconcile our secret as the recreated monitor might have been assigned a different slug
+		if err := r.reconcileSecret(ctx, sc, &monitor, sMonitor); err != nil {
+			log.Error(err, "failed to reconcile Secret for Monitor")
+			return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+		}
+
+		log.Info("successfully reconciled Secret for Monitor")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	sMonitor, err := r.handleUpdate(ctx, sc, &monitor, existing)
+	if err != nil {
+		log.Error(err, "failed to update Monitor")
+		return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+	}
+
+	log.Info("successfully updated Monitor")
+
+	// Reconcile our secret to ensure that its data matches the slug and project of our Sentry monitor
+	if err := r.reconcileSecret(ctx, sc, &monitor, sMonitor); err != nil {
+		log.Error(err, "failed to reconcile Secret for Monitor")
+		return ctrl.Result{}, r.handleError(ctx, &monitor, err)
+	}
+
+	log.Info("successfully reconciled Secret for Monitor")
+
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its slug, and returns an
+// ErrOutOfSync error if the resource cannot be found. The resource's constant ID is checked as well, so that a
+// different monitor that has since taken over our slug isn't mistaken for ours.
+func (r *MonitorReconciler) getExistingState(sc *Sentry, monitor sentryv1alpha1.Monitor) (*sentry.Monitor, error) {
+	sMonitor, resp, err := sc.Client.Monitors.Get(sc.Organization, monitor.Status.Slug)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+			return nil, err
+		}
+	}
+
+	// We won't know the ID of the Sentry monitor yet if we are adopting it
+	if monitor.Status.ID != "" && sMonitor.ID != monitor.Status.ID {
+		return nil, ErrOutOfSync
+	}
+
+	return sMonitor, nil
+}
+
+func (r *MonitorReconciler) handleCreate(ctx context.Context, sc *Sentry, monitor *sentryv1alpha1.Monitor, hasFinalizer bool) (*sentry.Monitor, error) {
+	sMonitor, resp, err := sc.Client.Monitors.Create(sc.Organization, &sentry.CreateMonitorParams{
+		Config:  monitorConfig(monitor.Spec),
+		Name:    monitor.Spec.Name,
+		Project: monitor.Spec.Project,
+		Slug:    monitor.Spec.Slug,
+		Type:    "cron_job",
+	})
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return nil, retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec, such as an
+			// invalid schedule or timezone
+			return nil, err
+		}
+	}
+
+	monitor.Status.Condition = sentryv1alpha1.MonitorConditionCreated
+	monitor.Status.Message = ""
+	monitor.Status.ID = sMonitor.ID
+	monitor.Status.Slug = sMonitor.Slug
+	monitor.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, monitor); err != nil {
+		return nil, retryableError{err}
+	}
+
+	if !hasFinalizer {
+		monitor.SetFinalizers(append(monitor.GetFinalizers(), MonitorFinalizerName))
+		if err := r.Update(ctx, monitor); err != nil {
+			return nil, retryableError{err}
+		}
+	}
+
+	return sMonitor, nil
+}
+
+// reconcileSecret provisions a Secret containing our Sentry monitor's slug and the DSN of its project, so that the
+// monitored job's Sentry SDK can send check-ins for it.
+func (r *MonitorReconciler) reconcileSecret(ctx context.Context, sc *Sentry, monitor *sentryv1alpha1.Monitor, sMonitor *sentry.Monitor) error {
+	dsn, err := r.projectDSN(sc, sMonitor.Project.Slug)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("sentry-monitor-%s", monitor.Name),
+			Namespace:   monitor.Namespace,
+			Labels:      make(map[string]string),
+			Annotations: make(map[string]string),
+		},
+		Data: make(map[string][]byte),
+	}
+
+	if err := ctrl.SetControllerReference(monitor, secret, r.Scheme); err != nil {
+		return err
+	}
+
+	if _, err := ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		for k, v := range monitor.Labels {
+			secret.Labels[k] = v
+		}
+
+		for k, v := range monitor.Annotations {
+			secret.Annotations[k] = v
+		}
+
+		secret.Data["SENTRY_MONITOR_SLUG"] = []byte(sMonitor.Slug)
+		secret.Data["SENTRY_DSN"] = []byte(dsn)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// projectDSN returns the public DSN of the first active client key of the given Sentry project.
+func (r *MonitorReconciler) projectDSN(sc *Sentry, projectSlug string) (string, error) {
+	listKeysOpts := &sentry.ListOptions{}
+	for {
+		keys, resp, err := sc.Client.Projects.ListKeys(sc.Organization, projectSlug, listKeysOpts)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return "", retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+				return "", retryableError{err}
+			default:
+				// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+				return "", err
+			}
+		}
+
+		for _, key := range keys {
+			if key.IsActive {
+				return key.DSN.Public, nil
+			}
+		}
+
+		if !resp.NextPage.Results {
+			break
+		}
+		listKeysOpts.Cursor = resp.NextPage.Cursor
+	}
+
+	// Retry as a key might still be created for the project, such as by a ProjectKey
+	return "", retryableError{fmt.Errorf("Sentry project %s has no active keys", projectSlug)}
+}
+
+func (r *MonitorReconciler) handleDelete(ctx context.Context, sc *Sentry, monitor *sentryv1alpha1.Monitor, existing *sentry.Monitor) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below
+	if existing != nil {
+		resp, err := sc.Client.Monitors.Delete(sc.Organization, existing.Slug)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	monitor.SetFinalizers(removeFinalizer(monitor.GetFinalizers(), MonitorFinalizerName))
+	if err := r.Update(ctx, monitor); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *MonitorReconciler) handleUpdate(ctx context.Context, sc *Sentry, monitor *sentryv1alpha1.Monitor, existing *sentry.Monitor) (*sentry.Monitor, error) {
+	sMonitor, resp, err := sc.Client.Monitors.Update(sc.Organization, existing.Slug, &sentry.UpdateMonitorParams{
+		Config:  monitorConfig(monitor.Spec),
+		Name:    monitor.Spec.Name,
+		Project: monitor.Spec.Project,
+		Slug:    monitor.Spec.Slug,
+		Type:    "cron_job",
+	})
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return nil, retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return nil, err
+		}
+	}
+
+	monitor.Status.Condition = sentryv1alpha1.MonitorConditionCreated
+	monitor.Status.Message = ""
+	monitor.Status.ID = sMonitor.ID
+	monitor.Status.Slug = sMonitor.Slug
+	monitor.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, monitor); err != nil {
+		return nil, retryableError{err}
+	}
+
+	return sMonitor, nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *MonitorReconciler) handleError(ctx context.Context, monitor *sentryv1alpha1.Monitor, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(monitor, corev1.EventTypeNormal, "DryRun", de.Error())
+		monitor.Status.Condition = sentryv1alpha1.MonitorConditionPlanned
+		monitor.Status.Message = de.Error()
+		return r.Status().Update(ctx, monitor)
+	}
+
+	monitor.Status.Condition = sentryv1alpha1.MonitorConditionError
+	monitor.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, monitor); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+func monitorConfig(spec sentryv1alpha1.MonitorSpec) sentry.MonitorConfig {
+	timezone := spec.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	return sentry.MonitorConfig{
+		CheckinMargin: spec.CheckinMargin,
+		MaxRuntime:    spec.MaxRuntime,
+		Schedule:      spec.Schedule,
+		ScheduleType:  "crontab",
+		Timezone:      timezone,
+	}
+}
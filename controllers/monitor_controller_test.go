@@ -0,0 +1,246 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("MonitorReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		monitorName      = "test-monitor"
+		monitorNamespace = "test-monitor-namespace"
+	)
+
+	var (
+		lookupKey       types.NamespacedName
+		secretLookupKey types.NamespacedName
+
+		monitor *sentryv1alpha1.Monitor
+		secret  *corev1.Secret
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.Monitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "Monitor",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      monitorName,
+			Namespace: monitorNamespace,
+		},
+		Spec: sentryv1alpha1.MonitorSpec{
+			Project:  "test-project",
+			Name:     "test-monitor",
+			Schedule: "0 2 * * *",
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: monitorName, Namespace: monitorNamespace}
+		secretLookupKey = types.NamespacedName{Name: fmt.Sprintf("sentry-monitor-%s", monitorName), Namespace: monitorNamespace}
+
+		monitor = new(sentryv1alpha1.Monitor)
+		secret = new(corev1.Secret)
+
+		key := testSentryProjectKey("12345", 0, "Default", "test-dsn")
+		key.IsActive = true
+		fakeSentryProjects.ListKeysReturns([]sentry.ProjectKey{*key}, newSentryResponse(http.StatusOK), nil)
+	})
+
+	Context("when creating a Monitor", func() {
+		var (
+			created *sentry.Monitor
+		)
+
+		BeforeEach(func() {
+			created = testSentryMonitor("d1b7e2a3", "test-monitor", request.Spec.Project, request.Spec.Schedule)
+			fakeSentryMonitors.CreateReturns(created, newSentryResponse(http.StatusCreated), nil)
+			fakeSentryMonitors.GetReturns(created, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMonitors.UpdateReturns(created, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the Monitor gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.MonitorStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, monitor)
+				if err != nil {
+					return nil, err
+				}
+				return &monitor.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.MonitorConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("d1b7e2a3"),
+					"Slug":      Equal("test-monitor"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(monitor.Finalizers).To(ContainElement(controllers.MonitorFinalizerName))
+
+			By("invoked the Sentry client's .Monitors.Create method")
+			organization, params := fakeSentryMonitors.CreateArgsForCall(fakeSentryMonitors.CreateCallCount() - 1)
+			Expect(organization).To(Equal("organization"))
+			Expect(params).To(Equal(&sentry.CreateMonitorParams{
+				Config: sentry.MonitorConfig{
+					Schedule:     "0 2 * * *",
+					ScheduleType: "crontab",
+					Timezone:     "UTC",
+				},
+				Name:    "test-monitor",
+				Project: "test-project",
+				Type:    "cron_job",
+			}))
+		})
+
+		It("the Secret gets created successfully", func() {
+			Eventually(func() (map[string][]byte, error) {
+				err := k8sClient.Get(ctx, secretLookupKey, secret)
+				if err != nil {
+					return nil, err
+				}
+				return secret.Data, nil
+			}, timeout, interval).Should(And(
+				HaveKeyWithValue("SENTRY_MONITOR_SLUG", []byte("test-monitor")),
+				HaveKeyWithValue("SENTRY_DSN", []byte("test-dsn")),
+			))
+
+			By("with the expected owner reference")
+			Expect(secret.ObjectMeta.OwnerReferences).To(
+				ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"APIVersion": Equal("sentry.kubernetes.jaceys.me/v1alpha1"),
+						"Kind":       Equal("Monitor"),
+						"Name":       Equal(request.GetName()),
+						"UID":        Equal(request.GetUID()),
+					}),
+				),
+			)
+		})
+	})
+
+	Context("when updating a Monitor", func() {
+		var (
+			existing *sentry.Monitor
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, monitor)).To(Succeed())
+
+			existing = testSentryMonitor("d1b7e2a3", "test-monitor", monitor.Spec.Project, monitor.Spec.Schedule)
+			fakeSentryMonitors.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+			monitor.Spec.Schedule = "0 3 * * *"
+			monitor.Spec.Timezone = "Europe/London"
+			monitor.Spec.MaxRuntime = sentry.Int(30)
+		})
+
+		Context("the Sentry client returns an error", func() {
+			BeforeEach(func() {
+				fakeSentryMonitors.UpdateReturns(nil, newSentryResponse(http.StatusBadRequest), errors.New("an error occurred"))
+			})
+
+			It("the Monitor gets updated unsuccessfully", func() {
+				Expect(k8sClient.Update(ctx, monitor)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.MonitorStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, monitor)
+					if err != nil {
+						return nil, err
+					}
+					return &monitor.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.MonitorConditionError),
+						"Message":   Equal("an error occurred"),
+						"ID":        Equal("d1b7e2a3"),
+					})),
+				)
+			})
+		})
+
+		It("the Monitor gets updated successfully", func() {
+			updated := testSentryMonitor("d1b7e2a3", "test-monitor", monitor.Spec.Project, monitor.Spec.Schedule)
+			fakeSentryMonitors.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+
+			Expect(k8sClient.Update(ctx, monitor)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.MonitorStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, monitor)
+				if err != nil {
+					return nil, err
+				}
+				return &monitor.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.MonitorConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("d1b7e2a3"),
+					"Slug":      Equal("test-monitor"),
+				})),
+			)
+
+			By("invoked the Sentry client's .Monitors.Update method")
+			organization, slug, params := fakeSentryMonitors.UpdateArgsForCall(fakeSentryMonitors.UpdateCallCount() - 1)
+			Expect(organization).To(Equal("organization"))
+			Expect(slug).To(Equal(existing.Slug))
+			Expect(params.Config).To(Equal(sentry.MonitorConfig{
+				MaxRuntime:   sentry.Int(30),
+				Schedule:     "0 3 * * *",
+				ScheduleType: "crontab",
+				Timezone:     "Europe/London",
+			}))
+		})
+	})
+
+	Context("when deleting a Monitor", func() {
+		var (
+			existing *sentry.Monitor
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, monitor)).To(Succeed())
+
+			existing = testSentryMonitor("d1b7e2a3", "test-monitor", monitor.Spec.Project, monitor.Spec.Schedule)
+			fakeSentryMonitors.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMonitors.DeleteReturns(newSentryResponse(http.StatusAccepted), nil)
+		})
+
+		It("the Monitor gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, monitor)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, monitor)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Monitors.Delete method")
+			organization, slug := fakeSentryMonitors.DeleteArgsForCall(fakeSentryMonitors.DeleteCallCount() - 1)
+			Expect(organization).To(Equal("organization"))
+			Expect(slug).To(Equal(existing.Slug))
+		})
+	})
+})
@@ -0,0 +1,450 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	OrganizationMemberFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/organizationmember"
+
+	// organizationMemberPendingInterval is how often we check whether a pending Sentry organization member has accepted
+	// their invitation, as this doesn't trigger any change to our Custom Resource.
+	organizationMemberPendingInterval = 10 * time.Minute
+)
+
+// OrganizationMemberReconciler reconciles a OrganizationMember object
+type OrganizationMemberReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *OrganizationMemberReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.OrganizationMember{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=organizationmembers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=organizationmembers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *OrganizationMemberReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("organizationmember", req.NamespacedName)
+
+	var member sentryv1alpha1.OrganizationMember
+	if err := r.Get(ctx, req.NamespacedName, &member); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch OrganizationMember")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &member, OrganizationMemberFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &member, err)
+	}
+
+	hasFinalizer := containsFinalizer(member.GetFinalizers(), OrganizationMemberFinalizerName)
+
+	// Invite our Sentry organization member if we have not been synced before, unless we've been asked to adopt an
+	// existing Sentry organization member
+	adoptID, adopt := member.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if member.Status.LastSynced.IsZero() && !adopt {
+		if err := r.handleCreate(ctx, sc, &member, hasFinalizer); err != nil {
+			log.Error(err, "failed to create OrganizationMember")
+			return ctrl.Result{}, r.handleError(ctx, &member, err)
+		}
+
+		log.Info("successfully created OrganizationMember")
+		return r.result(&member), nil
+	}
+
+	// Adopt the existing Sentry organization member if we have not been synced before, so that it gets reconciled
+	// against our spec below
+	if member.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &member, OrganizationMemberFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt OrganizationMember")
+			return ctrl.Result{}, r.handleError(ctx, &member, err)
+		}
+
+		member.Status.ID = adoptID
+		log.Info("adopting existing Sentry organization member", "id", adoptID)
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, err := r.getExistingState(sc, member)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry organization member state")
+		return ctrl.Result{}, r.handleError(ctx, &member, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !member.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &member, existing); err != nil {
+				log.Error(err, "failed to delete OrganizationMember")
+				return ctrl.Result{}, r.handleError(ctx, &member, err)
+			}
+		}
+
+		log.Info("successfully deleted OrganizationMember")
+		return ctrl.Result{}, nil
+	}
+
+	// Don't invite a new Sentry organization member in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && member.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry organization member %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt OrganizationMember")
+		return ctrl.Result{}, r.handleError(ctx, &member, err)
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to reinvite it
+	if errors.Is(err, ErrOutOfSync) {
+		if err := r.handleCreate(ctx, sc, &member, hasFinalizer); err != nil {
+			log.Error(err, "failed to recreate OrganizationMember")
+			return ctrl.Result{}, r.handleError(ctx, &member, err)
+		}
+
+		log.Info("successfully recreated OrganizationMember")
+		return r.result(&member), nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	if err := r.handleUpdate(ctx, sc, &member, existing); err != nil {
+		log.Error(err, "failed to update OrganizationMember")
+		return ctrl.Result{}, r.handleError(ctx, &member, err)
+	}
+
+	log.Info("successfully updated OrganizationMember")
+
+	return r.result(&member), nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
+// returns an ErrOutOfSync error if the resource cannot be found.
+func (r *OrganizationMemberReconciler) getExistingState(sc *Sentry, member sentryv1alpha1.OrganizationMember) (*sentry.Member, error) {
+	sMember, resp, err := sc.Client.Members.Get(sc.Organization, member.Status.ID)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+			return nil, err
+		}
+	}
+
+	return sMember, nil
+}
+
+// handleCreate invites our Sentry organization member. Sentry doesn't allow inviting an email that already belongs to a
+// member of the Sentry organization, in which case that member has to be adopted explicitly.
+func (r *OrganizationMemberReconciler) handleCreate(ctx context.Context, sc *Sentry, member *sentryv1alpha1.OrganizationMember, hasFinalizer bool) error {
+	existing, err := r.findMember(sc, member.Spec.Email)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		return fmt.Errorf("email %s already belongs to Sentry organization member %s, which can only be managed by setting the %s annotation", member.Spec.Email, existing.ID, sentryv1alpha1.AdoptAnnotation)
+	}
+
+	var teams []string
+	if member.Spec.Teams != nil {
+		teams = *member.Spec.Teams
+	}
+
+	sMember, resp, err := sc.Client.Members.Invite(sc.Organization, &sentry.InviteMemberParams{
+		Email: member.Spec.Email,
+		Role:  organizationRole(member.Spec.Role),
+		Teams: teams,
+	})
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return err
+		}
+	}
+
+	member.Status.Condition = memberCondition(sMember)
+	member.Status.Message = ""
+	member.Status.ID = sMember.ID
+	member.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, member); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		member.SetFinalizers(append(member.GetFinalizers(), OrganizationMemberFinalizerName))
+		if err := r.Update(ctx, member); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *OrganizationMemberReconciler) handleDelete(ctx context.Context, sc *Sentry, member *sentryv1alpha1.OrganizationMember, existing *sentry.Member) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below. Members are left in the
+	// Sentry organization if our deletion policy says so.
+	if existing != nil && memberDeletionPolicy(member) == sentryv1alpha1.OrganizationMemberDeletionPolicyDelete {
+		resp, err := sc.Client.Members.Delete(sc.Organization, existing.ID)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	member.SetFinalizers(removeFinalizer(member.GetFinalizers(), OrganizationMemberFinalizerName))
+	if err := r.Update(ctx, member); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *OrganizationMemberReconciler) handleUpdate(ctx context.Context, sc *Sentry, member *sentryv1alpha1.OrganizationMember, existing *sentry.Member) error {
+	// Error if our spec's email doesn't match reality as Sentry doesn't allow us to update a member's email
+	if !strings.EqualFold(member.Spec.Email, existing.Email) {
+		return fmt.Errorf("%w: OrganizationMember's email could not be updated", ErrOutOfSync)
+	}
+
+	if err := r.converge(sc, member, existing); err != nil {
+		return err
+	}
+
+	member.Status.Condition = memberCondition(existing)
+	member.Status.Message = ""
+	member.Status.ID = existing.ID
+	member.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, member); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// converge updates the role and teams of the existing Sentry organization member to match our spec. The role and teams
+// are left untouched if they are not set in our spec, so that they can be managed elsewhere.
+func (r *OrganizationMemberReconciler) converge(sc *Sentry, member *sentryv1alpha1.OrganizationMember, existing *sentry.Member) error {
+	if member.Spec.Role != "" && existing.Role != member.Spec.Role {
+		_, resp, err := sc.Client.Members.Update(sc.Organization, existing.ID, &sentry.UpdateMemberParams{
+			Role: member.Spec.Role,
+		})
+		if err != nil {
+			return memberError(resp, err)
+		}
+	}
+
+	if member.Spec.Teams == nil {
+		return nil
+	}
+
+	current := make(map[string]bool)
+	for _, teamSlug := range existing.Teams {
+		current[teamSlug] = true
+	}
+
+	desired := make(map[string]bool)
+	for _, teamSlug := range *member.Spec.Teams {
+		desired[teamSlug] = true
+		if current[teamSlug] {
+			continue
+		}
+
+		_, resp, err := sc.Client.Members.AddTeam(sc.Organization, existing.ID, teamSlug)
+		if err != nil {
+			return memberError(resp, err)
+		}
+	}
+
+	for _, teamSlug := range existing.Teams {
+		if desired[teamSlug] {
+			continue
+		}
+
+		resp, err := sc.Client.Members.RemoveTeam(sc.Organization, existing.ID, teamSlug)
+		if err != nil {
+			return memberError(resp, err)
+		}
+	}
+
+	return nil
+}
+
+// findMember returns the Sentry organization member with the given email, or nil if there isn't one.
+func (r *OrganizationMemberReconciler) findMember(sc *Sentry, email string) (*sentry.Member, error) {
+	opts := &sentry.ListOptions{}
+	var sMembers []sentry.Member
+	for {
+		members, resp, err := sc.Client.Members.List(sc.Organization, opts)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return nil, retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that there might be an issue with our organization
+				return nil, err
+			}
+		}
+
+		sMembers = append(sMembers, members...)
+		if !resp.NextPage.Results {
+			break
+		}
+		opts.Cursor = resp.NextPage.Cursor
+	}
+
+	for idx, sMember := range sMembers {
+		if strings.EqualFold(sMember.Email, email) {
+			return &sMembers[idx], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// result requeues our reconcile key while our Sentry organization member has yet to accept their invitation, so that
+// our status gets updated once they do.
+func (r *OrganizationMemberReconciler) result(member *sentryv1alpha1.OrganizationMember) ctrl.Result {
+	if member.Status.Condition == sentryv1alpha1.OrganizationMemberConditionPending {
+		return ctrl.Result{RequeueAfter: organizationMemberPendingInterval}
+	}
+
+	return ctrl.Result{}
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *OrganizationMemberReconciler) handleError(ctx context.Context, member *sentryv1alpha1.OrganizationMember, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(member, corev1.EventTypeNormal, "DryRun", de.Error())
+		member.Status.Condition = sentryv1alpha1.OrganizationMemberConditionPlanned
+		member.Status.Message = de.Error()
+		return r.Status().Update(ctx, member)
+	}
+
+	member.Status.Condition = sentryv1alpha1.OrganizationMemberConditionError
+	member.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, member); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// memberCondition returns the condition of our Custom Resource based on whether the Sentry organization member has
+// accepted their invitation.
+func memberCondition(sMember *sentry.Member) sentryv1alpha1.OrganizationMemberCondition {
+	if sMember.Pending {
+		return sentryv1alpha1.OrganizationMemberConditionPending
+	}
+
+	return sentryv1alpha1.OrganizationMemberConditionActive
+}
+
+// memberDeletionPolicy returns the deletion policy of our Custom Resource, which defaults to retaining adopted members
+// as they weren't invited by the operator.
+func memberDeletionPolicy(member *sentryv1alpha1.OrganizationMember) sentryv1alpha1.OrganizationMemberDeletionPolicy {
+	if member.Spec.DeletionPolicy != "" {
+		return member.Spec.DeletionPolicy
+	}
+
+	if _, adopt := member.Annotations[sentryv1alpha1.AdoptAnnotation]; adopt {
+		return sentryv1alpha1.OrganizationMemberDeletionPolicyRetain
+	}
+
+	return sentryv1alpha1.OrganizationMemberDeletionPolicyDelete
+}
+
+// organizationRole returns the role of a member within a Sentry organization, which defaults to member.
+func organizationRole(role string) string {
+	if role == "" {
+		return "member"
+	}
+
+	return role
+}
@@ -0,0 +1,272 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("OrganizationMemberReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		memberName      = "test-member"
+		memberNamespace = "test-member-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		member    *sentryv1alpha1.OrganizationMember
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.OrganizationMember{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "OrganizationMember",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      memberName,
+			Namespace: memberNamespace,
+		},
+		Spec: sentryv1alpha1.OrganizationMemberSpec{
+			Email: "jane@example.com",
+			Teams: &[]string{"test-team"},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: memberName, Namespace: memberNamespace}
+		member = new(sentryv1alpha1.OrganizationMember)
+	})
+
+	Context("when creating an OrganizationMember", func() {
+		BeforeEach(func() {
+			fakeSentryMembers.ListReturns([]sentry.Member{}, newSentryResponse(http.StatusOK), nil)
+
+			invited := testSentryMember("12345", request.Spec.Email, "member", true, *request.Spec.Teams...)
+			fakeSentryMembers.InviteReturns(invited, newSentryResponse(http.StatusCreated), nil)
+		})
+
+		It("the OrganizationMember gets invited successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.OrganizationMemberStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, member)
+				if err != nil {
+					return nil, err
+				}
+				return &member.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.OrganizationMemberConditionPending),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(member.Finalizers).To(ContainElement(controllers.OrganizationMemberFinalizerName))
+
+			By("invoked the Sentry client's .Members.Invite method")
+			organizationSlug, params := fakeSentryMembers.InviteArgsForCall(fakeSentryMembers.InviteCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(params).To(Equal(&sentry.InviteMemberParams{
+				Email: "jane@example.com",
+				Role:  "member",
+				Teams: []string{"test-team"},
+			}))
+		})
+	})
+
+	Context("when updating an OrganizationMember", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, member)).To(Succeed())
+
+			existing := testSentryMember("12345", member.Spec.Email, "member", false, "test-team")
+			fakeSentryMembers.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMembers.UpdateReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMembers.AddTeamReturns(testSentryTeam("1", "test-team-update"), newSentryResponse(http.StatusCreated), nil)
+			fakeSentryMembers.RemoveTeamReturns(newSentryResponse(http.StatusOK), nil)
+
+			member.Spec.Role = "admin"
+			member.Spec.Teams = &[]string{"test-team-update"}
+		})
+
+		It("the OrganizationMember gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, member)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.OrganizationMemberStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, member)
+				if err != nil {
+					return nil, err
+				}
+				return &member.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.OrganizationMemberConditionActive),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+				})),
+			)
+
+			By("invoked the Sentry client's .Members.Update method")
+			organizationSlug, memberID, params := fakeSentryMembers.UpdateArgsForCall(fakeSentryMembers.UpdateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(memberID).To(Equal("12345"))
+			Expect(params).To(Equal(&sentry.UpdateMemberParams{
+				Role: "admin",
+			}))
+
+			By("invoked the Sentry client's .Members.AddTeam method")
+			organizationSlug, memberID, teamSlug := fakeSentryMembers.AddTeamArgsForCall(fakeSentryMembers.AddTeamCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(memberID).To(Equal("12345"))
+			Expect(teamSlug).To(Equal("test-team-update"))
+
+			By("invoked the Sentry client's .Members.RemoveTeam method")
+			organizationSlug, memberID, teamSlug = fakeSentryMembers.RemoveTeamArgsForCall(fakeSentryMembers.RemoveTeamCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(memberID).To(Equal("12345"))
+			Expect(teamSlug).To(Equal("test-team"))
+		})
+	})
+
+	Context("when deleting an OrganizationMember", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, member)).To(Succeed())
+
+			existing := testSentryMember("12345", member.Spec.Email, "admin", false, "test-team-update")
+			fakeSentryMembers.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMembers.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the OrganizationMember gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, member)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, member)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Members.Delete method")
+			organizationSlug, memberID := fakeSentryMembers.DeleteArgsForCall(fakeSentryMembers.DeleteCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(memberID).To(Equal("12345"))
+		})
+	})
+
+	Context("when creating an OrganizationMember whose email already belongs to a Sentry organization member", func() {
+		var (
+			conflict  *sentryv1alpha1.OrganizationMember
+			invitedBy int
+		)
+
+		BeforeEach(func() {
+			conflict = request.DeepCopy()
+			conflict.Name = "test-member-conflict"
+			lookupKey = types.NamespacedName{Name: conflict.Name, Namespace: memberNamespace}
+
+			existing := testSentryMember("67890", conflict.Spec.Email, "owner", false, "test-team-existing")
+			fakeSentryMembers.ListReturns([]sentry.Member{*existing}, newSentryResponse(http.StatusOK), nil)
+
+			invitedBy = fakeSentryMembers.InviteCallCount()
+		})
+
+		It("the OrganizationMember does not take over the existing member", func() {
+			Expect(k8sClient.Create(ctx, conflict)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.OrganizationMemberStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, member)
+				if err != nil {
+					return nil, err
+				}
+				return &member.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.OrganizationMemberConditionError),
+					"Message":   ContainSubstring(sentryv1alpha1.AdoptAnnotation),
+					"ID":        BeEmpty(),
+				})),
+			)
+
+			By("did not invoke the Sentry client's .Members.Invite method")
+			Expect(fakeSentryMembers.InviteCallCount()).To(Equal(invitedBy))
+
+			Expect(k8sClient.Delete(ctx, conflict)).To(Succeed())
+		})
+	})
+
+	Context("when adopting an OrganizationMember", func() {
+		var (
+			adopt                                    *sentryv1alpha1.OrganizationMember
+			updatedBy, addedBy, removedBy, deletedBy int
+		)
+
+		BeforeEach(func() {
+			adopt = request.DeepCopy()
+			adopt.Name = "test-member-adopt"
+			adopt.Annotations = map[string]string{
+				sentryv1alpha1.AdoptAnnotation: "67890",
+			}
+			adopt.Spec.Teams = nil
+			lookupKey = types.NamespacedName{Name: adopt.Name, Namespace: memberNamespace}
+
+			existing := testSentryMember("67890", adopt.Spec.Email, "owner", false, "test-team-existing")
+			fakeSentryMembers.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMembers.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+
+			updatedBy = fakeSentryMembers.UpdateCallCount()
+			addedBy = fakeSentryMembers.AddTeamCallCount()
+			removedBy = fakeSentryMembers.RemoveTeamCallCount()
+			deletedBy = fakeSentryMembers.DeleteCallCount()
+		})
+
+		It("the OrganizationMember gets adopted and retained successfully", func() {
+			Expect(k8sClient.Create(ctx, adopt)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.OrganizationMemberStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, member)
+				if err != nil {
+					return nil, err
+				}
+				return &member.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.OrganizationMemberConditionActive),
+					"Message":   BeEmpty(),
+					"ID":        Equal("67890"),
+				})),
+			)
+
+			By("left the existing member's role and teams untouched")
+			Expect(fakeSentryMembers.UpdateCallCount()).To(Equal(updatedBy))
+			Expect(fakeSentryMembers.AddTeamCallCount()).To(Equal(addedBy))
+			Expect(fakeSentryMembers.RemoveTeamCallCount()).To(Equal(removedBy))
+
+			Expect(k8sClient.Delete(ctx, member)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, member)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("did not invoke the Sentry client's .Members.Delete method")
+			Expect(fakeSentryMembers.DeleteCallCount()).To(Equal(deletedBy))
+		})
+	})
+})
@@ -0,0 +1,231 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+// OrganizationSettingsReconciler reconciles an OrganizationSettings object
+type OrganizationSettingsReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *OrganizationSettingsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.OrganizationSettings{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=organizationsettings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=organizationsettings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *OrganizationSettingsReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("organizationsettings", req.NamespacedName)
+
+	var settings sentryv1alpha1.OrganizationSettings
+	if err := r.Get(ctx, req.NamespacedName, &settings); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch OrganizationSettings")
+		return ctrl.Result{}, err
+	}
+
+	// The settings are left as they are when we receive a delete request, as there is no Sentry resource to delete
+	if !settings.ObjectMeta.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// OrganizationSettings are cluster-scoped, so they always apply to the default Sentry organization. Only manage the
+	// settings named after it, so that multiple OrganizationSettings don't fight over the same organization.
+	sc := r.Sentry
+	if settings.Name != sc.Organization {
+		err := fmt.Errorf("OrganizationSettings must be named after the Sentry organization %s", sc.Organization)
+		log.Error(err, "failed to update OrganizationSettings")
+		return ctrl.Result{}, r.handleError(ctx, &settings, err)
+	}
+
+	// Reconcile any differences between our spec and the existing settings of our Sentry organization
+	if err := r.handleUpdate(ctx, sc, &settings); err != nil {
+		log.Error(err, "failed to update OrganizationSettings")
+		return ctrl.Result{}, r.handleError(ctx, &settings, err)
+	}
+
+	log.Info("successfully updated OrganizationSettings")
+
+	return ctrl.Result{}, nil
+}
+
+func (r *OrganizationSettingsReconciler) handleUpdate(ctx context.Context, sc *Sentry, settings *sentryv1alpha1.OrganizationSettings) error {
+	sOrganization, resp, err := sc.Client.Organizations.Get(sc.Organization)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		default:
+			// Don't retry on 4XX errors as these indicate that there might be an issue with our organization
+			return err
+		}
+	}
+
+	params, drifted := organizationParams(settings.Spec, sOrganization)
+	if drifted {
+		sOrganization, resp, err = sc.Client.Organizations.Update(sc.Organization, params)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	settings.Status.Condition = sentryv1alpha1.OrganizationSettingsConditionSynced
+	settings.Status.Message = ""
+	settings.Status.ID = sOrganization.ID
+	settings.Status.Slug = sOrganization.Slug
+	settings.Status.Name = sOrganization.Name
+	settings.Status.DefaultRole = sOrganization.DefaultRole
+	settings.Status.OpenMembership = sOrganization.OpenMembership
+	settings.Status.Require2FA = sOrganization.Require2FA
+	settings.Status.AllowSharedIssues = sOrganization.AllowSharedIssues
+	settings.Status.EnhancedPrivacy = sOrganization.EnhancedPrivacy
+	settings.Status.DataScrubber = sOrganization.DataScrubber
+	settings.Status.DataScrubberDefaults = sOrganization.DataScrubberDefaults
+	settings.Status.ScrubIPAddresses = sOrganization.ScrubIPAddresses
+	settings.Status.SensitiveFields = sOrganization.SensitiveFields
+	settings.Status.SafeFields = sOrganization.SafeFields
+	settings.Status.ScrapeJavaScript = sOrganization.ScrapeJavaScript
+	settings.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, settings); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *OrganizationSettingsReconciler) handleError(ctx context.Context, settings *sentryv1alpha1.OrganizationSettings, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(settings, corev1.EventTypeNormal, "DryRun", de.Error())
+		settings.Status.Condition = sentryv1alpha1.OrganizationSettingsConditionPlanned
+		settings.Status.Message = de.Error()
+		return r.Status().Update(ctx, settings)
+	}
+
+	settings.Status.Condition = sentryv1alpha1.OrganizationSettingsConditionError
+	settings.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, settings); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// organizationParams returns the parameters for updating the given Sentry organization to match our spec, and whether
+// any of them have drifted from the Sentry organization's current state.
+func organizationParams(spec sentryv1alpha1.OrganizationSettingsSpec, sOrganization *sentry.Organization) (*sentry.UpdateOrganizationParams, bool) {
+	params := &sentry.UpdateOrganizationParams{
+		AllowSharedIssues:    spec.AllowSharedIssues,
+		DataScrubber:         spec.DataScrubber,
+		DataScrubberDefaults: spec.DataScrubberDefaults,
+		DefaultRole:          spec.DefaultRole,
+		EnhancedPrivacy:      spec.EnhancedPrivacy,
+		OpenMembership:       spec.OpenMembership,
+		Require2FA:           spec.Require2FA,
+		SafeFields:           spec.SafeFields,
+		ScrapeJavaScript:     spec.ScrapeJavaScript,
+		ScrubIPAddresses:     spec.ScrubIPAddresses,
+		SensitiveFields:      spec.SensitiveFields,
+	}
+
+	drifted := spec.DefaultRole != "" && spec.DefaultRole != sOrganization.DefaultRole
+	for _, setting := range []struct {
+		desired *bool
+		actual  bool
+	}{
+		{spec.OpenMembership, sOrganization.OpenMembership},
+		{spec.Require2FA, sOrganization.Require2FA},
+		{spec.AllowSharedIssues, sOrganization.AllowSharedIssues},
+		{spec.EnhancedPrivacy, sOrganization.EnhancedPrivacy},
+		{spec.DataScrubber, sOrganization.DataScrubber},
+		{spec.DataScrubberDefaults, sOrganization.DataScrubberDefaults},
+		{spec.ScrubIPAddresses, sOrganization.ScrubIPAddresses},
+		{spec.ScrapeJavaScript, sOrganization.ScrapeJavaScript},
+	} {
+		if setting.desired != nil && *setting.desired != setting.actual {
+			drifted = true
+		}
+	}
+
+	// Sentry might return our sensitive and safe fields in a different order, so compare them as sets
+	if spec.SensitiveFields != nil && !stringSetsEqual(*spec.SensitiveFields, sOrganization.SensitiveFields) {
+		drifted = true
+	}
+
+	if spec.SafeFields != nil && !stringSetsEqual(*spec.SafeFields, sOrganization.SafeFields) {
+		drifted = true
+	}
+
+	return params, drifted
+}
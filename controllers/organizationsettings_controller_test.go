@@ -0,0 +1,129 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("OrganizationSettingsReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+	)
+
+	var (
+		settings *sentryv1alpha1.OrganizationSettings
+	)
+
+	ctx := context.Background()
+
+	newRequest := func(name string) *sentryv1alpha1.OrganizationSettings {
+		return &sentryv1alpha1.OrganizationSettings{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+				Kind:       "OrganizationSettings",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: sentryv1alpha1.OrganizationSettingsSpec{
+				DefaultRole:     "member",
+				OpenMembership:  sentry.Bool(false),
+				Require2FA:      sentry.Bool(true),
+				SensitiveFields: sentry.Strings("password"),
+				SafeFields:      sentry.Strings(),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		settings = new(sentryv1alpha1.OrganizationSettings)
+
+		existing := &sentry.Organization{
+			DefaultRole:     "member",
+			ID:              "2",
+			Name:            "Organization",
+			OpenMembership:  true,
+			SafeFields:      []string{"token"},
+			SensitiveFields: []string{},
+			Slug:            "organization",
+		}
+		fakeSentryOrganizations.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+		updated := &sentry.Organization{
+			DefaultRole:     "member",
+			ID:              "2",
+			Name:            "Organization",
+			OpenMembership:  false,
+			Require2FA:      true,
+			SensitiveFields: []string{"password"},
+			Slug:            "organization",
+		}
+		fakeSentryOrganizations.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+	})
+
+	Context("when creating OrganizationSettings for the Sentry organization", func() {
+		It("the Sentry organization's settings get updated successfully", func() {
+			Expect(k8sClient.Create(ctx, newRequest("organization"))).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.OrganizationSettingsStatus, error) {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "organization"}, settings)
+				if err != nil {
+					return nil, err
+				}
+				return &settings.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition":       Equal(sentryv1alpha1.OrganizationSettingsConditionSynced),
+					"Message":         BeEmpty(),
+					"ID":              Equal("2"),
+					"Slug":            Equal("organization"),
+					"OpenMembership":  BeFalse(),
+					"Require2FA":      BeTrue(),
+					"SensitiveFields": Equal([]string{"password"}),
+				})),
+			)
+
+			By("invoked the Sentry client's .Organizations.Update method")
+			organizationSlug, params := fakeSentryOrganizations.UpdateArgsForCall(fakeSentryOrganizations.UpdateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(params).To(Equal(&sentry.UpdateOrganizationParams{
+				DefaultRole:     "member",
+				OpenMembership:  sentry.Bool(false),
+				Require2FA:      sentry.Bool(true),
+				SensitiveFields: sentry.Strings("password"),
+				SafeFields:      sentry.Strings(),
+			}))
+		})
+	})
+
+	Context("when creating OrganizationSettings for a different organization", func() {
+		It("the OrganizationSettings get rejected", func() {
+			Expect(k8sClient.Create(ctx, newRequest("other-organization"))).To(Succeed())
+
+			Eventually(func() (*sentryv1alpha1.OrganizationSettingsStatus, error) {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "other-organization"}, settings)
+				if err != nil {
+					return nil, err
+				}
+				return &settings.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.OrganizationSettingsConditionError),
+					"Message":   ContainSubstring("must be named after the Sentry organization organization"),
+				})),
+			)
+		})
+	})
+})
@@ -31,12 +31,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -47,14 +50,19 @@ import (
 
 const (
 	ProjectFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/project"
+
+	// projectUnknownEnvironmentsInterval is how often we check whether the environments in our spec have been created in
+	// our Sentry project, as this doesn't trigger any change to our Custom Resource.
+	projectUnknownEnvironmentsInterval = 10 * time.Minute
 )
 
 // ProjectReconciler reconciles a Project object
 type ProjectReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
-	Sentry *Sentry
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
 }
 
 func (r *ProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -66,6 +74,7 @@ func (r *ProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 // +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projects/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 func (r *ProjectReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -81,22 +90,49 @@ func (r *ProjectReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, err
 	}
 
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &project, ProjectFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &project, err)
+	}
+
 	hasFinalizer := containsFinalizer(project.GetFinalizers(), ProjectFinalizerName)
 
-	// Create our Sentry resource if we have not been synced before
-	if project.Status.LastSynced.IsZero() {
-		if err := r.handleCreate(ctx, &project, hasFinalizer); err != nil {
+	// Create our Sentry resource if we have not been synced before, unless we've been asked to adopt an existing Sentry
+	// resource
+	adoptID, adopt := project.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if project.Status.LastSynced.IsZero() && !adopt {
+		if err := r.handleCreate(ctx, sc, &project, hasFinalizer); err != nil {
 			log.Error(err, "failed to create Project")
 			return ctrl.Result{}, r.handleError(ctx, &project, err)
 		}
 
 		log.Info("successfully created Project")
-		return ctrl.Result{}, nil
+		return r.result(&project), nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if project.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &project, ProjectFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt Project")
+			return ctrl.Result{}, r.handleError(ctx, &project, err)
+		}
+
+		project.Status.ID = adoptID
+		log.Info("adopting existing Sentry project", "id", adoptID)
 	}
 
 	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
 	// will handle this accordingly below.
-	existing, err := r.getExistingState(project)
+	existing, err := r.getExistingState(sc, project)
 	if err != nil && !errors.Is(err, ErrOutOfSync) {
 		log.Error(err, "failed to fetch Sentry project state")
 		return ctrl.Result{}, r.handleError(ctx, &project, err)
@@ -105,7 +141,7 @@ func (r *ProjectReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
 	if !project.ObjectMeta.DeletionTimestamp.IsZero() {
 		if hasFinalizer {
-			if err := r.handleDelete(ctx, &project, existing); err != nil {
+			if err := r.handleDelete(ctx, sc, &project, existing); err != nil {
 				log.Error(err, "failed to delete Project")
 				return ctrl.Result{}, r.handleError(ctx, &project, err)
 			}
@@ -115,37 +151,44 @@ func (r *ProjectReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	}
 
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && project.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry project %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt Project")
+		return ctrl.Result{}, r.handleError(ctx, &project, err)
+	}
+
 	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
 	if errors.Is(err, ErrOutOfSync) {
-		if err := r.handleCreate(ctx, &project, hasFinalizer); err != nil {
+		if err := r.handleCreate(ctx, sc, &project, hasFinalizer); err != nil {
 			log.Error(err, "failed to recreate Project")
 			return ctrl.Result{}, r.handleError(ctx, &project, err)
 		}
 
 		log.Info("successfully recreated Project")
-		return ctrl.Result{}, nil
+		return r.result(&project), nil
 	}
 
 	// Reconcile any differences between our spec and the existing state of our Sentry resource
-	if err := r.handleUpdate(ctx, &project, existing); err != nil {
+	if err := r.handleUpdate(ctx, sc, &project, existing); err != nil {
 		log.Error(err, "failed to update Project")
 		return ctrl.Result{}, r.handleError(ctx, &project, err)
 	}
 
 	log.Info("successfully updated Project")
 
-	return ctrl.Result{}, nil
+	return r.result(&project), nil
 }
 
 // getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
 // returns an ErrOutOfSync error if the resource cannot be found.
-func (r *ProjectReconciler) getExistingState(project sentryv1alpha1.Project) (*sentry.Project, error) {
+func (r *ProjectReconciler) getExistingState(sc *Sentry, project sentryv1alpha1.Project) (*sentry.Project, error) {
 	opts := &sentry.ListOptions{}
 	var sProjects []sentry.Project
 	for {
 		// List our organization's projects instead of team's as when a Sentry team gets deleted, the projects under it get
 		// orphaned under the organization.
-		projects, resp, err := r.Sentry.Client.Organizations.ListProjects(r.Sentry.Organization, opts)
+		projects, resp, err := sc.Client.Organizations.ListProjects(sc.Organization, opts)
 		if err != nil {
 			switch {
 			case resp.StatusCode >= 500:
@@ -172,8 +215,13 @@ func (r *ProjectReconciler) getExistingState(project sentryv1alpha1.Project) (*s
 	return nil, ErrOutOfSync
 }
 
-func (r *ProjectReconciler) handleCreate(ctx context.Context, project *sentryv1alpha1.Project, hasFinalizer bool) error {
-	sProject, resp, err := r.Sentry.Client.Teams.CreateProject(r.Sentry.Organization, project.Spec.Team, &sentry.CreateProjectParams{
+func (r *ProjectReconciler) handleCreate(ctx context.Context, sc *Sentry, project *sentryv1alpha1.Project, hasFinalizer bool) error {
+	teams := specTeams(project)
+	if len(teams) == 0 {
+		return ErrProjectTeamsEmpty
+	}
+
+	sProject, resp, err := sc.Client.Teams.CreateProject(sc.Organization, teams[0], &sentry.CreateProjectParams{
 		Name: project.Spec.Name,
 		Slug: project.Spec.Slug,
 	})
@@ -194,6 +242,11 @@ func (r *ProjectReconciler) handleCreate(ctx context.Context, project *sentryv1a
 	project.Status.Message = ""
 	project.Status.ID = sProject.ID
 	project.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	// Our new Sentry project won't have any environments until it receives its first events
+	project.Status.UnknownEnvironments = nil
+	for _, environment := range project.Spec.Environments {
+		project.Status.UnknownEnvironments = append(project.Status.UnknownEnvironments, environment.Name)
+	}
 	if err := r.Status().Update(ctx, project); err != nil {
 		return retryableError{err}
 	}
@@ -205,13 +258,42 @@ func (r *ProjectReconciler) handleCreate(ctx context.Context, project *sentryv1a
 		}
 	}
 
+	// Grant our remaining teams access and apply our settings only once our Sentry project has been recorded in our
+	// status, so that it doesn't get recreated if this fails
+	if err := r.handleTeams(sc, project, sProject.Slug, []string{teams[0]}); err != nil {
+		return err
+	}
+
+	sProject, err = r.handleSettings(sc, project, sProject)
+	if err != nil {
+		return err
+	}
+
+	if err := r.handleInboundFilters(sc, project, sProject); err != nil {
+		return err
+	}
+
+	if err := r.handleSpikeProtection(sc, project, sProject); err != nil {
+		return err
+	}
+
+	if err := r.handleQuotas(sc, project, sProject.Slug); err != nil {
+		return err
+	}
+
+	if project.Status.SpikeProtection != nil || project.Status.Quotas != nil {
+		if err := r.Status().Update(ctx, project); err != nil {
+			return retryableError{err}
+		}
+	}
+
 	return nil
 }
 
-func (r *ProjectReconciler) handleDelete(ctx context.Context, project *sentryv1alpha1.Project, existing *sentry.Project) error {
+func (r *ProjectReconciler) handleDelete(ctx context.Context, sc *Sentry, project *sentryv1alpha1.Project, existing *sentry.Project) error {
 	// Our resource might no longer exist so check that it's not nil to avoid panicking below
 	if existing != nil {
-		resp, err := r.Sentry.Client.Projects.Delete(r.Sentry.Organization, existing.Slug)
+		resp, err := sc.Client.Projects.Delete(sc.Organization, existing.Slug)
 		if err != nil {
 			switch {
 			case resp.StatusCode >= 500:
@@ -233,20 +315,14 @@ func (r *ProjectReconciler) handleDelete(ctx context.Context, project *sentryv1a
 	return nil
 }
 
-func (r *ProjectReconciler) handleUpdate(ctx context.Context, project *sentryv1alpha1.Project, existing *sentry.Project) error {
-	// Error if our spec's team doesn't match reality as the Sentry API doesn't allow us to update a project's team.
-	// This helps highlight configuration drift where a user forgets to update our spec's team after modifying the
-	// associated team's slug.
-	// Workaround to move project under a new team: manually modify the project's team via the Sentry UI, and update our
-	// spec accordingly to reflect the change.
-	if project.Spec.Team != existing.Team.Slug {
-		return fmt.Errorf("%w: Project's team could not be updated", ErrOutOfSync)
+func (r *ProjectReconciler) handleUpdate(ctx context.Context, sc *Sentry, project *sentryv1alpha1.Project, existing *sentry.Project) error {
+	// Refuse to reconcile our Sentry project if it would be left without any teams
+	if len(specTeams(project)) == 0 {
+		return ErrProjectTeamsEmpty
 	}
 
-	sProject, resp, err := r.Sentry.Client.Projects.Update(r.Sentry.Organization, existing.Slug, &sentry.UpdateProjectParams{
-		Name: project.Spec.Name,
-		Slug: project.Spec.Slug,
-	})
+	// Fetch the details of our Sentry project, as its settings are not included when listing our organization's projects
+	detail, resp, err := sc.Client.Projects.Get(sc.Organization, existing.Slug)
 	if err != nil {
 		switch {
 		case resp.StatusCode >= 500:
@@ -255,11 +331,36 @@ func (r *ProjectReconciler) handleUpdate(ctx context.Context, project *sentryv1a
 			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
 			return retryableError{err}
 		default:
-			// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
 			return err
 		}
 	}
 
+	sProject, err := r.handleSettings(sc, project, detail)
+	if err != nil {
+		return err
+	}
+
+	if err := r.handleInboundFilters(sc, project, sProject); err != nil {
+		return err
+	}
+
+	if err := r.handleSpikeProtection(sc, project, sProject); err != nil {
+		return err
+	}
+
+	if err := r.handleQuotas(sc, project, sProject.Slug); err != nil {
+		return err
+	}
+
+	if err := r.handleTeams(sc, project, sProject.Slug, projectTeams(existing)); err != nil {
+		return err
+	}
+
+	if err := r.handleEnvironments(sc, project, sProject.Slug); err != nil {
+		return err
+	}
+
 	project.Status.Condition = sentryv1alpha1.ProjectConditionCreated
 	project.Status.Message = ""
 	project.Status.ID = sProject.ID
@@ -271,9 +372,457 @@ func (r *ProjectReconciler) handleUpdate(ctx context.Context, project *sentryv1a
 	return nil
 }
 
+// handleSettings updates our Sentry project if its name, slug or any of the settings in our spec have drifted from the
+// given state, returning the resulting Sentry project. Settings that are not set in our spec are left untouched.
+func (r *ProjectReconciler) handleSettings(sc *Sentry, project *sentryv1alpha1.Project, sProject *sentry.Project) (*sentry.Project, error) {
+	params, drifted := projectParams(project.Spec, sProject)
+	if !drifted {
+		return sProject, nil
+	}
+
+	updated, resp, err := sc.Client.Projects.Update(sc.Organization, sProject.Slug, params)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return nil, retryableError{err}
+		default:
+			// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// projectParams returns the parameters for updating the given Sentry project to match our spec, and whether any of
+// them have drifted from the Sentry project's current state.
+func projectParams(spec sentryv1alpha1.ProjectSpec, sProject *sentry.Project) (*sentry.UpdateProjectParams, bool) {
+	params := &sentry.UpdateProjectParams{
+		Name:                 spec.Name,
+		Slug:                 spec.Slug,
+		Platform:             spec.Platform,
+		SubjectPrefix:        spec.SubjectPrefix,
+		SubjectTemplate:      spec.SubjectTemplate,
+		ResolveAge:           spec.ResolveAge,
+		DefaultEnvironment:   spec.DefaultEnvironment,
+		AllowedDomains:       spec.AllowedDomains,
+		ScrubIPAddresses:     spec.ScrubIPAddresses,
+		DataScrubber:         spec.DataScrubber,
+		DataScrubberDefaults: spec.DataScrubberDefaults,
+		SensitiveFields:      spec.SensitiveFields,
+	}
+
+	drifted := spec.Name != sProject.Name || spec.Slug != sProject.Slug
+	if spec.Platform != "" && spec.Platform != sProject.Platform {
+		drifted = true
+	}
+
+	if spec.DigestsMinDelay != nil {
+		params.DigestsMinDelay = *spec.DigestsMinDelay
+		drifted = drifted || *spec.DigestsMinDelay != sProject.DigestsMinDelay
+	}
+
+	if spec.DigestsMaxDelay != nil {
+		params.DigestsMaxDelay = *spec.DigestsMaxDelay
+		drifted = drifted || *spec.DigestsMaxDelay != sProject.DigestsMaxDelay
+	}
+
+	if spec.SubjectPrefix != nil && *spec.SubjectPrefix != sProject.SubjectPrefix {
+		drifted = true
+	}
+
+	if spec.SubjectTemplate != nil && *spec.SubjectTemplate != sProject.SubjectTemplate {
+		drifted = true
+	}
+
+	if spec.ResolveAge != nil && *spec.ResolveAge != sProject.ResolveAge {
+		drifted = true
+	}
+
+	if spec.DefaultEnvironment != nil && *spec.DefaultEnvironment != sProject.DefaultEnvironment {
+		drifted = true
+	}
+
+	// Sentry might return our allowed domains and sensitive fields in a different order, so compare them as sets
+	if spec.AllowedDomains != nil && !stringSetsEqual(*spec.AllowedDomains, sProject.AllowedDomains) {
+		drifted = true
+	}
+
+	if spec.ScrubIPAddresses != nil && *spec.ScrubIPAddresses != sProject.ScrubIPAddresses {
+		drifted = true
+	}
+
+	if spec.DataScrubber != nil && *spec.DataScrubber != sProject.DataScrubber {
+		drifted = true
+	}
+
+	if spec.DataScrubberDefaults != nil && *spec.DataScrubberDefaults != sProject.DataScrubberDefaults {
+		drifted = true
+	}
+
+	if spec.SensitiveFields != nil && !stringSetsEqual(*spec.SensitiveFields, sProject.SensitiveFields) {
+		drifted = true
+	}
+
+	return params, drifted
+}
+
+// handleInboundFilters converges the inbound data filters of our Sentry project with our spec, given the Sentry
+// project's current state. The filters are left untouched if our spec doesn't manage them.
+func (r *ProjectReconciler) handleInboundFilters(sc *Sentry, project *sentryv1alpha1.Project, sProject *sentry.Project) error {
+	filters := project.Spec.InboundFilters
+	if filters == nil {
+		return nil
+	}
+
+	sFilters, resp, err := sc.Client.Projects.ListFilters(sc.Organization, sProject.Slug)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+			return err
+		}
+	}
+
+	current := make(map[string]sentry.ProjectFilter)
+	for _, sFilter := range sFilters {
+		current[sFilter.ID] = sFilter
+	}
+
+	legacyBrowsers := make([]string, len(filters.LegacyBrowsers))
+	for idx, browser := range filters.LegacyBrowsers {
+		legacyBrowsers[idx] = string(browser)
+	}
+
+	desired := []struct {
+		id     string
+		params *sentry.UpdateProjectFilterParams
+	}{
+		{"browser-extensions", &sentry.UpdateProjectFilterParams{Active: sentry.Bool(filters.BrowserExtensions)}},
+		{"localhost", &sentry.UpdateProjectFilterParams{Active: sentry.Bool(filters.Localhost)}},
+		{"web-crawlers", &sentry.UpdateProjectFilterParams{Active: sentry.Bool(filters.WebCrawlers)}},
+		{"legacy-browsers", &sentry.UpdateProjectFilterParams{Active: sentry.Bool(len(legacyBrowsers) > 0), Subfilters: legacyBrowsers}},
+	}
+
+	for _, filter := range desired {
+		sFilter := current[filter.id]
+		if sFilter.Active == *filter.params.Active && stringSetsEqual(sFilter.Subfilters, filter.params.Subfilters) {
+			continue
+		}
+
+		resp, err := sc.Client.Projects.UpdateFilter(sc.Organization, sProject.Slug, filter.id, filter.params)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	// Filtered releases and error messages are stored as newline-separated options of our Sentry project
+	releases := strings.Join(filters.FilteredReleases, "\n")
+	errorMessages := strings.Join(filters.FilteredErrorMessages, "\n")
+	if sProject.Options.FilterReleases == releases && sProject.Options.FilterErrorMessages == errorMessages {
+		return nil
+	}
+
+	_, resp, err = sc.Client.Projects.Update(sc.Organization, sProject.Slug, &sentry.UpdateProjectParams{
+		Options: &sentry.UpdateProjectOptionsParams{
+			FilterErrorMessages: &errorMessages,
+			FilterReleases:      &releases,
+		},
+	})
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleSpikeProtection converges the spike protection of our Sentry project with our spec, given the Sentry project's
+// current settings, and records the spike protection in effect in our status.
+func (r *ProjectReconciler) handleSpikeProtection(sc *Sentry, project *sentryv1alpha1.Project, sProject *sentry.Project) error {
+	// Sentry only reports spike protection as an option of our Sentry project if our organization supports it
+	var enabled *bool
+	if sProject.Options.SpikeProtectionDisabled != nil {
+		enabled = sentry.Bool(!*sProject.Options.SpikeProtectionDisabled)
+	}
+
+	desired := project.Spec.SpikeProtection
+	if desired != nil && (enabled == nil || *enabled != *desired) {
+		params := &sentry.SpikeProtectionParams{Projects: []string{sProject.Slug}}
+
+		toggle := sc.Client.Organizations.DisableSpikeProtection
+		if *desired {
+			toggle = sc.Client.Organizations.EnableSpikeProtection
+		}
+
+		resp, err := toggle(sc.Organization, params)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Don't retry on 404 errors as these indicate that our organization doesn't support spike protection
+				return fmt.Errorf("spike protection is not available for organization %s: %w", sc.Organization, err)
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+
+		enabled = sentry.Bool(*desired)
+	}
+
+	project.Status.SpikeProtection = enabled
+	return nil
+}
+
+// handleQuotas converges the per-category quotas of our Sentry project with our spec, and records the quotas in effect
+// in our status. Categories that are not set in our spec are left untouched.
+func (r *ProjectReconciler) handleQuotas(sc *Sentry, project *sentryv1alpha1.Project, projectSlug string) error {
+	sQuotas, resp, err := sc.Client.Projects.ListQuotas(sc.Organization, projectSlug)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Sentry doesn't serve per-category quotas if our organization doesn't support them, as is the case for
+			// self-hosted Sentry
+			if project.Spec.Quotas != nil {
+				// Don't retry as this won't get resolved without a change to our organization
+				return fmt.Errorf("quotas are not available for organization %s: %w", sc.Organization, err)
+			}
+
+			project.Status.Quotas = nil
+			return nil
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+			return err
+		}
+	}
+
+	current := make(map[string]sentry.ProjectQuota)
+	for _, quota := range sQuotas {
+		current[quota.Category] = quota
+	}
+
+	var desired, effective sentryv1alpha1.ProjectQuotas
+	if project.Spec.Quotas != nil {
+		desired = *project.Spec.Quotas
+	}
+
+	categories := []struct {
+		name      string
+		desired   *sentryv1alpha1.ProjectQuota
+		effective **sentryv1alpha1.ProjectQuota
+	}{
+		{name: "error", desired: desired.Errors, effective: &effective.Errors},
+		{name: "transaction", desired: desired.Transactions, effective: &effective.Transactions},
+		{name: "attachment", desired: desired.Attachments, effective: &effective.Attachments},
+	}
+
+	for _, category := range categories {
+		quota := current[category.name]
+
+		if category.desired != nil && (quota.Limit == nil || *quota.Limit != category.desired.Limit || quota.Window != category.desired.Window) {
+			params := &sentry.UpdateProjectQuotaParams{
+				Limit:  sentry.Int(category.desired.Limit),
+				Window: category.desired.Window,
+			}
+
+			updated, resp, err := sc.Client.Projects.UpdateQuota(sc.Organization, projectSlug, category.name, params)
+			if err != nil {
+				switch {
+				case resp.StatusCode >= 500:
+					return retryableError{err}
+				default:
+					// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+					return err
+				}
+			}
+
+			quota = *updated
+		}
+
+		if quota.Limit != nil {
+			*category.effective = &sentryv1alpha1.ProjectQuota{Limit: *quota.Limit, Window: quota.Window}
+		}
+	}
+
+	project.Status.Quotas = &effective
+	return nil
+}
+
+// handleEnvironments sets the visibility of the environments in our spec on our Sentry project. Environments in our spec
+// that don't exist in our Sentry project yet are recorded in our status.
+func (r *ProjectReconciler) handleEnvironments(sc *Sentry, project *sentryv1alpha1.Project, projectSlug string) error {
+	project.Status.UnknownEnvironments = nil
+
+	for _, environment := range project.Spec.Environments {
+		sEnvironment, resp, err := sc.Client.Projects.GetEnvironment(sc.Organization, projectSlug, environment.Name)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				project.Status.UnknownEnvironments = append(project.Status.UnknownEnvironments, environment.Name)
+				continue
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+
+		if sEnvironment.IsHidden == environment.Hidden {
+			continue
+		}
+
+		_, resp, err = sc.Client.Projects.UpdateEnvironment(sc.Organization, projectSlug, environment.Name, &sentry.UpdateProjectEnvironmentParams{
+			IsHidden: sentry.Bool(environment.Hidden),
+		})
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+				return retryableError{err}
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// handleTeams converges the Sentry teams with access to our Sentry project with the teams in our spec, given the teams
+// that currently have access to it. Teams are never removed if our spec doesn't have any teams.
+func (r *ProjectReconciler) handleTeams(sc *Sentry, project *sentryv1alpha1.Project, projectSlug string, current []string) error {
+	teams := specTeams(project)
+	if len(teams) == 0 {
+		return ErrProjectTeamsEmpty
+	}
+
+	hasAccess := make(map[string]bool)
+	for _, teamSlug := range current {
+		hasAccess[teamSlug] = true
+	}
+
+	desired := make(map[string]bool)
+	for _, teamSlug := range teams {
+		desired[teamSlug] = true
+		if hasAccess[teamSlug] {
+			continue
+		}
+
+		_, resp, err := sc.Client.Projects.AddTeam(sc.Organization, projectSlug, teamSlug)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+				return retryableError{err}
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	for _, teamSlug := range current {
+		if desired[teamSlug] {
+			continue
+		}
+
+		_, resp, err := sc.Client.Projects.RemoveTeam(sc.Organization, projectSlug, teamSlug)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as the team might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// specTeams returns the slugs of the Sentry teams that should have access to our Sentry project, merging the deprecated
+// team field of our spec into its teams.
+func specTeams(project *sentryv1alpha1.Project) []string {
+	if project.Spec.Team == "" || containsString(project.Spec.Teams, project.Spec.Team) {
+		return project.Spec.Teams
+	}
+
+	return append([]string{project.Spec.Team}, project.Spec.Teams...)
+}
+
+// projectTeams returns the slugs of the Sentry teams that have access to the given Sentry project.
+func projectTeams(sProject *sentry.Project) []string {
+	// Fall back to the project's deprecated team field for older versions of Sentry
+	if len(sProject.Teams) == 0 && sProject.Team.Slug != "" {
+		return []string{sProject.Team.Slug}
+	}
+
+	teams := make([]string, len(sProject.Teams))
+	for idx, sTeam := range sProject.Teams {
+		teams[idx] = sTeam.Slug
+	}
+
+	return teams
+}
+
+// result requeues our reconcile key periodically while any environments in our spec don't exist in our Sentry project
+// yet, so that their visibility gets set once Sentry creates them.
+func (r *ProjectReconciler) result(project *sentryv1alpha1.Project) ctrl.Result {
+	if len(project.Status.UnknownEnvironments) > 0 {
+		return ctrl.Result{RequeueAfter: projectUnknownEnvironmentsInterval}
+	}
+
+	return ctrl.Result{}
+}
+
 // handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
-// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key.
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
 func (r *ProjectReconciler) handleError(ctx context.Context, project *sentryv1alpha1.Project, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(project, corev1.EventTypeNormal, "DryRun", de.Error())
+		project.Status.Condition = sentryv1alpha1.ProjectConditionPlanned
+		project.Status.Message = de.Error()
+		return r.Status().Update(ctx, project)
+	}
+
 	project.Status.Condition = sentryv1alpha1.ProjectConditionError
 	project.Status.Message = err.Error()
 	if err := r.Status().Update(ctx, project); err != nil {
@@ -43,9 +43,9 @@ var _ = Describe("ProjectReconciler", func() {
 			Namespace: projectNamespace,
 		},
 		Spec: sentryv1alpha1.ProjectSpec{
-			Team: "test-team",
-			Name: "test-project",
-			Slug: "test-project",
+			Teams: []string{"test-team"},
+			Name:  "test-project",
+			Slug:  "test-project",
 		},
 	}
 
@@ -56,7 +56,7 @@ var _ = Describe("ProjectReconciler", func() {
 
 	Context("when creating a Project", func() {
 		BeforeEach(func() {
-			created := testSentryProject("12345", request.Spec.Team, request.Spec.Name)
+			created := testSentryProject("12345", request.Spec.Teams[0], request.Spec.Name)
 			fakeSentryTeams.CreateProjectReturns(created, newSentryResponse(http.StatusOK), nil)
 		})
 
@@ -80,9 +80,9 @@ var _ = Describe("ProjectReconciler", func() {
 
 			By("with the desired spec")
 			Expect(project.Spec).To(Equal(sentryv1alpha1.ProjectSpec{
-				Team: "test-team",
-				Name: "test-project",
-				Slug: "test-project",
+				Teams: []string{"test-team"},
+				Name:  "test-project",
+				Slug:  "test-project",
 			}))
 
 			By("with the expected finalizer")
@@ -91,7 +91,7 @@ var _ = Describe("ProjectReconciler", func() {
 			By("invoked the Sentry client's .Teams.CreateProject method")
 			organizationSlug, teamSlug, params := fakeSentryTeams.CreateProjectArgsForCall(fakeSentryTeams.CreateProjectCallCount() - 1)
 			Expect(organizationSlug).To(Equal("organization"))
-			Expect(teamSlug).To(Equal(request.Spec.Team))
+			Expect(teamSlug).To(Equal(request.Spec.Teams[0]))
 			Expect(params).To(Equal(&sentry.CreateProjectParams{
 				Name: request.Spec.Name,
 				Slug: request.Spec.Slug,
@@ -107,13 +107,14 @@ var _ = Describe("ProjectReconciler", func() {
 		BeforeEach(func() {
 			Expect(k8sClient.Get(ctx, lookupKey, project)).To(Succeed())
 
-			existing = testSentryProject("12345", project.Spec.Team, project.Spec.Name)
+			existing = testSentryProject("12345", project.Spec.Teams[0], project.Spec.Name)
 			fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
 
 			project.Spec.Name = "test-project-update"
 			project.Spec.Slug = "test-project-update"
 
-			updated := testSentryProject("12345", project.Spec.Team, project.Spec.Name)
+			updated := testSentryProject("12345", project.Spec.Teams[0], project.Spec.Name)
 			fakeSentryProjects.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
 		})
 
@@ -137,9 +138,9 @@ var _ = Describe("ProjectReconciler", func() {
 
 			By("with the desired spec")
 			Expect(project.Spec).To(Equal(sentryv1alpha1.ProjectSpec{
-				Team: "test-team",
-				Name: "test-project-update",
-				Slug: "test-project-update",
+				Teams: []string{"test-team"},
+				Name:  "test-project-update",
+				Slug:  "test-project-update",
 			}))
 
 			By("with the expected finalizer")
@@ -150,6 +151,11 @@ var _ = Describe("ProjectReconciler", func() {
 			Expect(organizationSlug).To(Equal("organization"))
 			Expect(opts.Cursor).To(BeEmpty())
 
+			By("invoked the Sentry client's .Projects.Get method")
+			organizationSlug, projectSlug := fakeSentryProjects.GetArgsForCall(fakeSentryProjects.GetCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal(existing.Slug))
+
 			By("invoked the Sentry client's .Projects.Update method")
 			organizationSlug, projectSlug, params := fakeSentryProjects.UpdateArgsForCall(fakeSentryProjects.UpdateCallCount() - 1)
 			Expect(organizationSlug).To(Equal("organization"))
@@ -187,9 +193,9 @@ var _ = Describe("ProjectReconciler", func() {
 
 				By("with the desired spec")
 				Expect(project.Spec).To(Equal(sentryv1alpha1.ProjectSpec{
-					Team: "test-team",
-					Name: "test-project-error",
-					Slug: "test-project-error",
+					Teams: []string{"test-team"},
+					Name:  "test-project-error",
+					Slug:  "test-project-error",
 				}))
 
 				By("with the expected finalizer")
@@ -211,13 +217,18 @@ var _ = Describe("ProjectReconciler", func() {
 			})
 		})
 
-		Context("the associated Sentry team has changed", func() {
+		Context("the Sentry teams with access have changed", func() {
 			BeforeEach(func() {
 				existing = testSentryProject("12345", "new-team", project.Spec.Name)
 				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.AddTeamReturns(existing, newSentryResponse(http.StatusCreated), nil)
+				fakeSentryProjects.RemoveTeamReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+				project.Spec.Teams = []string{"test-team", "test-team-shared"}
 			})
 
-			It("the Project gets updated unsuccessfully", func() {
+			It("the Project's teams get updated successfully", func() {
 				Expect(k8sClient.Update(ctx, project)).To(Succeed())
 
 				By("with the expected status")
@@ -229,26 +240,376 @@ var _ = Describe("ProjectReconciler", func() {
 					return &project.Status, nil
 				}, timeout, interval).Should(
 					PointTo(MatchFields(IgnoreExtras, Fields{
-						"Condition": Equal(sentryv1alpha1.ProjectConditionError),
-						"Message":   ContainSubstring("out of sync"),
+						"Condition": Equal(sentryv1alpha1.ProjectConditionCreated),
+						"Message":   BeEmpty(),
 						"ID":        Equal("12345"),
 					})),
 				)
 
 				By("with the desired spec")
 				Expect(project.Spec).To(Equal(sentryv1alpha1.ProjectSpec{
-					Team: "test-team",
-					Name: "test-project-update",
-					Slug: "test-project-update",
+					Teams: []string{"test-team", "test-team-shared"},
+					Name:  "test-project-update",
+					Slug:  "test-project-update",
 				}))
 
-				By("with the expected finalizer")
-				Expect(project.Finalizers).To(ContainElement(controllers.ProjectFinalizerName))
+				By("invoked the Sentry client's .Projects.AddTeam method")
+				Expect(fakeSentryProjects.AddTeamCallCount()).To(BeNumerically(">=", 2))
+				organizationSlug, projectSlug, teamSlug := fakeSentryProjects.AddTeamArgsForCall(fakeSentryProjects.AddTeamCallCount() - 2)
+				Expect(organizationSlug).To(Equal("organization"))
+				Expect(projectSlug).To(Equal(project.Spec.Slug))
+				Expect(teamSlug).To(Equal("test-team"))
+				organizationSlug, projectSlug, teamSlug = fakeSentryProjects.AddTeamArgsForCall(fakeSentryProjects.AddTeamCallCount() - 1)
+				Expect(teamSlug).To(Equal("test-team-shared"))
 
-				By("invoked the Sentry client's .Organizations.ListProjects method")
-				organizationSlug, opts := fakeSentryOrganizations.ListProjectsArgsForCall(fakeSentryOrganizations.ListProjectsCallCount() - 1)
+				By("invoked the Sentry client's .Projects.RemoveTeam method")
+				organizationSlug, projectSlug, teamSlug = fakeSentryProjects.RemoveTeamArgsForCall(fakeSentryProjects.RemoveTeamCallCount() - 1)
 				Expect(organizationSlug).To(Equal("organization"))
-				Expect(opts.Cursor).To(BeEmpty())
+				Expect(projectSlug).To(Equal(project.Spec.Slug))
+				Expect(teamSlug).To(Equal("new-team"))
+			})
+		})
+
+		Context("the Sentry project's settings have drifted", func() {
+			BeforeEach(func() {
+				existing = testSentryProject("12345", "test-team", "test-project-update")
+				existing.SubjectPrefix = "[drifted]"
+				existing.ResolveAge = 24
+				existing.AllowedDomains = []string{"*.example.com"}
+				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+				updated := testSentryProject("12345", "test-team", "test-project-update")
+				updated.SubjectPrefix = "[test]"
+				updated.ResolveAge = 0
+				fakeSentryProjects.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+
+				project.Spec.SubjectPrefix = sentry.String("[test]")
+				project.Spec.ResolveAge = sentry.Int(0)
+				project.Spec.AllowedDomains = sentry.Strings()
+			})
+
+			It("the Project's settings get updated successfully", func() {
+				Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+				By("invoked the Sentry client's .Projects.Update method")
+				Eventually(func() (*sentry.UpdateProjectParams, error) {
+					if fakeSentryProjects.UpdateCallCount() == 0 {
+						return nil, nil
+					}
+					_, _, params := fakeSentryProjects.UpdateArgsForCall(fakeSentryProjects.UpdateCallCount() - 1)
+					return params, nil
+				}, timeout, interval).Should(Equal(&sentry.UpdateProjectParams{
+					Name:           "test-project-update",
+					Slug:           "test-project-update",
+					SubjectPrefix:  sentry.String("[test]"),
+					ResolveAge:     sentry.Int(0),
+					AllowedDomains: sentry.Strings(),
+				}))
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.ProjectStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, project)
+					if err != nil {
+						return nil, err
+					}
+					return &project.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.ProjectConditionCreated),
+						"Message":   BeEmpty(),
+						"ID":        Equal("12345"),
+					})),
+				)
+			})
+		})
+
+		Context("the Sentry project's environments are managed", func() {
+			BeforeEach(func() {
+				existing = testSentryProject("12345", "test-team", "test-project-update")
+				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetEnvironmentStub = func(organizationSlug, projectSlug, environmentName string) (*sentry.ProjectEnvironment, *sentry.Response, error) {
+					if environmentName == "staging" {
+						return &sentry.ProjectEnvironment{ID: "1", Name: "staging"}, newSentryResponse(http.StatusOK), nil
+					}
+					return nil, newSentryResponse(http.StatusNotFound), errors.New("The requested resource does not exist")
+				}
+				fakeSentryProjects.UpdateEnvironmentReturns(&sentry.ProjectEnvironment{ID: "1", Name: "staging", IsHidden: true}, newSentryResponse(http.StatusOK), nil)
+
+				project.Spec.Environments = []sentryv1alpha1.ProjectEnvironment{
+					{Name: "staging", Hidden: true},
+					{Name: "canary", Hidden: true},
+				}
+			})
+
+			AfterEach(func() {
+				fakeSentryProjects.GetEnvironmentStub = nil
+			})
+
+			It("the Project's environments get updated successfully", func() {
+				Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.ProjectStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, project)
+					if err != nil {
+						return nil, err
+					}
+					return &project.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition":           Equal(sentryv1alpha1.ProjectConditionCreated),
+						"Message":             BeEmpty(),
+						"UnknownEnvironments": Equal([]string{"canary"}),
+					})),
+				)
+
+				By("invoked the Sentry client's .Projects.UpdateEnvironment method")
+				organizationSlug, projectSlug, environmentName, params := fakeSentryProjects.UpdateEnvironmentArgsForCall(fakeSentryProjects.UpdateEnvironmentCallCount() - 1)
+				Expect(organizationSlug).To(Equal("organization"))
+				Expect(projectSlug).To(Equal("test-project-update"))
+				Expect(environmentName).To(Equal("staging"))
+				Expect(params).To(Equal(&sentry.UpdateProjectEnvironmentParams{
+					IsHidden: sentry.Bool(true),
+				}))
+			})
+		})
+
+		Context("the Sentry project's inbound filters have drifted", func() {
+			BeforeEach(func() {
+				existing = testSentryProject("12345", "test-team", "test-project-update")
+				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.UpdateReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.ListFiltersReturns([]sentry.ProjectFilter{
+					{ID: "browser-extensions", Active: false},
+					{ID: "localhost", Active: false},
+					{ID: "web-crawlers", Active: false},
+					{ID: "legacy-browsers", Active: true, Subfilters: []string{"ie_pre_9"}},
+				}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.UpdateFilterReturns(newSentryResponse(http.StatusCreated), nil)
+
+				project.Spec.InboundFilters = &sentryv1alpha1.ProjectInboundFilters{
+					BrowserExtensions: true,
+					LegacyBrowsers:    []sentryv1alpha1.ProjectLegacyBrowser{"ie9", "ie_pre_9"},
+					FilteredReleases:  []string{"1.0.*", "2.0.0-rc*"},
+				}
+			})
+
+			It("the Project's inbound filters get updated successfully", func() {
+				updateFilterCallCount := fakeSentryProjects.UpdateFilterCallCount()
+				Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+				Eventually(func() int {
+					return fakeSentryProjects.UpdateFilterCallCount()
+				}, timeout, interval).Should(BeNumerically(">=", updateFilterCallCount+2))
+
+				By("invoked the Sentry client's .Projects.UpdateFilter method")
+				organizationSlug, projectSlug, filterID, params := fakeSentryProjects.UpdateFilterArgsForCall(updateFilterCallCount)
+				Expect(organizationSlug).To(Equal("organization"))
+				Expect(projectSlug).To(Equal("test-project-update"))
+				Expect(filterID).To(Equal("browser-extensions"))
+				Expect(params).To(Equal(&sentry.UpdateProjectFilterParams{
+					Active: sentry.Bool(true),
+				}))
+
+				organizationSlug, projectSlug, filterID, params = fakeSentryProjects.UpdateFilterArgsForCall(updateFilterCallCount + 1)
+				Expect(organizationSlug).To(Equal("organization"))
+				Expect(projectSlug).To(Equal("test-project-update"))
+				Expect(filterID).To(Equal("legacy-browsers"))
+				Expect(params).To(Equal(&sentry.UpdateProjectFilterParams{
+					Active:     sentry.Bool(true),
+					Subfilters: []string{"ie9", "ie_pre_9"},
+				}))
+
+				By("invoked the Sentry client's .Projects.Update method")
+				Eventually(func() *sentry.UpdateProjectParams {
+					_, _, params := fakeSentryProjects.UpdateArgsForCall(fakeSentryProjects.UpdateCallCount() - 1)
+					return params
+				}, timeout, interval).Should(Equal(&sentry.UpdateProjectParams{
+					Options: &sentry.UpdateProjectOptionsParams{
+						FilterErrorMessages: sentry.String(""),
+						FilterReleases:      sentry.String("1.0.*\n2.0.0-rc*"),
+					},
+				}))
+			})
+		})
+
+		Context("the Sentry project's spike protection has drifted", func() {
+			BeforeEach(func() {
+				existing = testSentryProject("12345", "test-team", "test-project-update")
+				existing.Options.SpikeProtectionDisabled = sentry.Bool(true)
+				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.UpdateReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryOrganizations.EnableSpikeProtectionReturns(newSentryResponse(http.StatusCreated), nil)
+
+				project.Spec.SpikeProtection = sentry.Bool(true)
+			})
+
+			It("the Project's spike protection gets updated successfully", func() {
+				Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.ProjectStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, project)
+					if err != nil {
+						return nil, err
+					}
+					return &project.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition":       Equal(sentryv1alpha1.ProjectConditionCreated),
+						"SpikeProtection": Equal(sentry.Bool(true)),
+					})),
+				)
+
+				By("invoked the Sentry client's .Organizations.EnableSpikeProtection method")
+				organizationSlug, params := fakeSentryOrganizations.EnableSpikeProtectionArgsForCall(fakeSentryOrganizations.EnableSpikeProtectionCallCount() - 1)
+				Expect(organizationSlug).To(Equal("organization"))
+				Expect(params).To(Equal(&sentry.SpikeProtectionParams{
+					Projects: []string{"test-project-update"},
+				}))
+			})
+		})
+
+		Context("the Sentry project's quotas have drifted", func() {
+			BeforeEach(func() {
+				existing = testSentryProject("12345", "test-team", "test-project-update")
+				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.UpdateReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.ListQuotasReturns([]sentry.ProjectQuota{
+					{Category: "error", Limit: sentry.Int(10000), Window: 3600},
+					{Category: "transaction"},
+					{Category: "attachment", Limit: sentry.Int(500), Window: 86400},
+				}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.UpdateQuotaReturns(&sentry.ProjectQuota{
+					Category: "transaction",
+					Limit:    sentry.Int(50000),
+					Window:   3600,
+				}, newSentryResponse(http.StatusOK), nil)
+
+				project.Spec.Quotas = &sentryv1alpha1.ProjectQuotas{
+					Errors:       &sentryv1alpha1.ProjectQuota{Limit: 10000, Window: 3600},
+					Transactions: &sentryv1alpha1.ProjectQuota{Limit: 50000, Window: 3600},
+				}
+			})
+
+			It("the Project's quotas get updated successfully", func() {
+				updatedBy := fakeSentryProjects.UpdateQuotaCallCount()
+
+				Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.ProjectStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, project)
+					if err != nil {
+						return nil, err
+					}
+					return &project.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.ProjectConditionCreated),
+						"Quotas": Equal(&sentryv1alpha1.ProjectQuotas{
+							Errors:       &sentryv1alpha1.ProjectQuota{Limit: 10000, Window: 3600},
+							Transactions: &sentryv1alpha1.ProjectQuota{Limit: 50000, Window: 3600},
+							Attachments:  &sentryv1alpha1.ProjectQuota{Limit: 500, Window: 86400},
+						}),
+					})),
+				)
+
+				By("invoked the Sentry client's .Projects.UpdateQuota method for the drifted categories only")
+				Expect(fakeSentryProjects.UpdateQuotaCallCount()).To(BeNumerically(">", updatedBy))
+				for i := updatedBy; i < fakeSentryProjects.UpdateQuotaCallCount(); i++ {
+					organizationSlug, projectSlug, category, params := fakeSentryProjects.UpdateQuotaArgsForCall(i)
+					Expect(organizationSlug).To(Equal("organization"))
+					Expect(projectSlug).To(Equal("test-project-update"))
+					Expect(category).To(Equal("transaction"))
+					Expect(params).To(Equal(&sentry.UpdateProjectQuotaParams{
+						Limit:  sentry.Int(50000),
+						Window: 3600,
+					}))
+				}
+			})
+		})
+
+		Context("the Project only specifies the deprecated team field", func() {
+			var (
+				addedBy, removedBy int
+			)
+
+			BeforeEach(func() {
+				existing = testSentryProject("12345", "test-team", "test-project-update")
+				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+				fakeSentryProjects.UpdateReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+				addedBy = fakeSentryProjects.AddTeamCallCount()
+				removedBy = fakeSentryProjects.RemoveTeamCallCount()
+
+				project.Spec.Teams = nil
+				project.Spec.Team = "test-team"
+			})
+
+			It("the Project's teams are left untouched", func() {
+				Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.ProjectStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, project)
+					if err != nil {
+						return nil, err
+					}
+					return &project.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.ProjectConditionCreated),
+						"Message":   BeEmpty(),
+					})),
+				)
+
+				By("did not invoke the Sentry client's .Projects.AddTeam or .Projects.RemoveTeam methods")
+				Expect(fakeSentryProjects.AddTeamCallCount()).To(Equal(addedBy))
+				Expect(fakeSentryProjects.RemoveTeamCallCount()).To(Equal(removedBy))
+			})
+		})
+
+		Context("the Project doesn't specify any teams", func() {
+			var (
+				removedBy int
+			)
+
+			BeforeEach(func() {
+				existing = testSentryProject("12345", "test-team", "test-project-update")
+				fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
+
+				removedBy = fakeSentryProjects.RemoveTeamCallCount()
+
+				project.Spec.Teams = nil
+				project.Spec.Team = ""
+			})
+
+			It("the Project is not reconciled", func() {
+				Expect(k8sClient.Update(ctx, project)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.ProjectStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, project)
+					if err != nil {
+						return nil, err
+					}
+					return &project.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.ProjectConditionError),
+						"Message":   Equal(controllers.ErrProjectTeamsEmpty.Error()),
+					})),
+				)
+
+				By("did not invoke the Sentry client's .Projects.RemoveTeam method")
+				Expect(fakeSentryProjects.RemoveTeamCallCount()).To(Equal(removedBy))
 			})
 		})
 	})
@@ -261,7 +622,7 @@ var _ = Describe("ProjectReconciler", func() {
 		BeforeEach(func() {
 			Expect(k8sClient.Get(ctx, lookupKey, project)).To(Succeed())
 
-			existing = testSentryProject("12345", project.Spec.Team, project.Spec.Name)
+			existing = testSentryProject("12345", project.Spec.Teams[0], project.Spec.Name)
 			fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*existing}, newSentryResponse(http.StatusOK), nil)
 			fakeSentryProjects.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
 		})
@@ -39,6 +39,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -54,9 +55,10 @@ const (
 // ProjectKeyReconciler reconciles a ProjectKey object
 type ProjectKeyReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
-	Sentry *Sentry
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
 }
 
 func (r *ProjectKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -69,6 +71,7 @@ func (r *ProjectKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 // +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projectkeys,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projectkeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 
 func (r *ProjectKeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
@@ -85,11 +88,26 @@ func (r *ProjectKeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 		return ctrl.Result{}, err
 	}
 
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &projectkey, ProjectKeyFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
+	}
+
 	hasFinalizer := containsFinalizer(projectkey.GetFinalizers(), ProjectKeyFinalizerName)
 
-	// Create our Sentry resource and secret if we have not been synced before
-	if projectkey.Status.LastSynced.IsZero() {
-		sProjectKey, err := r.handleCreate(ctx, &projectkey, hasFinalizer)
+	// Create our Sentry resource and secret if we have not been synced before, unless we've been asked to adopt an
+	// existing Sentry resource
+	adoptID, adopt := projectkey.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if projectkey.Status.LastSynced.IsZero() && !adopt {
+		sProjectKey, err := r.handleCreate(ctx, sc, &projectkey, hasFinalizer)
 		if err != nil {
 			log.Error(err, "failed to create ProjectKey")
 			return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
@@ -104,9 +122,21 @@ func (r *ProjectKeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 		return ctrl.Result{}, nil
 	}
 
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if projectkey.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &projectkey, ProjectKeyFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt ProjectKey")
+			return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
+		}
+
+		projectkey.Status.ID = adoptID
+		log.Info("adopting existing Sentry project key", "id", adoptID)
+	}
+
 	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
 	// will handle this accordingly below.
-	existing, projectSlug, err := r.getExistingState(projectkey)
+	existing, projectSlug, err := r.getExistingState(sc, projectkey)
 	if err != nil && !errors.Is(err, ErrOutOfSync) {
 		log.Error(err, "failed to fetch Sentry project key state")
 		return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
@@ -115,7 +145,7 @@ func (r *ProjectKeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
 	if !projectkey.ObjectMeta.DeletionTimestamp.IsZero() {
 		if hasFinalizer {
-			if err := r.handleDelete(ctx, &projectkey, existing, projectSlug); err != nil {
+			if err := r.handleDelete(ctx, sc, &projectkey, existing, projectSlug); err != nil {
 				log.Error(err, "failed to delete ProjectKey")
 				return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
 			}
@@ -125,9 +155,16 @@ func (r *ProjectKeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 		return ctrl.Result{}, nil
 	}
 
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && projectkey.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry project key %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt ProjectKey")
+		return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
+	}
+
 	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
 	if errors.Is(err, ErrOutOfSync) {
-		sProjectKey, err := r.handleCreate(ctx, &projectkey, hasFinalizer)
+		sProjectKey, err := r.handleCreate(ctx, sc, &projectkey, hasFinalizer)
 		if err != nil {
 			log.Error(err, "failed to recreate ProjectKey")
 			return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
@@ -147,7 +184,7 @@ func (r *ProjectKeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 	}
 
 	// Reconcile any differences between our spec and the existing state of our Sentry resource
-	sProjectKey, err := r.handleUpdate(ctx, &projectkey, existing, projectSlug)
+	sProjectKey, err := r.handleUpdate(ctx, sc, &projectkey, existing, projectSlug)
 	if err != nil {
 		log.Error(err, "failed to update ProjectKey")
 		return ctrl.Result{}, r.handleError(ctx, &projectkey, err)
@@ -169,11 +206,11 @@ func (r *ProjectKeyReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 // getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
 // returns an ErrOutOfSync error if the resource cannot be found. It also returns our associated project's slug, as it's
 // not part of the payload returned when listing a Sentry project's keys.
-func (r *ProjectKeyReconciler) getExistingState(projectkey sentryv1alpha1.ProjectKey) (*sentry.ProjectKey, string, error) {
+func (r *ProjectKeyReconciler) getExistingState(sc *Sentry, projectkey sentryv1alpha1.ProjectKey) (*sentry.ProjectKey, string, error) {
 	listProjectsOpts := &sentry.ListOptions{}
 	var sProjects []sentry.Project
 	for {
-		projects, resp, err := r.Sentry.Client.Organizations.ListProjects(r.Sentry.Organization, listProjectsOpts)
+		projects, resp, err := sc.Client.Organizations.ListProjects(sc.Organization, listProjectsOpts)
 		if err != nil {
 			switch {
 			case resp.StatusCode >= 500:
@@ -197,6 +234,13 @@ func (r *ProjectKeyReconciler) getExistingState(projectkey sentryv1alpha1.Projec
 			projectSlug = sProject.Slug
 			break
 		}
+
+		// Fall back to our spec's project if we are adopting an existing Sentry project key, as we won't know the ID of
+		// its project yet
+		if projectkey.Status.ProjectID == "" && sProject.Slug == projectkey.Spec.Project {
+			projectSlug = sProject.Slug
+			break
+		}
 	}
 
 	if projectSlug == "" {
@@ -206,7 +250,7 @@ func (r *ProjectKeyReconciler) getExistingState(projectkey sentryv1alpha1.Projec
 	listKeysOpts := &sentry.ListOptions{}
 	var sProjectKeys []sentry.ProjectKey
 	for {
-		keys, resp, err := r.Sentry.Client.Projects.ListKeys(r.Sentry.Organization, projectSlug, listKeysOpts)
+		keys, resp, err := sc.Client.Projects.ListKeys(sc.Organization, projectSlug, listKeysOpts)
 		if err != nil {
 			switch {
 			case resp.StatusCode >= 500:
@@ -237,8 +281,8 @@ func (r *ProjectKeyReconciler) getExistingState(projectkey sentryv1alpha1.Projec
 	return nil, "", ErrOutOfSync
 }
 
-func (r *ProjectKeyReconciler) handleCreate(ctx context.Context, projectkey *sentryv1alpha1.ProjectKey, hasFinalizer bool) (*sentry.ProjectKey, error) {
-	sProjectKey, resp, err := r.Sentry.Client.Projects.CreateKey(r.Sentry.Organization, projectkey.Spec.Project, &sentry.CreateProjectKeyParams{
+func (r *ProjectKeyReconciler) handleCreate(ctx context.Context, sc *Sentry, projectkey *sentryv1alpha1.ProjectKey, hasFinalizer bool) (*sentry.ProjectKey, error) {
+	sProjectKey, resp, err := sc.Client.Projects.CreateKey(sc.Organization, projectkey.Spec.Project, &sentry.CreateProjectKeyParams{
 		Name: projectkey.Spec.Name,
 	})
 	if err != nil {
@@ -313,10 +357,10 @@ func (r *ProjectKeyReconciler) reconcileSecret(ctx context.Context, projectkey *
 	return nil
 }
 
-func (r *ProjectKeyReconciler) handleDelete(ctx context.Context, projectkey *sentryv1alpha1.ProjectKey, existing *sentry.ProjectKey, projectSlug string) error {
+func (r *ProjectKeyReconciler) handleDelete(ctx context.Context, sc *Sentry, projectkey *sentryv1alpha1.ProjectKey, existing *sentry.ProjectKey, projectSlug string) error {
 	// Our resource might no longer exist so check that it's not nil to avoid panicking below
 	if existing != nil {
-		resp, err := r.Sentry.Client.Projects.DeleteKey(r.Sentry.Organization, projectSlug, existing.ID)
+		resp, err := sc.Client.Projects.DeleteKey(sc.Organization, projectSlug, existing.ID)
 		if err != nil {
 			switch {
 			case resp.StatusCode >= 500:
@@ -340,7 +384,7 @@ func (r *ProjectKeyReconciler) handleDelete(ctx context.Context, projectkey *sen
 	return nil
 }
 
-func (r *ProjectKeyReconciler) handleUpdate(ctx context.Context, projectkey *sentryv1alpha1.ProjectKey, existing *sentry.ProjectKey, projectSlug string) (*sentry.ProjectKey, error) {
+func (r *ProjectKeyReconciler) handleUpdate(ctx context.Context, sc *Sentry, projectkey *sentryv1alpha1.ProjectKey, existing *sentry.ProjectKey, projectSlug string) (*sentry.ProjectKey, error) {
 	// Error if our spec's project doesn't match reality as updating a project key's project is not a valid operation.
 	// This helps highlight configuration drift where a user forgets to update our spec's project after modifying the
 	// associated project's slug.
@@ -348,7 +392,7 @@ func (r *ProjectKeyReconciler) handleUpdate(ctx context.Context, projectkey *sen
 		return nil, retryableError{fmt.Errorf("%w: ProjectKey's project could not be updated", ErrOutOfSync)}
 	}
 
-	sProjectKey, resp, err := r.Sentry.Client.Projects.UpdateKey(r.Sentry.Organization, projectSlug, existing.ID, &sentry.UpdateProjectKeyParams{
+	sProjectKey, resp, err := sc.Client.Projects.UpdateKey(sc.Organization, projectSlug, existing.ID, &sentry.UpdateProjectKeyParams{
 		Name: projectkey.Spec.Name,
 	})
 	if err != nil {
@@ -380,8 +424,18 @@ func (r *ProjectKeyReconciler) handleUpdate(ctx context.Context, projectkey *sen
 }
 
 // handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
-// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key.
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
 func (r *ProjectKeyReconciler) handleError(ctx context.Context, projectkey *sentryv1alpha1.ProjectKey, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(projectkey, corev1.EventTypeNormal, "DryRun", de.Error())
+		projectkey.Status.Condition = sentryv1alpha1.ProjectKeyConditionPlanned
+		projectkey.Status.Message = de.Error()
+		return r.Status().Update(ctx, projectkey)
+	}
+
 	projectkey.Status.Condition = sentryv1alpha1.ProjectKeyConditionError
 	projectkey.Status.Message = err.Error()
 	if err := r.Status().Update(ctx, projectkey); err != nil {
@@ -0,0 +1,311 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	ProjectOwnershipFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/projectownership"
+)
+
+// ProjectOwnershipReconciler reconciles a ProjectOwnership object
+type ProjectOwnershipReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *ProjectOwnershipReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.ProjectOwnership{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projectownerships,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projectownerships/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=teams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *ProjectOwnershipReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("projectownership", req.NamespacedName)
+
+	var ownership sentryv1alpha1.ProjectOwnership
+	if err := r.Get(ctx, req.NamespacedName, &ownership); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch ProjectOwnership")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &ownership, ProjectOwnershipFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &ownership, err)
+	}
+
+	hasFinalizer := containsFinalizer(ownership.GetFinalizers(), ProjectOwnershipFinalizerName)
+
+	// Attempt to clear our Sentry project's ownership rules and remove our finalizer if we receive a delete request
+	if !ownership.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &ownership); err != nil {
+				log.Error(err, "failed to delete ProjectOwnership")
+				return ctrl.Result{}, r.handleError(ctx, &ownership, err)
+			}
+		}
+
+		log.Info("successfully deleted ProjectOwnership")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing ownership rules of our Sentry project
+	if err := r.handleUpdate(ctx, sc, &ownership, hasFinalizer); err != nil {
+		log.Error(err, "failed to update ProjectOwnership")
+		return ctrl.Result{}, r.handleError(ctx, &ownership, err)
+	}
+
+	log.Info("successfully updated ProjectOwnership")
+
+	return ctrl.Result{}, nil
+}
+
+// handleUpdate updates the ownership rules of our Sentry project if they have drifted from our spec. Sentry projects
+// always have ownership rules, so there is nothing to create.
+func (r *ProjectOwnershipReconciler) handleUpdate(ctx context.Context, sc *Sentry, ownership *sentryv1alpha1.ProjectOwnership, hasFinalizer bool) error {
+	// Error if our spec's project doesn't match the one we have synced, as we would otherwise leave its rules behind
+	if ownership.Status.Project != "" && ownership.Status.Project != ownership.Spec.Project {
+		return fmt.Errorf("%w: ProjectOwnership's project could not be updated", ErrOutOfSync)
+	}
+
+	raw, err := r.ownershipRules(ctx, ownership)
+	if err != nil {
+		return err
+	}
+
+	existing, resp, err := sc.Client.Projects.GetOwnership(sc.Organization, ownership.Spec.Project)
+	if err != nil {
+		return ownershipError(resp, err)
+	}
+
+	params := &sentry.UpdateProjectOwnershipParams{
+		AutoAssignment: ownership.Spec.AutoAssignment,
+		Fallthrough:    ownership.Spec.Fallthrough,
+		Raw:            &raw,
+	}
+
+	drifted := strings.TrimSpace(existing.Raw) != strings.TrimSpace(raw)
+	if ownership.Spec.AutoAssignment != nil && *ownership.Spec.AutoAssignment != existing.AutoAssignment {
+		drifted = true
+	}
+
+	if ownership.Spec.Fallthrough != nil && *ownership.Spec.Fallthrough != existing.Fallthrough {
+		drifted = true
+	}
+
+	if drifted {
+		_, resp, err := sc.Client.Projects.UpdateOwnership(sc.Organization, ownership.Spec.Project, params)
+		if err != nil {
+			return ownershipError(resp, err)
+		}
+	}
+
+	ownership.Status.Condition = sentryv1alpha1.ProjectOwnershipConditionCreated
+	ownership.Status.Message = ""
+	ownership.Status.Project = ownership.Spec.Project
+	ownership.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, ownership); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		ownership.SetFinalizers(append(ownership.GetFinalizers(), ProjectOwnershipFinalizerName))
+		if err := r.Update(ctx, ownership); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *ProjectOwnershipReconciler) handleDelete(ctx context.Context, sc *Sentry, ownership *sentryv1alpha1.ProjectOwnership) error {
+	// Clear the ownership rules of the Sentry project that we have synced, if any
+	if ownership.Status.Project != "" {
+		_, resp, err := sc.Client.Projects.UpdateOwnership(sc.Organization, ownership.Status.Project, &sentry.UpdateProjectOwnershipParams{
+			Raw: sentry.String(""),
+		})
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our Sentry project might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	ownership.SetFinalizers(removeFinalizer(ownership.GetFinalizers(), ProjectOwnershipFinalizerName))
+	if err := r.Update(ctx, ownership); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// ownershipRules renders our spec's raw and structured rules in Sentry's raw text format, resolving Team owners to the
+// slugs of their Sentry teams.
+func (r *ProjectOwnershipReconciler) ownershipRules(ctx context.Context, ownership *sentryv1alpha1.ProjectOwnership) (string, error) {
+	var lines []string
+	if raw := strings.TrimSpace(ownership.Spec.Raw); raw != "" {
+		lines = append(lines, raw)
+	}
+
+	for _, rule := range ownership.Spec.Rules {
+		owners := make([]string, len(rule.Owners))
+		for idx, owner := range rule.Owners {
+			switch {
+			case owner.Team != "" && owner.Email != "":
+				return "", fmt.Errorf("owner of rule %s:%s must only set one of team or email", rule.Type, rule.Pattern)
+			case owner.Email != "":
+				owners[idx] = owner.Email
+			case owner.Team != "":
+				teamSlug, err := r.teamSlug(ctx, ownership.Namespace, owner.Team)
+				if err != nil {
+					return "", err
+				}
+				owners[idx] = "#" + teamSlug
+			default:
+				return "", fmt.Errorf("owner of rule %s:%s must set one of team or email", rule.Type, rule.Pattern)
+			}
+		}
+
+		lines = append(lines, fmt.Sprintf("%s:%s %s", rule.Type, rule.Pattern, strings.Join(owners, " ")))
+	}
+
+	if len(lines) == 0 {
+		return "", nil
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// teamSlug returns the slug of the Sentry team of the given Team, once it has been created in Sentry.
+func (r *ProjectOwnershipReconciler) teamSlug(ctx context.Context, namespace, name string) (string, error) {
+	var team sentryv1alpha1.Team
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &team); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Retry as the Team might get created later on
+			return "", retryableError{fmt.Errorf("Team %s not found", name)}
+		}
+		return "", retryableError{err}
+	}
+
+	if team.Status.LastSynced.IsZero() {
+		// Retry as Sentry rejects ownership rules that reference teams which don't exist yet
+		return "", retryableError{fmt.Errorf("Team %s has not been created in Sentry yet", name)}
+	}
+
+	return team.Spec.Slug, nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *ProjectOwnershipReconciler) handleError(ctx context.Context, ownership *sentryv1alpha1.ProjectOwnership, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(ownership, corev1.EventTypeNormal, "DryRun", de.Error())
+		ownership.Status.Condition = sentryv1alpha1.ProjectOwnershipConditionPlanned
+		ownership.Status.Message = de.Error()
+		return r.Status().Update(ctx, ownership)
+	}
+
+	ownership.Status.Condition = sentryv1alpha1.ProjectOwnershipConditionError
+	ownership.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, ownership); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// ownershipError wraps errors from requests against the ownership rules of a Sentry project, retrying on 5XX and 404
+// errors.
+func ownershipError(resp *sentry.Response, err error) error {
+	switch {
+	case resp.StatusCode >= 500:
+		return retryableError{err}
+	case resp.StatusCode == http.StatusNotFound:
+		// Retry on 404 errors as our Sentry project might not have been created yet
+		return retryableError{err}
+	default:
+		// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+		return err
+	}
+}
@@ -0,0 +1,157 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("ProjectOwnershipReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		ownershipName      = "test-projectownership"
+		ownershipNamespace = "test-projectownership-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		ownership *sentryv1alpha1.ProjectOwnership
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.ProjectOwnership{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "ProjectOwnership",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ownershipName,
+			Namespace: ownershipNamespace,
+		},
+		Spec: sentryv1alpha1.ProjectOwnershipSpec{
+			Project: "test-project",
+			Raw:     "path:src/frontend/* #frontend",
+			Rules: []sentryv1alpha1.ProjectOwnershipRule{
+				{
+					Type:    "url",
+					Pattern: "*/checkout/*",
+					Owners: []sentryv1alpha1.ProjectOwner{
+						{Email: "jane@example.com"},
+					},
+				},
+			},
+			Fallthrough: sentry.Bool(false),
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: ownershipName, Namespace: ownershipNamespace}
+		ownership = new(sentryv1alpha1.ProjectOwnership)
+	})
+
+	Context("when creating a ProjectOwnership", func() {
+		BeforeEach(func() {
+			existing := &sentry.ProjectOwnership{Fallthrough: true, IsActive: true}
+			fakeSentryProjects.GetOwnershipReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.UpdateOwnershipReturns(existing, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the ProjectOwnership gets synced successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.ProjectOwnershipStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, ownership)
+				if err != nil {
+					return nil, err
+				}
+				return &ownership.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ProjectOwnershipConditionCreated),
+					"Message":   BeEmpty(),
+					"Project":   Equal("test-project"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Eventually(func() ([]string, error) {
+				err := k8sClient.Get(ctx, lookupKey, ownership)
+				return ownership.Finalizers, err
+			}, timeout, interval).Should(ContainElement(controllers.ProjectOwnershipFinalizerName))
+
+			By("invoked the Sentry client's .Projects.UpdateOwnership method")
+			organizationSlug, projectSlug, params := fakeSentryProjects.UpdateOwnershipArgsForCall(fakeSentryProjects.UpdateOwnershipCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(params).To(Equal(&sentry.UpdateProjectOwnershipParams{
+				Fallthrough: sentry.Bool(false),
+				Raw:         sentry.String("path:src/frontend/* #frontend\nurl:*/checkout/* jane@example.com\n"),
+			}))
+		})
+	})
+
+	Context("when updating a ProjectOwnership", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, ownership)).To(Succeed())
+
+			ownership.Spec.Rules[0].Owners = append(ownership.Spec.Rules[0].Owners, sentryv1alpha1.ProjectOwner{
+				Team: "test-team-missing",
+			})
+		})
+
+		It("the ProjectOwnership fails to reference a Team that doesn't exist", func() {
+			Expect(k8sClient.Update(ctx, ownership)).To(Succeed())
+
+			Eventually(func() (*sentryv1alpha1.ProjectOwnershipStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, ownership)
+				if err != nil {
+					return nil, err
+				}
+				return &ownership.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ProjectOwnershipConditionError),
+					"Message":   Equal("Team test-team-missing not found"),
+				})),
+			)
+		})
+	})
+
+	Context("when deleting a ProjectOwnership", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, ownership)).To(Succeed())
+
+			fakeSentryProjects.UpdateOwnershipReturns(&sentry.ProjectOwnership{}, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the ProjectOwnership gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, ownership)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, ownership)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Projects.UpdateOwnership method")
+			organizationSlug, projectSlug, params := fakeSentryProjects.UpdateOwnershipArgsForCall(fakeSentryProjects.UpdateOwnershipCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(params).To(Equal(&sentry.UpdateProjectOwnershipParams{
+				Raw: sentry.String(""),
+			}))
+		})
+	})
+})
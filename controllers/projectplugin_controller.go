@@ -0,0 +1,393 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	ProjectPluginFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/projectplugin"
+)
+
+// ProjectPluginReconciler reconciles a ProjectPlugin object
+type ProjectPluginReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *ProjectPluginReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.ProjectPlugin{}).
+		// Apply changes to the Secrets referenced by our configuration
+		Watches(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.pluginsForSecret),
+		}).
+		WithEventFilter(&referenceChangedPredicate{}).
+		Complete(r)
+}
+
+// pluginsForSecret maps a Secret to the ProjectPlugins in its namespace whose configuration references it.
+func (r *ProjectPluginReconciler) pluginsForSecret(obj handler.MapObject) []reconcile.Request {
+	var pluginList sentryv1alpha1.ProjectPluginList
+	if err := r.List(context.Background(), &pluginList, client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list ProjectPlugins", "secret", obj.Meta.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, plugin := range pluginList.Items {
+		for _, field := range plugin.Spec.Config {
+			if field.ValueFrom != nil && field.ValueFrom.SecretKeyRef != nil && field.ValueFrom.SecretKeyRef.Name == obj.Meta.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: plugin.Namespace, Name: plugin.Name},
+				})
+				break
+			}
+		}
+	}
+
+	return requests
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projectplugins,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=projectplugins/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *ProjectPluginReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("projectplugin", req.NamespacedName)
+
+	var plugin sentryv1alpha1.ProjectPlugin
+	if err := r.Get(ctx, req.NamespacedName, &plugin); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch ProjectPlugin")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &plugin, ProjectPluginFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &plugin, err)
+	}
+
+	hasFinalizer := containsFinalizer(plugin.GetFinalizers(), ProjectPluginFinalizerName)
+
+	// Attempt to disable our Sentry project's plugin and remove our finalizer if we receive a delete request
+	if !plugin.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &plugin); err != nil {
+				log.Error(err, "failed to delete ProjectPlugin")
+				return ctrl.Result{}, r.handleError(ctx, &plugin, err)
+			}
+		}
+
+		log.Info("successfully deleted ProjectPlugin")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing plugin of our Sentry project
+	if err := r.handleUpdate(ctx, sc, &plugin, hasFinalizer); err != nil {
+		log.Error(err, "failed to update ProjectPlugin")
+		return ctrl.Result{}, r.handleError(ctx, &plugin, err)
+	}
+
+	log.Info("successfully updated ProjectPlugin")
+
+	return ctrl.Result{}, nil
+}
+
+// handleUpdate configures and enables or disables the plugin of our Sentry project if it has drifted from our spec.
+// Plugins are always available to Sentry projects, so there is nothing to create.
+func (r *ProjectPluginReconciler) handleUpdate(ctx context.Context, sc *Sentry, plugin *sentryv1alpha1.ProjectPlugin, hasFinalizer bool) error {
+	// Error if our spec's project or plugin doesn't match the one we have synced, as we would otherwise leave it behind
+	if plugin.Status.Project != "" && plugin.Status.Project != plugin.Spec.Project {
+		return fmt.Errorf("%w: ProjectPlugin's project could not be updated", ErrOutOfSync)
+	}
+
+	if plugin.Status.Plugin != "" && plugin.Status.Plugin != plugin.Spec.Plugin {
+		return fmt.Errorf("%w: ProjectPlugin's plugin could not be updated", ErrOutOfSync)
+	}
+
+	config, revisions, err := r.pluginConfig(ctx, plugin)
+	if err != nil {
+		return err
+	}
+
+	existing, resp, err := sc.Client.Projects.GetPlugin(sc.Organization, plugin.Spec.Project, plugin.Spec.Plugin)
+	if err != nil {
+		return pluginError(resp, err)
+	}
+
+	// Sentry doesn't return the values of secret fields, so we also compare against the hash of the configuration that
+	// we last synced
+	hash := pluginConfigHash(revisions)
+	if len(config) > 0 && (hash != plugin.Status.ConfigHash || pluginConfigDrifted(existing, config)) {
+		params := make(sentry.UpdateProjectPluginParams, len(config))
+		for name, value := range config {
+			params[name] = value
+		}
+
+		_, resp, err := sc.Client.Projects.UpdatePlugin(sc.Organization, plugin.Spec.Project, plugin.Spec.Plugin, params)
+		if err != nil {
+			return pluginError(resp, err)
+		}
+	}
+
+	enabled := plugin.Spec.Enabled == nil || *plugin.Spec.Enabled
+	switch {
+	case enabled && !existing.Enabled:
+		resp, err := sc.Client.Projects.EnablePlugin(sc.Organization, plugin.Spec.Project, plugin.Spec.Plugin)
+		if err != nil {
+			return pluginError(resp, err)
+		}
+	case !enabled && existing.Enabled:
+		resp, err := sc.Client.Projects.DisablePlugin(sc.Organization, plugin.Spec.Project, plugin.Spec.Plugin)
+		if err != nil {
+			return pluginError(resp, err)
+		}
+	}
+
+	plugin.Status.Condition = sentryv1alpha1.ProjectPluginConditionSynced
+	plugin.Status.Message = ""
+	plugin.Status.Project = plugin.Spec.Project
+	plugin.Status.Plugin = plugin.Spec.Plugin
+	plugin.Status.Enabled = enabled
+	plugin.Status.ConfigHash = hash
+	plugin.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, plugin); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		plugin.SetFinalizers(append(plugin.GetFinalizers(), ProjectPluginFinalizerName))
+		if err := r.Update(ctx, plugin); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *ProjectPluginReconciler) handleDelete(ctx context.Context, sc *Sentry, plugin *sentryv1alpha1.ProjectPlugin) error {
+	// Disable the plugin that we have synced, if any, and clear its configuration so no credentials are left behind
+	if plugin.Status.Plugin != "" {
+		resp, err := sc.Client.Projects.DisablePlugin(sc.Organization, plugin.Status.Project, plugin.Status.Plugin)
+		if err == nil {
+			_, resp, err = sc.Client.Projects.ResetPlugin(sc.Organization, plugin.Status.Project, plugin.Status.Plugin)
+		}
+
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our Sentry project might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	plugin.SetFinalizers(removeFinalizer(plugin.GetFinalizers(), ProjectPluginFinalizerName))
+	if err := r.Update(ctx, plugin); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// pluginConfig resolves our spec's configuration to the values of the plugin's fields, reading sensitive values from
+// their referenced Secrets. It also returns the revision of each field's value, which identifies the version of the
+// referenced Secret in place of a sensitive value, so that changes to the configuration can be detected without
+// storing anything derived from the sensitive values.
+func (r *ProjectPluginReconciler) pluginConfig(ctx context.Context, plugin *sentryv1alpha1.ProjectPlugin) (map[string]string, map[string]string, error) {
+	config := make(map[string]string, len(plugin.Spec.Config))
+	revisions := make(map[string]string, len(plugin.Spec.Config))
+	for _, field := range plugin.Spec.Config {
+		if field.ValueFrom == nil {
+			config[field.Name] = field.Value
+			revisions[field.Name] = field.Value
+			continue
+		}
+
+		if field.Value != "" {
+			return nil, nil, fmt.Errorf("config %s must only set one of value or valueFrom", field.Name)
+		}
+
+		ref := field.ValueFrom.SecretKeyRef
+		if ref == nil {
+			return nil, nil, fmt.Errorf("config %s must set valueFrom.secretKeyRef", field.Name)
+		}
+
+		var secret corev1.Secret
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: plugin.Namespace}, &secret); err != nil {
+			if apierrors.IsNotFound(err) && ref.Optional != nil && *ref.Optional {
+				continue
+			}
+
+			// Retry as the error might get resolved once the Secret is created
+			return nil, nil, retryableError{fmt.Errorf("failed to fetch Secret for config %s: %w", field.Name, err)}
+		}
+
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			if ref.Optional != nil && *ref.Optional {
+				continue
+			}
+
+			return nil, nil, fmt.Errorf("Secret %s is missing required key %s", secret.Name, ref.Key)
+		}
+
+		config[field.Name] = string(value)
+		revisions[field.Name] = fmt.Sprintf("secret:%s/%s/%s", secret.UID, secret.ResourceVersion, ref.Key)
+	}
+
+	return config, revisions, nil
+}
+
+// pluginConfigHash returns a hash of the given revisions of the plugin's configuration, independent of the order of its
+// fields.
+func pluginConfigHash(revisions map[string]string) string {
+	if len(revisions) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(revisions))
+	for name := range revisions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\n", name, revisions[name])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// pluginConfigDrifted reports whether the values of the existing plugin's fields differ from the given configuration.
+// Secret fields are skipped as Sentry doesn't return their values.
+func pluginConfigDrifted(existing *sentry.ProjectPlugin, config map[string]string) bool {
+	for _, field := range existing.Config {
+		value, ok := config[field.Name]
+		if !ok || field.Type == "secret" {
+			continue
+		}
+
+		existingValue := ""
+		if field.Value != nil {
+			existingValue = fmt.Sprint(field.Value)
+		}
+
+		if existingValue != value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *ProjectPluginReconciler) handleError(ctx context.Context, plugin *sentryv1alpha1.ProjectPlugin, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(plugin, corev1.EventTypeNormal, "DryRun", de.Error())
+		plugin.Status.Condition = sentryv1alpha1.ProjectPluginConditionPlanned
+		plugin.Status.Message = de.Error()
+		return r.Status().Update(ctx, plugin)
+	}
+
+	plugin.Status.Condition = sentryv1alpha1.ProjectPluginConditionError
+	plugin.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, plugin); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// pluginError wraps errors from requests against the plugins of a Sentry project, retrying on 5XX and 404 errors.
+func pluginError(resp *sentry.Response, err error) error {
+	switch {
+	case resp.StatusCode >= 500:
+		return retryableError{err}
+	case resp.StatusCode == http.StatusNotFound:
+		// Retry on 404 errors as our Sentry project might not have been created yet
+		return retryableError{err}
+	default:
+		// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+		return err
+	}
+}
@@ -0,0 +1,239 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("ProjectPluginReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		pluginName      = "test-projectplugin"
+		pluginNamespace = "test-projectplugin-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		plugin    *sentryv1alpha1.ProjectPlugin
+	)
+
+	ctx := context.Background()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-projectplugin-splunk",
+			Namespace: pluginNamespace,
+		},
+		Data: map[string][]byte{
+			"token": []byte("8a1b6e0c-4c1b-4b3e-9b2a-5d3c7f1e9a2b"),
+		},
+	}
+
+	request := &sentryv1alpha1.ProjectPlugin{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "ProjectPlugin",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pluginName,
+			Namespace: pluginNamespace,
+		},
+		Spec: sentryv1alpha1.ProjectPluginSpec{
+			Project: "test-project",
+			Plugin:  "splunk",
+			Config: []sentryv1alpha1.ProjectPluginConfig{
+				{Name: "instance", Value: "https://splunk.example.com:8088"},
+				{Name: "index", Value: "main"},
+				{
+					Name: "token",
+					ValueFrom: &sentryv1alpha1.ProjectPluginConfigSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "test-projectplugin-splunk"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: pluginName, Namespace: pluginNamespace}
+		plugin = new(sentryv1alpha1.ProjectPlugin)
+	})
+
+	Context("when creating a ProjectPlugin", func() {
+		BeforeEach(func() {
+			existing := testSentryProjectPlugin("splunk", false, "", "")
+			fakeSentryProjects.GetPluginReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.UpdatePluginReturns(testSentryProjectPlugin("splunk", false, "https://splunk.example.com:8088", "main"), newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.EnablePluginReturns(newSentryResponse(http.StatusCreated), nil)
+		})
+
+		It("the ProjectPlugin gets synced successfully", func() {
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.ProjectPluginStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, plugin)
+				if err != nil {
+					return nil, err
+				}
+				return &plugin.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition":  Equal(sentryv1alpha1.ProjectPluginConditionSynced),
+					"Message":    BeEmpty(),
+					"Project":    Equal("test-project"),
+					"Plugin":     Equal("splunk"),
+					"Enabled":    BeTrue(),
+					"ConfigHash": Not(BeEmpty()),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Eventually(func() ([]string, error) {
+				err := k8sClient.Get(ctx, lookupKey, plugin)
+				return plugin.Finalizers, err
+			}, timeout, interval).Should(ContainElement(controllers.ProjectPluginFinalizerName))
+
+			By("invoked the Sentry client's .Projects.UpdatePlugin method")
+			organizationSlug, projectSlug, pluginID, params := fakeSentryProjects.UpdatePluginArgsForCall(fakeSentryProjects.UpdatePluginCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(pluginID).To(Equal("splunk"))
+			Expect(params).To(Equal(sentry.UpdateProjectPluginParams{
+				"instance": "https://splunk.example.com:8088",
+				"index":    "main",
+				"token":    "8a1b6e0c-4c1b-4b3e-9b2a-5d3c7f1e9a2b",
+			}))
+
+			By("invoked the Sentry client's .Projects.EnablePlugin method")
+			organizationSlug, projectSlug, pluginID = fakeSentryProjects.EnablePluginArgsForCall(fakeSentryProjects.EnablePluginCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(pluginID).To(Equal("splunk"))
+		})
+	})
+
+	Context("when updating the Secret of a ProjectPlugin", func() {
+		BeforeEach(func() {
+			existing := testSentryProjectPlugin("splunk", true, "https://splunk.example.com:8088", "main")
+			fakeSentryProjects.GetPluginReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.UpdatePluginReturns(existing, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the ProjectPlugin's configuration gets updated successfully", func() {
+			updated := new(corev1.Secret)
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: pluginNamespace}, updated)).To(Succeed())
+
+			updated.Data["token"] = []byte("0f9e8d7c-6b5a-4f3e-2d1c-0b9a8f7e6d5c")
+			Expect(k8sClient.Update(ctx, updated)).To(Succeed())
+
+			By("invoked the Sentry client's .Projects.UpdatePlugin method")
+			Eventually(func() sentry.UpdateProjectPluginParams {
+				_, _, _, params := fakeSentryProjects.UpdatePluginArgsForCall(fakeSentryProjects.UpdatePluginCallCount() - 1)
+				return params
+			}, timeout, interval).Should(Equal(sentry.UpdateProjectPluginParams{
+				"instance": "https://splunk.example.com:8088",
+				"index":    "main",
+				"token":    "0f9e8d7c-6b5a-4f3e-2d1c-0b9a8f7e6d5c",
+			}))
+		})
+	})
+
+	Context("when updating a ProjectPlugin", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, plugin)).To(Succeed())
+
+			plugin.Spec.Config[2].ValueFrom.SecretKeyRef.Key = "missing"
+		})
+
+		It("the ProjectPlugin fails to reference a Secret key that doesn't exist", func() {
+			Expect(k8sClient.Update(ctx, plugin)).To(Succeed())
+
+			Eventually(func() (*sentryv1alpha1.ProjectPluginStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, plugin)
+				if err != nil {
+					return nil, err
+				}
+				return &plugin.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ProjectPluginConditionError),
+					"Message":   Equal("Secret test-projectplugin-splunk is missing required key missing"),
+				})),
+			)
+		})
+	})
+
+	Context("when deleting a ProjectPlugin", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, plugin)).To(Succeed())
+
+			fakeSentryProjects.DisablePluginReturns(newSentryResponse(http.StatusNoContent), nil)
+			fakeSentryProjects.ResetPluginReturns(testSentryProjectPlugin("splunk", false, "", ""), newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the ProjectPlugin gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, plugin)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, plugin)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Projects.DisablePlugin method")
+			organizationSlug, projectSlug, pluginID := fakeSentryProjects.DisablePluginArgsForCall(fakeSentryProjects.DisablePluginCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(pluginID).To(Equal("splunk"))
+
+			By("invoked the Sentry client's .Projects.ResetPlugin method")
+			organizationSlug, projectSlug, pluginID = fakeSentryProjects.ResetPluginArgsForCall(fakeSentryProjects.ResetPluginCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal("test-project"))
+			Expect(pluginID).To(Equal("splunk"))
+		})
+	})
+})
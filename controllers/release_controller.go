@@ -0,0 +1,390 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	ReleaseFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/release"
+)
+
+// ReleaseReconciler reconciles a Release object
+type ReleaseReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *ReleaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.Release{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=releases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=releases/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *ReleaseReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("release", req.NamespacedName)
+
+	var release sentryv1alpha1.Release
+	if err := r.Get(ctx, req.NamespacedName, &release); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch Release")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &release, ReleaseFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &release, err)
+	}
+
+	hasFinalizer := containsFinalizer(release.GetFinalizers(), ReleaseFinalizerName)
+
+	// Create our Sentry release if we have not been synced before
+	if release.Status.LastSynced.IsZero() {
+		if err := r.handleCreate(ctx, sc, &release, hasFinalizer); err != nil {
+			log.Error(err, "failed to create Release")
+			return ctrl.Result{}, r.handleError(ctx, &release, err)
+		}
+
+		log.Info("successfully created Release")
+		return ctrl.Result{}, nil
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, err := r.getExistingState(sc, release)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry release state")
+		return ctrl.Result{}, r.handleError(ctx, &release, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !release.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &release, existing); err != nil {
+				log.Error(err, "failed to delete Release")
+				return ctrl.Result{}, r.handleError(ctx, &release, err)
+			}
+		}
+
+		log.Info("successfully deleted Release")
+		return ctrl.Result{}, nil
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		if err := r.handleCreate(ctx, sc, &release, hasFinalizer); err != nil {
+			log.Error(err, "failed to recreate Release")
+			return ctrl.Result{}, r.handleError(ctx, &release, err)
+		}
+
+		log.Info("successfully recreated Release")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	if err := r.handleUpdate(ctx, sc, &release, existing); err != nil {
+		log.Error(err, "failed to update Release")
+		return ctrl.Result{}, r.handleError(ctx, &release, err)
+	}
+
+	log.Info("successfully updated Release")
+
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant version, and
+// returns an ErrOutOfSync error if the resource cannot be found.
+func (r *ReleaseReconciler) getExistingState(sc *Sentry, release sentryv1alpha1.Release) (*sentry.Release, error) {
+	sRelease, resp, err := sc.Client.Releases.Get(sc.Organization, release.Status.Version)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our organization
+			return nil, err
+		}
+	}
+
+	return sRelease, nil
+}
+
+// handleCreate creates our Sentry release. Sentry adds our projects to the release if one with the same version already
+// exists in the organization, so existing releases don't need to be adopted explicitly.
+func (r *ReleaseReconciler) handleCreate(ctx context.Context, sc *Sentry, release *sentryv1alpha1.Release, hasFinalizer bool) error {
+	params := &sentry.CreateReleaseParams{
+		Projects: release.Spec.Projects,
+		Ref:      release.Spec.Ref,
+		Refs:     releaseRefs(release.Spec.Refs),
+		URL:      release.Spec.URL,
+		Version:  release.Spec.Version,
+	}
+	if release.Spec.Finalized {
+		now := time.Now()
+		params.DateReleased = &now
+	}
+
+	sRelease, resp, err := sc.Client.Releases.Create(sc.Organization, params)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return err
+		}
+	}
+
+	r.setStatus(release, sRelease)
+	if err := r.Status().Update(ctx, release); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		release.SetFinalizers(append(release.GetFinalizers(), ReleaseFinalizerName))
+		if err := r.Update(ctx, release); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *ReleaseReconciler) handleDelete(ctx context.Context, sc *Sentry, release *sentryv1alpha1.Release, existing *sentry.Release) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below. Releases are only deleted
+	// from the Sentry organization if our deletion policy says so.
+	if existing != nil && release.Spec.DeletionPolicy == sentryv1alpha1.ReleaseDeletionPolicyDelete {
+		resp, err := sc.Client.Releases.Delete(sc.Organization, existing.Version)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	release.SetFinalizers(removeFinalizer(release.GetFinalizers(), ReleaseFinalizerName))
+	if err := r.Update(ctx, release); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *ReleaseReconciler) handleUpdate(ctx context.Context, sc *Sentry, release *sentryv1alpha1.Release, existing *sentry.Release) error {
+	// Error if our spec's version doesn't match reality as Sentry identifies releases by their version
+	if release.Spec.Version != existing.Version {
+		return fmt.Errorf("%w: Release's version could not be updated", ErrOutOfSync)
+	}
+
+	sRelease := existing
+
+	// Sentry doesn't allow removing projects from a release, but creating the release again adds any missing projects
+	if !releaseHasProjects(existing, release.Spec.Projects) {
+		var resp *sentry.Response
+		var err error
+		sRelease, resp, err = sc.Client.Releases.Create(sc.Organization, &sentry.CreateReleaseParams{
+			Projects: release.Spec.Projects,
+			Version:  release.Spec.Version,
+		})
+		if err != nil {
+			return releaseError(resp, err)
+		}
+	}
+
+	if sRelease.Ref != release.Spec.Ref || sRelease.URL != release.Spec.URL {
+		var resp *sentry.Response
+		var err error
+		sRelease, resp, err = sc.Client.Releases.Update(sc.Organization, existing.Version, &sentry.UpdateReleaseParams{
+			Ref: release.Spec.Ref,
+			URL: release.Spec.URL,
+		})
+		if err != nil {
+			return releaseError(resp, err)
+		}
+	}
+
+	if len(release.Spec.Refs) > 0 {
+		var resp *sentry.Response
+		var err error
+		sRelease, resp, err = sc.Client.Releases.SetCommits(sc.Organization, existing.Version, &sentry.SetReleaseCommitsParams{
+			Refs: releaseRefs(release.Spec.Refs),
+		})
+		if err != nil {
+			return releaseError(resp, err)
+		}
+	}
+
+	// Releases are never unfinalized, as Sentry doesn't allow clearing the release date
+	if release.Spec.Finalized && sRelease.DateReleased == nil {
+		var resp *sentry.Response
+		var err error
+		sRelease, resp, err = sc.Client.Releases.Finalize(sc.Organization, existing.Version, time.Now())
+		if err != nil {
+			return releaseError(resp, err)
+		}
+	}
+
+	r.setStatus(release, sRelease)
+	if err := r.Status().Update(ctx, release); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// setStatus annotates our Custom Resource status with the state of the given Sentry release.
+func (r *ReleaseReconciler) setStatus(release *sentryv1alpha1.Release, sRelease *sentry.Release) {
+	release.Status.Condition = sentryv1alpha1.ReleaseConditionCreated
+	release.Status.DateReleased = nil
+	if sRelease.DateReleased != nil {
+		release.Status.Condition = sentryv1alpha1.ReleaseConditionFinalized
+		release.Status.DateReleased = &metav1.Time{Time: *sRelease.DateReleased}
+	}
+
+	release.Status.Message = ""
+	release.Status.Version = sRelease.Version
+	release.Status.LastSynced = &metav1.Time{Time: time.Now()}
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *ReleaseReconciler) handleError(ctx context.Context, release *sentryv1alpha1.Release, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(release, corev1.EventTypeNormal, "DryRun", de.Error())
+		release.Status.Condition = sentryv1alpha1.ReleaseConditionPlanned
+		release.Status.Message = de.Error()
+		return r.Status().Update(ctx, release)
+	}
+
+	release.Status.Condition = sentryv1alpha1.ReleaseConditionError
+	release.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, release); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// releaseError wraps errors from mutating requests against an existing Sentry release, retrying on 5XX and 404 errors.
+func releaseError(resp *sentry.Response, err error) error {
+	switch {
+	case resp.StatusCode >= 500:
+		return retryableError{err}
+	case resp.StatusCode == http.StatusNotFound:
+		// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+		return retryableError{err}
+	default:
+		// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+		return err
+	}
+}
+
+// releaseHasProjects returns whether the Sentry release belongs to all of the given projects.
+func releaseHasProjects(sRelease *sentry.Release, projects []string) bool {
+	current := make(map[string]bool)
+	for _, project := range sRelease.Projects {
+		current[project.Slug] = true
+	}
+
+	for _, project := range projects {
+		if !current[project] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func releaseRefs(refs []sentryv1alpha1.ReleaseRef) []sentry.ReleaseRef {
+	var sRefs []sentry.ReleaseRef
+	for _, ref := range refs {
+		sRefs = append(sRefs, sentry.ReleaseRef{
+			Commit:         ref.Commit,
+			PreviousCommit: ref.PreviousCommit,
+			Repository:     ref.Repository,
+		})
+	}
+
+	return sRefs
+}
@@ -0,0 +1,187 @@
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("ReleaseReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		releaseName      = "test-release"
+		releaseNamespace = "test-release-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		release   *sentryv1alpha1.Release
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.Release{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "Release",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      releaseName,
+			Namespace: releaseNamespace,
+		},
+		Spec: sentryv1alpha1.ReleaseSpec{
+			Version:  "1.0.0",
+			Projects: []string{"test-project"},
+			Ref:      "a1b2c3d4",
+			Refs: []sentryv1alpha1.ReleaseRef{
+				{
+					Repository: "organization/repository",
+					Commit:     "a1b2c3d4",
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: releaseName, Namespace: releaseNamespace}
+		release = new(sentryv1alpha1.Release)
+	})
+
+	Context("when creating a Release", func() {
+		BeforeEach(func() {
+			created := testSentryRelease(request.Spec.Version, request.Spec.Projects...)
+			created.Ref = request.Spec.Ref
+			fakeSentryReleases.CreateReturns(created, newSentryResponse(http.StatusCreated), nil)
+		})
+
+		It("the Release gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.ReleaseStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, release)
+				if err != nil {
+					return nil, err
+				}
+				return &release.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition":    Equal(sentryv1alpha1.ReleaseConditionCreated),
+					"Message":      BeEmpty(),
+					"Version":      Equal("1.0.0"),
+					"DateReleased": BeNil(),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(release.Finalizers).To(ContainElement(controllers.ReleaseFinalizerName))
+
+			By("invoked the Sentry client's .Releases.Create method")
+			organizationSlug, params := fakeSentryReleases.CreateArgsForCall(fakeSentryReleases.CreateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(params).To(Equal(&sentry.CreateReleaseParams{
+				Projects: []string{"test-project"},
+				Ref:      "a1b2c3d4",
+				Refs: []sentry.ReleaseRef{
+					{
+						Commit:     "a1b2c3d4",
+						Repository: "organization/repository",
+					},
+				},
+				Version: "1.0.0",
+			}))
+		})
+	})
+
+	Context("when updating a Release", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, release)).To(Succeed())
+
+			existing := testSentryRelease(release.Spec.Version, release.Spec.Projects...)
+			existing.Ref = release.Spec.Ref
+			fakeSentryReleases.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryReleases.SetCommitsReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+			dateReleased := time.Now()
+			finalized := testSentryRelease(release.Spec.Version, release.Spec.Projects...)
+			finalized.DateReleased = &dateReleased
+			fakeSentryReleases.FinalizeReturns(finalized, newSentryResponse(http.StatusOK), nil)
+
+			release.Spec.Finalized = true
+			release.Spec.DeletionPolicy = sentryv1alpha1.ReleaseDeletionPolicyDelete
+		})
+
+		It("the Release gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, release)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.ReleaseStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, release)
+				if err != nil {
+					return nil, err
+				}
+				return &release.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition":    Equal(sentryv1alpha1.ReleaseConditionFinalized),
+					"Message":      BeEmpty(),
+					"Version":      Equal("1.0.0"),
+					"DateReleased": Not(BeNil()),
+				})),
+			)
+
+			By("invoked the Sentry client's .Releases.SetCommits method")
+			organizationSlug, version, params := fakeSentryReleases.SetCommitsArgsForCall(fakeSentryReleases.SetCommitsCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(version).To(Equal("1.0.0"))
+			Expect(params).To(Equal(&sentry.SetReleaseCommitsParams{
+				Refs: []sentry.ReleaseRef{
+					{
+						Commit:     "a1b2c3d4",
+						Repository: "organization/repository",
+					},
+				},
+			}))
+
+			By("invoked the Sentry client's .Releases.Finalize method")
+			organizationSlug, version, _ = fakeSentryReleases.FinalizeArgsForCall(fakeSentryReleases.FinalizeCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(version).To(Equal("1.0.0"))
+		})
+	})
+
+	Context("when deleting a Release", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, release)).To(Succeed())
+
+			existing := testSentryRelease(release.Spec.Version, release.Spec.Projects...)
+			fakeSentryReleases.GetReturns(existing, newSentryResponse(http.StatusOK), nil)
+			fakeSentryReleases.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the Release gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, release)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, release)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Releases.Delete method")
+			organizationSlug, version := fakeSentryReleases.DeleteArgsForCall(fakeSentryReleases.DeleteCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(version).To(Equal("1.0.0"))
+		})
+	})
+})
@@ -0,0 +1,398 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	SavedSearchFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/savedsearch"
+)
+
+// SavedSearchReconciler reconciles a SavedSearch object
+type SavedSearchReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *SavedSearchReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.SavedSearch{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=savedsearches,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=savedsearches/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *SavedSearchReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("savedsearch", req.NamespacedName)
+
+	var search sentryv1alpha1.SavedSearch
+	if err := r.Get(ctx, req.NamespacedName, &search); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch SavedSearch")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &search, SavedSearchFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &search, err)
+	}
+
+	hasFinalizer := containsFinalizer(search.GetFinalizers(), SavedSearchFinalizerName)
+
+	// Create our Sentry resource if we have not been synced before, unless we've been asked to adopt an existing Sentry
+	// resource
+	adoptID, adopt := search.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if search.Status.LastSynced.IsZero() && !adopt {
+		if err := r.handleCreate(ctx, sc, &search, hasFinalizer); err != nil {
+			log.Error(err, "failed to create SavedSearch")
+			return ctrl.Result{}, r.handleError(ctx, &search, err)
+		}
+
+		log.Info("successfully created SavedSearch")
+		return ctrl.Result{}, nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if search.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &search, SavedSearchFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt SavedSearch")
+			return ctrl.Result{}, r.handleError(ctx, &search, err)
+		}
+
+		search.Status.ID = adoptID
+		search.Status.Project = search.Spec.Project
+		log.Info("adopting existing Sentry saved search", "id", adoptID)
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, err := r.getExistingState(sc, search)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry saved search state")
+		return ctrl.Result{}, r.handleError(ctx, &search, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !search.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &search, existing); err != nil {
+				log.Error(err, "failed to delete SavedSearch")
+				return ctrl.Result{}, r.handleError(ctx, &search, err)
+			}
+		}
+
+		log.Info("successfully deleted SavedSearch")
+		return ctrl.Result{}, nil
+	}
+
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && search.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry saved search %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt SavedSearch")
+		return ctrl.Result{}, r.handleError(ctx, &search, err)
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		if err := r.handleCreate(ctx, sc, &search, hasFinalizer); err != nil {
+			log.Error(err, "failed to recreate SavedSearch")
+			return ctrl.Result{}, r.handleError(ctx, &search, err)
+		}
+
+		log.Info("successfully recreated SavedSearch")
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	if err := r.handleUpdate(ctx, sc, &search, existing); err != nil {
+		log.Error(err, "failed to update SavedSearch")
+		return ctrl.Result{}, r.handleError(ctx, &search, err)
+	}
+
+	log.Info("successfully updated SavedSearch")
+
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
+// returns an ErrOutOfSync error if the resource cannot be found. Sentry doesn't allow saved searches to be fetched
+// individually, so they are looked up from the saved searches of our organization or project.
+func (r *SavedSearchReconciler) getExistingState(sc *Sentry, search sentryv1alpha1.SavedSearch) (*sentry.SavedSearch, error) {
+	var (
+		sSearches []sentry.SavedSearch
+		resp      *sentry.Response
+		err       error
+	)
+
+	if search.Status.Project != "" {
+		sSearches, resp, err = sc.Client.Projects.ListSearches(sc.Organization, search.Status.Project)
+	} else {
+		sSearches, resp, err = sc.Client.SavedSearches.List(sc.Organization)
+	}
+
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, ErrOutOfSync
+		case resp.StatusCode == http.StatusFound:
+			return nil, ErrOutOfSync
+		default:
+			// Don't retry on other 4XX errors as these indicate that there might be an issue with our spec
+			return nil, err
+		}
+	}
+
+	for idx, sSearch := range sSearches {
+		if sSearch.ID == search.Status.ID {
+			return &sSearches[idx], nil
+		}
+	}
+
+	return nil, ErrOutOfSync
+}
+
+func (r *SavedSearchReconciler) handleCreate(ctx context.Context, sc *Sentry, search *sentryv1alpha1.SavedSearch, hasFinalizer bool) error {
+	var (
+		sSearch *sentry.SavedSearch
+		resp    *sentry.Response
+		err     error
+	)
+
+	params := &sentry.CreateSavedSearchParams{
+		Name:  search.Spec.Name,
+		Query: search.Spec.Query,
+		Sort:  savedSearchSort(search.Spec.Sort),
+	}
+
+	if search.Spec.Project != "" {
+		sSearch, resp, err = sc.Client.Projects.CreateSearch(sc.Organization, search.Spec.Project, params)
+	} else {
+		// Share the saved search with the whole organization, rather than only the user that our token belongs to
+		params.Type = sentry.Int(sentry.SavedSearchTypeIssue)
+		params.Visibility = "organization"
+		sSearch, resp, err = sc.Client.SavedSearches.Create(sc.Organization, params)
+	}
+
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		case resp.StatusCode == http.StatusFound:
+			// Retry on 302 errors as the error might get resolved once dependencies are satisfied
+			return retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return err
+		}
+	}
+
+	search.Status.Condition = sentryv1alpha1.SavedSearchConditionCreated
+	search.Status.Message = ""
+	search.Status.ID = sSearch.ID
+	search.Status.Project = search.Spec.Project
+	search.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, search); err != nil {
+		return retryableError{err}
+	}
+
+	if !hasFinalizer {
+		search.SetFinalizers(append(search.GetFinalizers(), SavedSearchFinalizerName))
+		if err := r.Update(ctx, search); err != nil {
+			return retryableError{err}
+		}
+	}
+
+	return nil
+}
+
+func (r *SavedSearchReconciler) handleDelete(ctx context.Context, sc *Sentry, search *sentryv1alpha1.SavedSearch, existing *sentry.SavedSearch) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below
+	if existing != nil {
+		var (
+			resp *sentry.Response
+			err  error
+		)
+
+		if search.Status.Project != "" {
+			resp, err = sc.Client.Projects.DeleteSearch(sc.Organization, search.Status.Project, existing.ID)
+		} else {
+			resp, err = sc.Client.SavedSearches.Delete(sc.Organization, existing.ID)
+		}
+
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			case resp.StatusCode == http.StatusFound:
+				// Ignore 302 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	search.SetFinalizers(removeFinalizer(search.GetFinalizers(), SavedSearchFinalizerName))
+	if err := r.Update(ctx, search); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *SavedSearchReconciler) handleUpdate(ctx context.Context, sc *Sentry, search *sentryv1alpha1.SavedSearch, existing *sentry.SavedSearch) error {
+	// Error if our spec's project doesn't match the one our saved search is scoped to, as moving a saved search between
+	// projects or organizations is not a valid operation
+	if search.Spec.Project != search.Status.Project {
+		return fmt.Errorf("%w: SavedSearch's project could not be updated", ErrOutOfSync)
+	}
+
+	sort := savedSearchSort(search.Spec.Sort)
+	if search.Spec.Name != existing.Name || search.Spec.Query != existing.Query || sort != existing.Sort {
+		var (
+			resp *sentry.Response
+			err  error
+		)
+
+		params := &sentry.UpdateSavedSearchParams{
+			Name:  search.Spec.Name,
+			Query: search.Spec.Query,
+			Sort:  sort,
+		}
+
+		if search.Status.Project != "" {
+			_, resp, err = sc.Client.Projects.UpdateSearch(sc.Organization, search.Status.Project, existing.ID, params)
+		} else {
+			params.Type = sentry.Int(sentry.SavedSearchTypeIssue)
+			params.Visibility = existing.Visibility
+			_, resp, err = sc.Client.SavedSearches.Update(sc.Organization, existing.ID, params)
+		}
+
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+				return retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	search.Status.Condition = sentryv1alpha1.SavedSearchConditionCreated
+	search.Status.Message = ""
+	search.Status.ID = existing.ID
+	search.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, search); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *SavedSearchReconciler) handleError(ctx context.Context, search *sentryv1alpha1.SavedSearch, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(search, corev1.EventTypeNormal, "DryRun", de.Error())
+		search.Status.Condition = sentryv1alpha1.SavedSearchConditionPlanned
+		search.Status.Message = de.Error()
+		return r.Status().Update(ctx, search)
+	}
+
+	search.Status.Condition = sentryv1alpha1.SavedSearchConditionError
+	search.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, search); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+// savedSearchSort returns the sort order of a saved search, defaulting to Sentry's default of sorting by date.
+func savedSearchSort(sort string) string {
+	if sort == "" {
+		return "date"
+	}
+	return sort
+}
@@ -0,0 +1,198 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("SavedSearchReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		searchName      = "test-savedsearch"
+		searchNamespace = "test-savedsearch-namespace"
+	)
+
+	var (
+		lookupKey types.NamespacedName
+		search    *sentryv1alpha1.SavedSearch
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.SavedSearch{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "SavedSearch",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      searchName,
+			Namespace: searchNamespace,
+		},
+		Spec: sentryv1alpha1.SavedSearchSpec{
+			Name:  "Unresolved Checkout Errors",
+			Query: "is:unresolved transaction:/checkout/*",
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: searchName, Namespace: searchNamespace}
+		search = new(sentryv1alpha1.SavedSearch)
+	})
+
+	Context("when creating a SavedSearch", func() {
+		BeforeEach(func() {
+			created := testSentrySavedSearch("12345", request.Spec.Name, request.Spec.Query, "date")
+			fakeSentrySavedSearches.CreateReturns(created, newSentryResponse(http.StatusCreated), nil)
+			fakeSentrySavedSearches.ListReturns([]sentry.SavedSearch{*created}, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the SavedSearch gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.SavedSearchStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, search)
+				if err != nil {
+					return nil, err
+				}
+				return &search.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.SavedSearchConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+					"Project":   BeEmpty(),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(search.Finalizers).To(ContainElement(controllers.SavedSearchFinalizerName))
+
+			By("invoked the Sentry client's .SavedSearches.Create method")
+			organizationSlug, params := fakeSentrySavedSearches.CreateArgsForCall(fakeSentrySavedSearches.CreateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(params).To(Equal(&sentry.CreateSavedSearchParams{
+				Name:       request.Spec.Name,
+				Query:      request.Spec.Query,
+				Sort:       "date",
+				Type:       sentry.Int(sentry.SavedSearchTypeIssue),
+				Visibility: "organization",
+			}))
+		})
+	})
+
+	Context("when updating a SavedSearch", func() {
+		var (
+			existing *sentry.SavedSearch
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, search)).To(Succeed())
+
+			existing = testSentrySavedSearch("12345", search.Spec.Name, search.Spec.Query, "date")
+			fakeSentrySavedSearches.ListReturns([]sentry.SavedSearch{*existing}, newSentryResponse(http.StatusOK), nil)
+
+			search.Spec.Sort = "freq"
+
+			updated := testSentrySavedSearch("12345", search.Spec.Name, search.Spec.Query, "freq")
+			fakeSentrySavedSearches.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the SavedSearch gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, search)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.SavedSearchStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, search)
+				if err != nil {
+					return nil, err
+				}
+				return &search.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.SavedSearchConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+				})),
+			)
+
+			By("invoked the Sentry client's .SavedSearches.Update method")
+			organizationSlug, searchID, params := fakeSentrySavedSearches.UpdateArgsForCall(fakeSentrySavedSearches.UpdateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(searchID).To(Equal(existing.ID))
+			Expect(params).To(Equal(&sentry.UpdateSavedSearchParams{
+				Name:       search.Spec.Name,
+				Query:      search.Spec.Query,
+				Sort:       "freq",
+				Type:       sentry.Int(sentry.SavedSearchTypeIssue),
+				Visibility: "organization",
+			}))
+		})
+	})
+
+	Context("when deleting a SavedSearch", func() {
+		var (
+			existing *sentry.SavedSearch
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, search)).To(Succeed())
+
+			existing = testSentrySavedSearch("12345", search.Spec.Name, search.Spec.Query, "freq")
+			fakeSentrySavedSearches.ListReturns([]sentry.SavedSearch{*existing}, newSentryResponse(http.StatusOK), nil)
+			fakeSentrySavedSearches.DeleteReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the SavedSearch gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, search)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, search)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .SavedSearches.Delete method")
+			organizationSlug, searchID := fakeSentrySavedSearches.DeleteArgsForCall(fakeSentrySavedSearches.DeleteCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(searchID).To(Equal(existing.ID))
+		})
+	})
+})
@@ -0,0 +1,169 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+)
+
+// SentryCredentialsReconciler reconciles a SentryCredentials object
+type SentryCredentialsReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	Sentry *Sentry
+}
+
+func (r *SentryCredentialsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.SentryCredentials{}).
+		// Verify our SentryCredentials again whenever their Secret changes, such as when their token gets rotated
+		Watches(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.credentialsForSecret),
+		}).
+		WithEventFilter(&referenceChangedPredicate{}).
+		Complete(r)
+}
+
+// credentialsForSecret maps a Secret to the SentryCredentials in its namespace that reference it.
+func (r *SentryCredentialsReconciler) credentialsForSecret(obj handler.MapObject) []reconcile.Request {
+	var credentialsList sentryv1alpha1.SentryCredentialsList
+	if err := r.List(context.Background(), &credentialsList, client.InNamespace(obj.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list SentryCredentials", "secret", obj.Meta.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, credentials := range credentialsList.Items {
+		if credentials.Spec.SecretRef.Name == obj.Meta.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: credentials.Namespace, Name: credentials.Name},
+			})
+		}
+	}
+
+	return requests
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=sentrycredentials,verbs=get;list;watch
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=sentrycredentials/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+func (r *SentryCredentialsReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("sentrycredentials", req.NamespacedName)
+
+	var credentials sentryv1alpha1.SentryCredentials
+	if err := r.Get(ctx, req.NamespacedName, &credentials); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch SentryCredentials")
+		return ctrl.Result{}, err
+	}
+
+	// Nothing exists in Sentry for our resource, so there's nothing to clean up on deletion
+	if !credentials.ObjectMeta.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.handleVerify(ctx, &credentials); err != nil {
+		log.Error(err, "failed to verify SentryCredentials")
+		return ctrl.Result{}, r.handleError(ctx, &credentials, err)
+	}
+
+	log.Info("successfully verified SentryCredentials")
+
+	return ctrl.Result{}, nil
+}
+
+// handleVerify checks that the Sentry credentials can be used to access their Sentry organization.
+func (r *SentryCredentialsReconciler) handleVerify(ctx context.Context, credentials *sentryv1alpha1.SentryCredentials) error {
+	if r.Sentry.Credentials == nil || !r.Sentry.Credentials.Namespaced {
+		return errors.New("namespace-scoped Sentry credentials are not enabled")
+	}
+
+	sc, err := r.Sentry.fromCredentials(ctx, r, credentials)
+	if err != nil {
+		return err
+	}
+
+	organization, resp, err := sc.Client.Organizations.Get(sc.Organization)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return retryableError{err}
+		default:
+			// Don't retry on 4XX errors as these indicate that there might be an issue with our credentials
+			return err
+		}
+	}
+
+	credentials.Status.Condition = sentryv1alpha1.SentryCredentialsConditionVerified
+	credentials.Status.Message = ""
+	credentials.Status.OrganizationID = organization.ID
+	credentials.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, credentials); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key.
+func (r *SentryCredentialsReconciler) handleError(ctx context.Context, credentials *sentryv1alpha1.SentryCredentials, err error) error {
+	credentials.Status.Condition = sentryv1alpha1.SentryCredentialsConditionError
+	credentials.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, credentials); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
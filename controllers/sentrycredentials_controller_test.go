@@ -0,0 +1,198 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("SentryCredentialsReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		credentialsName      = "test-sentrycredentials"
+		credentialsNamespace = "test-sentrycredentials-namespace"
+	)
+
+	var (
+		lookupKey   types.NamespacedName
+		credentials *sentryv1alpha1.SentryCredentials
+	)
+
+	ctx := context.Background()
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-sentrycredentials-secret",
+			Namespace: credentialsNamespace,
+		},
+		Data: map[string][]byte{
+			"SENTRY_ORGANIZATION": []byte("test-organization"),
+			"SENTRY_TOKEN":        []byte("test-token"),
+		},
+	}
+
+	request := &sentryv1alpha1.SentryCredentials{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "SentryCredentials",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      credentialsName,
+			Namespace: credentialsNamespace,
+		},
+		Spec: sentryv1alpha1.SentryCredentialsSpec{
+			SecretRef: corev1.LocalObjectReference{
+				Name: "test-sentrycredentials-secret",
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: credentialsName, Namespace: credentialsNamespace}
+		credentials = new(sentryv1alpha1.SentryCredentials)
+	})
+
+	Context("when creating SentryCredentials", func() {
+		BeforeEach(func() {
+			organization := &sentry.Organization{
+				ID:   "12345",
+				Name: "test-organization",
+				Slug: "test-organization",
+			}
+			fakeSentryOrganizations.GetReturns(organization, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the SentryCredentials get verified successfully", func() {
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.SentryCredentialsStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, credentials)
+				if err != nil {
+					return nil, err
+				}
+				return &credentials.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition":      Equal(sentryv1alpha1.SentryCredentialsConditionVerified),
+					"Message":        BeEmpty(),
+					"OrganizationID": Equal("12345"),
+				})),
+			)
+
+			By("invoked the Sentry client's .Organizations.Get method")
+			organizationSlug := fakeSentryOrganizations.GetArgsForCall(fakeSentryOrganizations.GetCallCount() - 1)
+			Expect(organizationSlug).To(Equal("test-organization"))
+		})
+	})
+
+	Context("when updating SentryCredentials", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, credentials)).To(Succeed())
+
+			credentials.Spec.SecretRef.Name = "test-sentrycredentials-secret-update"
+			fakeSentryOrganizations.GetReturns(nil, newSentryResponse(http.StatusUnauthorized), errors.New("an error occurred"))
+
+			secret := secret.DeepCopy()
+			secret.ObjectMeta = metav1.ObjectMeta{
+				Name:      "test-sentrycredentials-secret-update",
+				Namespace: credentialsNamespace,
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		})
+
+		It("the SentryCredentials get verified unsuccessfully", func() {
+			Expect(k8sClient.Update(ctx, credentials)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.SentryCredentialsStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, credentials)
+				if err != nil {
+					return nil, err
+				}
+				return &credentials.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.SentryCredentialsConditionError),
+					"Message":   Equal("an error occurred"),
+				})),
+			)
+
+			By("with the desired spec")
+			Expect(credentials.Spec).To(Equal(sentryv1alpha1.SentryCredentialsSpec{
+				SecretRef: corev1.LocalObjectReference{
+					Name: "test-sentrycredentials-secret-update",
+				},
+			}))
+		})
+	})
+
+	Context("when updating the Secret of SentryCredentials", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, credentials)).To(Succeed())
+
+			organization := &sentry.Organization{
+				ID:   "12345",
+				Name: "test-organization",
+				Slug: "test-organization",
+			}
+			fakeSentryOrganizations.GetReturns(organization, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the SentryCredentials get verified again successfully", func() {
+			secret := new(corev1.Secret)
+			secretLookupKey := types.NamespacedName{Name: credentials.Spec.SecretRef.Name, Namespace: credentialsNamespace}
+			Expect(k8sClient.Get(ctx, secretLookupKey, secret)).To(Succeed())
+
+			secret.Data["SENTRY_TOKEN"] = []byte("test-token-rotated")
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.SentryCredentialsStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, credentials)
+				if err != nil {
+					return nil, err
+				}
+				return &credentials.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition":      Equal(sentryv1alpha1.SentryCredentialsConditionVerified),
+					"Message":        BeEmpty(),
+					"OrganizationID": Equal("12345"),
+				})),
+			)
+		})
+	})
+
+	Context("when deleting SentryCredentials", func() {
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, credentials)).To(Succeed())
+		})
+
+		It("the SentryCredentials get deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, credentials)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, credentials)
+			}, timeout, interval).ShouldNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,481 @@
+/*
+
+MIT License
+
+Copyright (c) 2020 Jace Tan
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+const (
+	ServiceHookFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/servicehook"
+)
+
+// ServiceHookReconciler reconciles a ServiceHook object
+type ServiceHookReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
+}
+
+func (r *ServiceHookReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&sentryv1alpha1.ServiceHook{}).
+		Owns(&corev1.Secret{}).
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=servicehooks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=servicehooks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+func (r *ServiceHookReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("servicehook", req.NamespacedName)
+
+	var servicehook sentryv1alpha1.ServiceHook
+	if err := r.Get(ctx, req.NamespacedName, &servicehook); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to fetch ServiceHook")
+		return ctrl.Result{}, err
+	}
+
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &servicehook, ServiceHookFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+	}
+
+	hasFinalizer := containsFinalizer(servicehook.GetFinalizers(), ServiceHookFinalizerName)
+
+	// Create our Sentry resource and secret if we have not been synced before, unless we've been asked to adopt an
+	// existing Sentry resource
+	adoptID, adopt := servicehook.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if servicehook.Status.LastSynced.IsZero() && !adopt {
+		sServiceHook, err := r.handleCreate(ctx, sc, &servicehook, hasFinalizer)
+		if err != nil {
+			log.Error(err, "failed to create ServiceHook")
+			return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+		}
+
+		if err := r.reconcileSecret(ctx, &servicehook, sServiceHook); err != nil {
+			log.Error(err, "failed to create Secret for ServiceHook")
+			return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+		}
+
+		log.Info("successfully created ServiceHook")
+		return ctrl.Result{}, nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if servicehook.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &servicehook, ServiceHookFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt ServiceHook")
+			return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+		}
+
+		servicehook.Status.ID = adoptID
+		log.Info("adopting existing Sentry service hook", "id", adoptID)
+	}
+
+	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
+	// will handle this accordingly below.
+	existing, sProject, err := r.getExistingState(sc, servicehook)
+	if err != nil && !errors.Is(err, ErrOutOfSync) {
+		log.Error(err, "failed to fetch Sentry service hook state")
+		return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+	}
+
+	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
+	if !servicehook.ObjectMeta.DeletionTimestamp.IsZero() {
+		if hasFinalizer {
+			if err := r.handleDelete(ctx, sc, &servicehook, existing, sProject); err != nil {
+				log.Error(err, "failed to delete ServiceHook")
+				return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+			}
+		}
+
+		log.Info("successfully deleted ServiceHook")
+		return ctrl.Result{}, nil
+	}
+
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && servicehook.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry service hook %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt ServiceHook")
+		return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+	}
+
+	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
+	if errors.Is(err, ErrOutOfSync) {
+		sServiceHook, err := r.handleCreate(ctx, sc, &servicehook, hasFinalizer)
+		if err != nil {
+			log.Error(err, "failed to recreate ServiceHook")
+			return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+		}
+
+		log.Info("successfully recreated ServiceHook")
+
+		// Reconcile our secret as a recreated service hook will have been issued a new signing secret
+		if err := r.reconcileSecret(ctx, &servicehook, sServiceHook); err != nil {
+			log.Error(err, "failed to reconcile Secret for ServiceHook")
+			return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+		}
+
+		log.Info("successfully reconciled Secret for ServiceHook")
+
+		return ctrl.Result{}, nil
+	}
+
+	// Reconcile any differences between our spec and the existing state of our Sentry resource
+	sServiceHook, err := r.handleUpdate(ctx, sc, &servicehook, existing, sProject)
+	if err != nil {
+		log.Error(err, "failed to update ServiceHook")
+		return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+	}
+
+	log.Info("successfully updated ServiceHook")
+
+	// Reconcile our secret to ensure that its data matches the signing secret of our Sentry service hook
+	if err := r.reconcileSecret(ctx, &servicehook, sServiceHook); err != nil {
+		log.Error(err, "failed to reconcile Secret for ServiceHook")
+		return ctrl.Result{}, r.handleError(ctx, &servicehook, err)
+	}
+
+	log.Info("successfully reconciled Secret for ServiceHook")
+
+	return ctrl.Result{}, nil
+}
+
+// getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
+// returns an ErrOutOfSync error if the resource cannot be found. It also returns our associated project, as a service
+// hook's payload doesn't reference the project that it belongs to.
+func (r *ServiceHookReconciler) getExistingState(sc *Sentry, servicehook sentryv1alpha1.ServiceHook) (*sentry.ServiceHook, *sentry.Project, error) {
+	listProjectsOpts := &sentry.ListOptions{}
+	var sProjects []sentry.Project
+	for {
+		projects, resp, err := sc.Client.Organizations.ListProjects(sc.Organization, listProjectsOpts)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return nil, nil, retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that there might be an issue with our organization
+				return nil, nil, err
+			}
+		}
+
+		sProjects = append(sProjects, projects...)
+		if !resp.NextPage.Results {
+			break
+		}
+		listProjectsOpts.Cursor = resp.NextPage.Cursor
+	}
+
+	var sProject *sentry.Project
+	for idx, project := range sProjects {
+		if project.ID == servicehook.Status.ProjectID {
+			sProject = &sProjects[idx]
+			break
+		}
+
+		// Fall back to our spec's project if we are adopting an existing Sentry service hook, as we won't know the ID
+		// of its project yet
+		if servicehook.Status.ProjectID == "" && project.Slug == servicehook.Spec.Project {
+			sProject = &sProjects[idx]
+			break
+		}
+	}
+
+	if sProject == nil {
+		return nil, nil, ErrOutOfSync
+	}
+
+	listHooksOpts := &sentry.ListOptions{}
+	var sServiceHooks []sentry.ServiceHook
+	for {
+		hooks, resp, err := sc.Client.Projects.ListHooks(sc.Organization, sProject.Slug, listHooksOpts)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return nil, nil, retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				return nil, nil, ErrOutOfSync
+			case resp.StatusCode == http.StatusFound:
+				return nil, nil, ErrOutOfSync
+			default:
+				// Don't retry on other 4XX errors as these indicate that there might be an issue with our spec
+				return nil, nil, err
+			}
+		}
+
+		sServiceHooks = append(sServiceHooks, hooks...)
+		if !resp.NextPage.Results {
+			break
+		}
+		listHooksOpts.Cursor = resp.NextPage.Cursor
+	}
+
+	for idx, sServiceHook := range sServiceHooks {
+		if sServiceHook.ID == servicehook.Status.ID {
+			return &sServiceHooks[idx], sProject, nil
+		}
+	}
+
+	return nil, nil, ErrOutOfSync
+}
+
+func (r *ServiceHookReconciler) handleCreate(ctx context.Context, sc *Sentry, servicehook *sentryv1alpha1.ServiceHook, hasFinalizer bool) (*sentry.ServiceHook, error) {
+	// Resolve our spec's project up front, as we need to keep track of its ID in our status
+	sProject, resp, err := sc.Client.Projects.Get(sc.Organization, servicehook.Spec.Project)
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusFound:
+			// Retry on 302 errors as the error might get resolved once dependencies are satisfied
+			return nil, retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return nil, err
+		}
+	}
+
+	sServiceHook, resp, err := sc.Client.Projects.CreateHook(sc.Organization, sProject.Slug, &sentry.CreateServiceHookParams{
+		Events: serviceHookEvents(servicehook.Spec.Events),
+		URL:    servicehook.Spec.URL,
+	})
+	if err != nil {
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+			return nil, retryableError{err}
+		default:
+			// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+			return nil, err
+		}
+	}
+
+	servicehook.Status.Condition = sentryv1alpha1.ServiceHookConditionCreated
+	servicehook.Status.Message = ""
+	servicehook.Status.ID = sServiceHook.ID
+	servicehook.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	servicehook.Status.ProjectID = sProject.ID
+	if err := r.Status().Update(ctx, servicehook); err != nil {
+		return nil, retryableError{err}
+	}
+
+	if !hasFinalizer {
+		servicehook.SetFinalizers(append(servicehook.GetFinalizers(), ServiceHookFinalizerName))
+		if err := r.Update(ctx, servicehook); err != nil {
+			return nil, retryableError{err}
+		}
+	}
+
+	return sServiceHook, nil
+}
+
+func (r *ServiceHookReconciler) reconcileSecret(ctx context.Context, servicehook *sentryv1alpha1.ServiceHook, sServiceHook *sentry.ServiceHook) error {
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("sentry-servicehook-%s", servicehook.Name),
+			Namespace:   servicehook.Namespace,
+			Labels:      make(map[string]string),
+			Annotations: make(map[string]string),
+		},
+		Data: make(map[string][]byte),
+	}
+
+	if err := ctrl.SetControllerReference(servicehook, secret, r.Scheme); err != nil {
+		return err
+	}
+
+	if _, err := ctrl.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		for k, v := range servicehook.Labels {
+			secret.Labels[k] = v
+		}
+
+		for k, v := range servicehook.Annotations {
+			secret.Annotations[k] = v
+		}
+
+		secret.Data["SENTRY_HOOK_SECRET"] = []byte(sServiceHook.Secret)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ServiceHookReconciler) handleDelete(ctx context.Context, sc *Sentry, servicehook *sentryv1alpha1.ServiceHook, existing *sentry.ServiceHook, sProject *sentry.Project) error {
+	// Our resource might no longer exist so check that it's not nil to avoid panicking below
+	if existing != nil {
+		resp, err := sc.Client.Projects.DeleteHook(sc.Organization, sProject.Slug, existing.ID)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Ignore 404 errors as our resource might have already been deleted
+			case resp.StatusCode == http.StatusFound:
+				// Ignore 302 errors as our resource might have already been deleted
+			default:
+				// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+				return err
+			}
+		}
+	}
+
+	servicehook.SetFinalizers(removeFinalizer(servicehook.GetFinalizers(), ServiceHookFinalizerName))
+	if err := r.Update(ctx, servicehook); err != nil {
+		return retryableError{err}
+	}
+
+	return nil
+}
+
+func (r *ServiceHookReconciler) handleUpdate(ctx context.Context, sc *Sentry, servicehook *sentryv1alpha1.ServiceHook, existing *sentry.ServiceHook, sProject *sentry.Project) (*sentry.ServiceHook, error) {
+	// Error if our spec's project doesn't match reality as updating a service hook's project is not a valid operation.
+	// This helps highlight configuration drift where a user forgets to update our spec's project after modifying the
+	// associated project's slug.
+	if servicehook.Spec.Project != sProject.Slug {
+		return nil, retryableError{fmt.Errorf("%w: ServiceHook's project could not be updated", ErrOutOfSync)}
+	}
+
+	sServiceHook := existing
+	events := serviceHookEvents(servicehook.Spec.Events)
+	if servicehook.Spec.URL != existing.URL || !stringSetsEqual(events, existing.Events) {
+		var (
+			resp *sentry.Response
+			err  error
+		)
+
+		sServiceHook, resp, err = sc.Client.Projects.UpdateHook(sc.Organization, sProject.Slug, existing.ID, &sentry.UpdateServiceHookParams{
+			Events: events,
+			URL:    servicehook.Spec.URL,
+		})
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return nil, retryableError{err}
+			case resp.StatusCode == http.StatusNotFound:
+				// Retry on 404 errors as the error might get resolved once dependencies are satisfied
+				return nil, retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that we might have an issue with our spec
+				return nil, err
+			}
+		}
+	}
+
+	servicehook.Status.Condition = sentryv1alpha1.ServiceHookConditionCreated
+	servicehook.Status.Message = ""
+	servicehook.Status.ID = sServiceHook.ID
+	servicehook.Status.LastSynced = &metav1.Time{Time: time.Now()}
+	servicehook.Status.ProjectID = sProject.ID
+	if err := r.Status().Update(ctx, servicehook); err != nil {
+		return nil, retryableError{err}
+	}
+
+	return sServiceHook, nil
+}
+
+// handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
+func (r *ServiceHookReconciler) handleError(ctx context.Context, servicehook *sentryv1alpha1.ServiceHook, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(servicehook, corev1.EventTypeNormal, "DryRun", de.Error())
+		servicehook.Status.Condition = sentryv1alpha1.ServiceHookConditionPlanned
+		servicehook.Status.Message = de.Error()
+		return r.Status().Update(ctx, servicehook)
+	}
+
+	servicehook.Status.Condition = sentryv1alpha1.ServiceHookConditionError
+	servicehook.Status.Message = err.Error()
+	if err := r.Status().Update(ctx, servicehook); err != nil {
+		return err
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.err
+	}
+
+	return nil
+}
+
+func serviceHookEvents(events []sentryv1alpha1.ServiceHookEvent) []string {
+	sEvents := make([]string, len(events))
+	for idx, event := range events {
+		sEvents[idx] = string(event)
+	}
+	return sEvents
+}
@@ -0,0 +1,261 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("ServiceHookReconciler", func() {
+	const (
+		timeout  = time.Second * 10
+		interval = time.Millisecond * 250
+
+		servicehookName      = "test-servicehook"
+		servicehookNamespace = "test-servicehook-namespace"
+	)
+
+	var (
+		lookupKey       types.NamespacedName
+		secretLookupKey types.NamespacedName
+
+		servicehook *sentryv1alpha1.ServiceHook
+		secret      *corev1.Secret
+	)
+
+	ctx := context.Background()
+
+	request := &sentryv1alpha1.ServiceHook{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "sentry.kubernetes.jaceys.me/v1alpha1",
+			Kind:       "ServiceHook",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      servicehookName,
+			Namespace: servicehookNamespace,
+			Labels: map[string]string{
+				"label": "test-label",
+			},
+			Annotations: map[string]string{
+				"annotation": "test-annotation",
+			},
+		},
+		Spec: sentryv1alpha1.ServiceHookSpec{
+			Project: "test-project",
+			URL:     "https://example.com/sentry-hook",
+			Events:  []sentryv1alpha1.ServiceHookEvent{sentryv1alpha1.ServiceHookEventAlert},
+		},
+	}
+
+	BeforeEach(func() {
+		lookupKey = types.NamespacedName{Name: servicehookName, Namespace: servicehookNamespace}
+		secretLookupKey = types.NamespacedName{Name: fmt.Sprintf("sentry-servicehook-%s", servicehookName), Namespace: servicehookNamespace}
+
+		servicehook = new(sentryv1alpha1.ServiceHook)
+		secret = new(corev1.Secret)
+	})
+
+	Context("when creating a ServiceHook", func() {
+		var (
+			created *sentry.ServiceHook
+		)
+
+		BeforeEach(func() {
+			project := testSentryProject("0", "test-team", request.Spec.Project)
+			fakeSentryProjects.GetReturns(project, newSentryResponse(http.StatusOK), nil)
+			fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*project}, newSentryResponse(http.StatusOK), nil)
+
+			created = testSentryServiceHook("12345", request.Spec.URL, "test-secret", "event.alert")
+			fakeSentryProjects.CreateHookReturns(created, newSentryResponse(http.StatusCreated), nil)
+			fakeSentryProjects.ListHooksReturns([]sentry.ServiceHook{*created}, newSentryResponse(http.StatusOK), nil)
+		})
+
+		It("the ServiceHook gets created successfully", func() {
+			Expect(k8sClient.Create(ctx, request)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.ServiceHookStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, servicehook)
+				if err != nil {
+					return nil, err
+				}
+				return &servicehook.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ServiceHookConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+					"ProjectID": Equal("0"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(servicehook.Finalizers).To(ContainElement(controllers.ServiceHookFinalizerName))
+
+			By("invoked the Sentry client's .Projects.CreateHook method")
+			organization, project, params := fakeSentryProjects.CreateHookArgsForCall(fakeSentryProjects.CreateHookCallCount() - 1)
+			Expect(organization).To(Equal("organization"))
+			Expect(project).To(Equal(request.Spec.Project))
+			Expect(params).To(Equal(&sentry.CreateServiceHookParams{
+				Events: []string{"event.alert"},
+				URL:    request.Spec.URL,
+			}))
+		})
+
+		It("the Secret gets created successfully", func() {
+			Eventually(func() (map[string][]byte, error) {
+				err := k8sClient.Get(ctx, secretLookupKey, secret)
+				if err != nil {
+					return nil, err
+				}
+				return secret.Data, nil
+			}, timeout, interval).Should(HaveKeyWithValue("SENTRY_HOOK_SECRET", []byte(created.Secret)))
+
+			By("with the desired labels and annotations")
+			Expect(secret.Labels).To(HaveKeyWithValue("label", "test-label"))
+			Expect(secret.Annotations).To(HaveKeyWithValue("annotation", "test-annotation"))
+
+			By("with the expected owner reference")
+			Expect(secret.ObjectMeta.OwnerReferences).To(
+				ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"APIVersion": Equal("sentry.kubernetes.jaceys.me/v1alpha1"),
+						"Kind":       Equal("ServiceHook"),
+						"Name":       Equal(request.GetName()),
+						"UID":        Equal(request.GetUID()),
+					}),
+				),
+			)
+		})
+	})
+
+	Context("when updating a ServiceHook", func() {
+		var (
+			project  *sentry.Project
+			existing *sentry.ServiceHook
+			updated  *sentry.ServiceHook
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, servicehook)).To(Succeed())
+
+			project = testSentryProject("0", "test-team", servicehook.Spec.Project)
+			fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*project}, newSentryResponse(http.StatusOK), nil)
+
+			existing = testSentryServiceHook("12345", servicehook.Spec.URL, "test-secret", "event.alert")
+			fakeSentryProjects.ListHooksReturns([]sentry.ServiceHook{*existing}, newSentryResponse(http.StatusOK), nil)
+
+			servicehook.Spec.Events = []sentryv1alpha1.ServiceHookEvent{sentryv1alpha1.ServiceHookEventAlert, sentryv1alpha1.ServiceHookEventCreated}
+
+			updated = testSentryServiceHook("12345", servicehook.Spec.URL, "test-secret", "event.alert", "event.created")
+			fakeSentryProjects.UpdateHookReturns(updated, newSentryResponse(http.StatusOK), nil)
+		})
+
+		Context("the Sentry client returns an error", func() {
+			BeforeEach(func() {
+				fakeSentryProjects.UpdateHookReturns(nil, newSentryResponse(http.StatusBadRequest), errors.New("an error occurred"))
+			})
+
+			It("the ServiceHook gets updated unsuccessfully", func() {
+				Expect(k8sClient.Update(ctx, servicehook)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.ServiceHookStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, servicehook)
+					if err != nil {
+						return nil, err
+					}
+					return &servicehook.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.ServiceHookConditionError),
+						"Message":   Equal("an error occurred"),
+						"ID":        Equal("12345"),
+						"ProjectID": Equal("0"),
+					})),
+				)
+			})
+		})
+
+		It("the ServiceHook gets updated successfully", func() {
+			Expect(k8sClient.Update(ctx, servicehook)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.ServiceHookStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, servicehook)
+				if err != nil {
+					return nil, err
+				}
+				return &servicehook.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.ServiceHookConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("12345"),
+					"ProjectID": Equal("0"),
+				})),
+			)
+
+			By("invoked the Sentry client's .Projects.ListHooks method")
+			organizationSlug, projectSlug, opts := fakeSentryProjects.ListHooksArgsForCall(fakeSentryProjects.ListHooksCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal(project.Slug))
+			Expect(opts.Cursor).To(BeEmpty())
+
+			By("invoked the Sentry client's .Projects.UpdateHook method")
+			organizationSlug, projectSlug, hookID, params := fakeSentryProjects.UpdateHookArgsForCall(fakeSentryProjects.UpdateHookCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal(project.Slug))
+			Expect(hookID).To(Equal(existing.ID))
+			Expect(params).To(Equal(&sentry.UpdateServiceHookParams{
+				Events: []string{"event.alert", "event.created"},
+				URL:    servicehook.Spec.URL,
+			}))
+		})
+	})
+
+	Context("when deleting a ServiceHook", func() {
+		var (
+			project  *sentry.Project
+			existing *sentry.ServiceHook
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, servicehook)).To(Succeed())
+
+			project = testSentryProject("0", "test-team", servicehook.Spec.Project)
+			fakeSentryOrganizations.ListProjectsReturns([]sentry.Project{*project}, newSentryResponse(http.StatusOK), nil)
+
+			existing = testSentryServiceHook("12345", servicehook.Spec.URL, "test-secret", "event.alert", "event.created")
+			fakeSentryProjects.ListHooksReturns([]sentry.ServiceHook{*existing}, newSentryResponse(http.StatusOK), nil)
+			fakeSentryProjects.DeleteHookReturns(newSentryResponse(http.StatusNoContent), nil)
+		})
+
+		It("the ServiceHook gets deleted successfully", func() {
+			Expect(k8sClient.Delete(ctx, servicehook)).To(Succeed())
+
+			Eventually(func() error {
+				return k8sClient.Get(ctx, lookupKey, servicehook)
+			}, timeout, interval).ShouldNot(Succeed())
+
+			By("invoked the Sentry client's .Projects.DeleteHook method")
+			organizationSlug, projectSlug, hookID := fakeSentryProjects.DeleteHookArgsForCall(fakeSentryProjects.DeleteHookCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(projectSlug).To(Equal(project.Slug))
+			Expect(hookID).To(Equal(existing.ID))
+		})
+	})
+})
@@ -1,7 +1,11 @@
 package controllers_test
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"testing"
 	"time"
@@ -27,12 +31,21 @@ var (
 	testEnv    *envtest.Environment
 	k8sClient  client.Client
 	k8sManager ctrl.Manager
+
+	cronJobsServed bool
 )
 
 var (
-	fakeSentryOrganizations *controllersfakes.FakeSentryOrganizations
-	fakeSentryProjects      *controllersfakes.FakeSentryProjects
-	fakeSentryTeams         *controllersfakes.FakeSentryTeams
+	fakeSentryDashboards       *controllersfakes.FakeSentryDashboards
+	fakeSentryMembers          *controllersfakes.FakeSentryMembers
+	fakeSentryMetricAlertRules *controllersfakes.FakeSentryMetricAlertRules
+	fakeSentryMonitors         *controllersfakes.FakeSentryMonitors
+	fakeSentryOrganizations    *controllersfakes.FakeSentryOrganizations
+	fakeSentryProjects         *controllersfakes.FakeSentryProjects
+	fakeSentryReleases         *controllersfakes.FakeSentryReleases
+	fakeSentrySavedSearches    *controllersfakes.FakeSentrySavedSearches
+	fakeSentrySentryApps       *controllersfakes.FakeSentrySentryApps
+	fakeSentryTeams            *controllersfakes.FakeSentryTeams
 )
 
 func TestAPIs(t *testing.T) {
@@ -65,40 +78,219 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).ToNot(HaveOccurred())
 
+	fakeSentryDashboards = new(controllersfakes.FakeSentryDashboards)
+	fakeSentryMembers = new(controllersfakes.FakeSentryMembers)
+	fakeSentryMetricAlertRules = new(controllersfakes.FakeSentryMetricAlertRules)
+	fakeSentryMonitors = new(controllersfakes.FakeSentryMonitors)
 	fakeSentryOrganizations = new(controllersfakes.FakeSentryOrganizations)
 	fakeSentryProjects = new(controllersfakes.FakeSentryProjects)
+	fakeSentryReleases = new(controllersfakes.FakeSentryReleases)
+	fakeSentrySavedSearches = new(controllersfakes.FakeSentrySavedSearches)
+	fakeSentrySentryApps = new(controllersfakes.FakeSentrySentryApps)
 	fakeSentryTeams = new(controllersfakes.FakeSentryTeams)
 
+	fakeSentryClient := &controllers.SentryClient{
+		Dashboards:       fakeSentryDashboards,
+		Members:          fakeSentryMembers,
+		MetricAlertRules: fakeSentryMetricAlertRules,
+		Monitors:         fakeSentryMonitors,
+		Organizations:    fakeSentryOrganizations,
+		Projects:         fakeSentryProjects,
+		Releases:         fakeSentryReleases,
+		SavedSearches:    fakeSentrySavedSearches,
+		SentryApps:       fakeSentrySentryApps,
+		Teams:            fakeSentryTeams,
+	}
+
 	ctrlSentry := &controllers.Sentry{
 		Organization: "organization",
-		Client: &controllers.SentryClient{
-			Organizations: fakeSentryOrganizations,
-			Projects:      fakeSentryProjects,
-			Teams:         fakeSentryTeams,
+		Client:       fakeSentryClient,
+		Credentials: &controllers.Credentials{
+			Namespaced: true,
+			NewClient: func(token string, sentryURL *url.URL) *controllers.SentryClient {
+				return fakeSentryClient
+			},
+			AdoptNamespaces: []string{"test-team-namespace", "test-member-namespace"},
 		},
 	}
 
 	err = (&controllers.ProjectReconciler{
-		Client: k8sManager.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Project"),
-		Scheme: k8sManager.GetScheme(),
-		Sentry: ctrlSentry,
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Project"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("project-controller"),
+		Sentry:   ctrlSentry,
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&controllers.ProjectKeyReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ProjectKey"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("projectkey-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.TeamReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Team"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("team-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.SentryCredentialsReconciler{
 		Client: k8sManager.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("ProjectKey"),
+		Log:    ctrl.Log.WithName("controllers").WithName("SentryCredentials"),
 		Scheme: k8sManager.GetScheme(),
 		Sentry: ctrlSentry,
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	err = (&controllers.TeamReconciler{
+	err = (&controllers.OrganizationMemberReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("OrganizationMember"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("organizationmember-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.IssueAlertRuleReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("IssueAlertRule"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("issuealertrule-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.MetricAlertRuleReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("MetricAlertRule"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("metricalertrule-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.ReleaseReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Release"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("release-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.DeploymentReleaseReconciler{
+		Client:       k8sManager.GetClient(),
+		Log:          ctrl.Log.WithName("controllers").WithName("DeploymentRelease"),
+		Scheme:       k8sManager.GetScheme(),
+		HistoryLimit: 2,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.DeployReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Deploy"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("deploy-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.ProjectOwnershipReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ProjectOwnership"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("projectownership-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.ServiceHookReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ServiceHook"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("servicehook-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.MonitorReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Monitor"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("monitor-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	// Older test control planes don't serve batch/v1 CronJobs, in which case the CronJobMonitorReconciler tests are skipped
+	err = (&controllers.CronJobMonitorReconciler{
 		Client: k8sManager.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Team"),
+		Log:    ctrl.Log.WithName("controllers").WithName("CronJobMonitor"),
 		Scheme: k8sManager.GetScheme(),
-		Sentry: ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	cronJobsServed = !errors.Is(err, controllers.ErrCronJobsNotServed)
+	if cronJobsServed {
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	err = (&controllers.OrganizationSettingsReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("OrganizationSettings"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("organizationsettings-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.ProjectPluginReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ProjectPlugin"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("projectplugin-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.SavedSearchReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("SavedSearch"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("savedsearch-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.DashboardReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Dashboard"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("dashboard-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.ArtifactUploadReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ArtifactUpload"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("artifactupload-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&controllers.InternalIntegrationReconciler{
+		Client:   k8sManager.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("InternalIntegration"),
+		Scheme:   k8sManager.GetScheme(),
+		Recorder: k8sManager.GetEventRecorderFor("internalintegration-controller"),
+		Sentry:   ctrlSentry,
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -118,6 +310,56 @@ var _ = AfterSuite(func() {
 	Expect(err).ToNot(HaveOccurred())
 })
 
+func testSentryDashboard(id, title string, widgets ...sentry.DashboardWidget) *sentry.Dashboard {
+	return &sentry.Dashboard{
+		DateCreated: time.Now(),
+		ID:          id,
+		Title:       title,
+		Widgets:     widgets,
+	}
+}
+
+func testSentryMetricAlertRule(id, name string, projects ...string) *sentry.MetricAlertRule {
+	return &sentry.MetricAlertRule{
+		Aggregate:   "count()",
+		Dataset:     "events",
+		DateCreated: time.Now(),
+		ID:          id,
+		Name:        name,
+		Projects:    projects,
+		TimeWindow:  60,
+		Triggers: []sentry.MetricAlertRuleTrigger{
+			{
+				AlertRuleID:    id,
+				AlertThreshold: 100,
+				ID:             "1",
+				Label:          "critical",
+			},
+		},
+	}
+}
+
+func testSentryMonitor(id, slug, project, schedule string) *sentry.Monitor {
+	return &sentry.Monitor{
+		Config: sentry.MonitorConfig{
+			Schedule:     schedule,
+			ScheduleType: "crontab",
+			Timezone:     "UTC",
+		},
+		DateCreated: time.Now(),
+		ID:          id,
+		Name:        slug,
+		Project: sentry.MonitorProject{
+			ID:   "0",
+			Name: project,
+			Slug: project,
+		},
+		Slug:   slug,
+		Status: "active",
+		Type:   "cron_job",
+	}
+}
+
 func testSentryProject(id, team, name string) *sentry.Project {
 	return &sentry.Project{
 		DateCreated: time.Now(),
@@ -127,6 +369,11 @@ func testSentryProject(id, team, name string) *sentry.Project {
 		Team: sentry.Team{
 			Slug: team,
 		},
+		Teams: []sentry.Team{
+			{
+				Slug: team,
+			},
+		},
 	}
 }
 
@@ -142,6 +389,104 @@ func testSentryProjectKey(id string, projectID int, name, dsn string) *sentry.Pr
 	}
 }
 
+func testSentryProjectPlugin(id string, enabled bool, instance, index string) *sentry.ProjectPlugin {
+	return &sentry.ProjectPlugin{
+		CanDisable: true,
+		Config: []sentry.ProjectPluginConfigField{
+			{Name: "instance", Required: true, Type: "url", Value: instance},
+			{Name: "index", Required: true, Type: "string", Value: index},
+			{HasSavedValue: instance != "", Name: "token", Required: true, Type: "secret"},
+		},
+		Enabled: enabled,
+		ID:      id,
+		Name:    id,
+		Slug:    id,
+		Type:    "data-forwarding",
+	}
+}
+
+func testSentryIssueAlertRule(id, project, name string) *sentry.IssueAlertRule {
+	return &sentry.IssueAlertRule{
+		ActionMatch: "all",
+		DateCreated: time.Now(),
+		FilterMatch: "all",
+		Frequency:   30,
+		ID:          id,
+		Name:        name,
+		Projects:    []string{project},
+	}
+}
+
+func testSentryRelease(version string, projects ...string) *sentry.Release {
+	release := &sentry.Release{
+		DateCreated:  time.Now(),
+		ID:           1,
+		ShortVersion: version,
+		Version:      version,
+	}
+
+	for _, project := range projects {
+		release.Projects = append(release.Projects, sentry.ReleaseProject{
+			Name: project,
+			Slug: project,
+		})
+	}
+
+	return release
+}
+
+func testSentryReleaseFile(id, name, content string) *sentry.ReleaseFile {
+	checksum := sha1.Sum([]byte(content))
+	return &sentry.ReleaseFile{
+		DateCreated: time.Now(),
+		ID:          id,
+		Name:        name,
+		SHA1:        hex.EncodeToString(checksum[:]),
+		Size:        len(content),
+	}
+}
+
+func testSentrySavedSearch(id, name, query, sort string) *sentry.SavedSearch {
+	return &sentry.SavedSearch{
+		DateCreated: time.Now(),
+		ID:          id,
+		Name:        name,
+		Query:       query,
+		Sort:        sort,
+		Type:        sentry.SavedSearchTypeIssue,
+		Visibility:  "organization",
+	}
+}
+
+func testSentrySentryApp(slug, name string, scopes ...string) *sentry.SentryApp {
+	return &sentry.SentryApp{
+		Events: []string{},
+		Name:   name,
+		Scopes: scopes,
+		Slug:   slug,
+		Status: "internal",
+	}
+}
+
+func testSentrySentryAppToken(id, token string) *sentry.SentryAppToken {
+	return &sentry.SentryAppToken{
+		DateCreated: time.Now(),
+		ID:          id,
+		Token:       token,
+	}
+}
+
+func testSentryServiceHook(id, url, secret string, events ...string) *sentry.ServiceHook {
+	return &sentry.ServiceHook{
+		DateCreated: time.Now(),
+		Events:      events,
+		ID:          id,
+		Secret:      secret,
+		Status:      "active",
+		URL:         url,
+	}
+}
+
 func testSentryTeam(id, name string) *sentry.Team {
 	return &sentry.Team{
 		DateCreated: time.Now(),
@@ -151,6 +496,17 @@ func testSentryTeam(id, name string) *sentry.Team {
 	}
 }
 
+func testSentryMember(id, email, role string, pending bool, teams ...string) *sentry.Member {
+	return &sentry.Member{
+		DateCreated: time.Now(),
+		Email:       email,
+		ID:          id,
+		Pending:     pending,
+		Role:        role,
+		Teams:       teams,
+	}
+}
+
 func newSentryResponse(statusCode int) *sentry.Response {
 	return &sentry.Response{
 		Response: &http.Response{
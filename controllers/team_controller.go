@@ -29,13 +29,17 @@ package controllers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -46,14 +50,19 @@ import (
 
 const (
 	TeamFinalizerName = "finalizers.sentry.kubernetes.jaceys.me/team"
+
+	// teamMembersInterval is how often we check whether the unknown or pending members in our spec have joined our Sentry
+	// organization, as this doesn't trigger any change to our Custom Resource.
+	teamMembersInterval = 10 * time.Minute
 )
 
 // TeamReconciler reconciles a Team object
 type TeamReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
-	Sentry *Sentry
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Sentry   *Sentry
 }
 
 func (r *TeamReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -65,6 +74,7 @@ func (r *TeamReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 // +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=teams,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sentry.kubernetes.jaceys.me,resources=teams/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 func (r *TeamReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -80,22 +90,49 @@ func (r *TeamReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, err
 	}
 
+	// Resolve the Sentry organization and client to be used for resources in our namespace
+	sc, err := r.Sentry.forNamespace(ctx, r, req.Namespace)
+	if err != nil {
+		abandoned, err := r.Sentry.abandonDeletion(ctx, r, &team, TeamFinalizerName, err)
+		if abandoned {
+			log.Info("removed finalizer without deleting Sentry resource as Sentry credentials could not be resolved")
+			return ctrl.Result{}, nil
+		}
+
+		log.Error(err, "failed to resolve Sentry credentials")
+		return ctrl.Result{}, r.handleError(ctx, &team, err)
+	}
+
 	hasFinalizer := containsFinalizer(team.GetFinalizers(), TeamFinalizerName)
 
-	// Create our Sentry resource if we have not been synced before
-	if team.Status.LastSynced.IsZero() {
-		if err := r.handleCreate(ctx, &team, hasFinalizer); err != nil {
+	// Create our Sentry resource if we have not been synced before, unless we've been asked to adopt an existing Sentry
+	// resource
+	adoptID, adopt := team.Annotations[sentryv1alpha1.AdoptAnnotation]
+	if team.Status.LastSynced.IsZero() && !adopt {
+		if err := r.handleCreate(ctx, sc, &team, hasFinalizer); err != nil {
 			log.Error(err, "failed to create Team")
 			return ctrl.Result{}, r.handleError(ctx, &team, err)
 		}
 
 		log.Info("successfully created Team")
-		return ctrl.Result{}, nil
+		return r.result(&team), nil
+	}
+
+	// Adopt the existing Sentry resource if we have not been synced before, so that it gets reconciled against our spec
+	// below like any other resource
+	if team.Status.LastSynced.IsZero() {
+		if err := sc.adopt(ctx, r, &team, TeamFinalizerName, hasFinalizer); err != nil {
+			log.Error(err, "failed to adopt Team")
+			return ctrl.Result{}, r.handleError(ctx, &team, err)
+		}
+
+		team.Status.ID = adoptID
+		log.Info("adopting existing Sentry team", "id", adoptID)
 	}
 
 	// Get the existing state of our Sentry resource as it might have drifted. Ignore ErrOutOfSync errors for now as we
 	// will handle this accordingly below.
-	existing, err := r.getExistingState(team)
+	existing, err := r.getExistingState(sc, team)
 	if err != nil && !errors.Is(err, ErrOutOfSync) {
 		log.Error(err, "failed to fetch Sentry team state")
 		return ctrl.Result{}, r.handleError(ctx, &team, err)
@@ -104,7 +141,7 @@ func (r *TeamReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	// Attempt to delete our Sentry resource and remove our finalizer if we receive a delete request
 	if !team.ObjectMeta.DeletionTimestamp.IsZero() {
 		if hasFinalizer {
-			if err := r.handleDelete(ctx, &team, existing); err != nil {
+			if err := r.handleDelete(ctx, sc, &team, existing); err != nil {
 				log.Error(err, "failed to delete Team")
 				return ctrl.Result{}, r.handleError(ctx, &team, err)
 			}
@@ -114,35 +151,42 @@ func (r *TeamReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	}
 
+	// Don't create a new Sentry resource in place of the one we've been asked to adopt if it cannot be found
+	if errors.Is(err, ErrOutOfSync) && team.Status.LastSynced.IsZero() {
+		err := fmt.Errorf("%w: Sentry team %s to be adopted could not be found", ErrOutOfSync, adoptID)
+		log.Error(err, "failed to adopt Team")
+		return ctrl.Result{}, r.handleError(ctx, &team, err)
+	}
+
 	// Our Sentry resource might have been deleted externally of the controller, so attempt to recreate it
 	if errors.Is(err, ErrOutOfSync) {
-		if err := r.handleCreate(ctx, &team, hasFinalizer); err != nil {
+		if err := r.handleCreate(ctx, sc, &team, hasFinalizer); err != nil {
 			log.Error(err, "failed to recreate Team")
 			return ctrl.Result{}, r.handleError(ctx, &team, err)
 		}
 
 		log.Info("successfully recreated Team")
-		return ctrl.Result{}, nil
+		return r.result(&team), nil
 	}
 
 	// Reconcile any differences between our spec and the existing state of our Sentry resource
-	if err := r.handleUpdate(ctx, &team, existing); err != nil {
+	if err := r.handleUpdate(ctx, sc, &team, existing); err != nil {
 		log.Error(err, "failed to update Team")
 		return ctrl.Result{}, r.handleError(ctx, &team, err)
 	}
 
 	log.Info("successfully updated Team")
 
-	return ctrl.Result{}, nil
+	return r.result(&team), nil
 }
 
 // getExistingState retrieves the true state of the resource that exists in Sentry using its constant resource ID, and
 // returns an ErrOutOfSync error if the resource cannot be found.
-func (r *TeamReconciler) getExistingState(team sentryv1alpha1.Team) (*sentry.Team, error) {
+func (r *TeamReconciler) getExistingState(sc *Sentry, team sentryv1alpha1.Team) (*sentry.Team, error) {
 	opts := &sentry.ListOptions{}
 	var sTeams []sentry.Team
 	for {
-		teams, resp, err := r.Sentry.Client.Teams.List(r.Sentry.Organization, opts)
+		teams, resp, err := sc.Client.Teams.List(sc.Organization, opts)
 		if err != nil {
 			switch {
 			case resp.StatusCode >= 500:
@@ -169,8 +213,8 @@ func (r *TeamReconciler) getExistingState(team sentryv1alpha1.Team) (*sentry.Tea
 	return nil, ErrOutOfSync
 }
 
-func (r *TeamReconciler) handleCreate(ctx context.Context, team *sentryv1alpha1.Team, hasFinalizer bool) error {
-	sTeam, resp, err := r.Sentry.Client.Teams.Create(r.Sentry.Organization, &sentry.CreateTeamParams{
+func (r *TeamReconciler) handleCreate(ctx context.Context, sc *Sentry, team *sentryv1alpha1.Team, hasFinalizer bool) error {
+	sTeam, resp, err := sc.Client.Teams.Create(sc.Organization, &sentry.CreateTeamParams{
 		Name: team.Spec.Name,
 		Slug: team.Spec.Slug,
 	})
@@ -202,13 +246,25 @@ func (r *TeamReconciler) handleCreate(ctx context.Context, team *sentryv1alpha1.
 		}
 	}
 
+	// Converge our team members only once our Sentry team has been recorded in our status, so that it doesn't get
+	// recreated if this fails
+	if len(team.Spec.Members) > 0 {
+		if err := r.handleMembers(sc, team, sTeam.Slug); err != nil {
+			return err
+		}
+
+		if err := r.Status().Update(ctx, team); err != nil {
+			return retryableError{err}
+		}
+	}
+
 	return nil
 }
 
-func (r *TeamReconciler) handleDelete(ctx context.Context, team *sentryv1alpha1.Team, existing *sentry.Team) error {
+func (r *TeamReconciler) handleDelete(ctx context.Context, sc *Sentry, team *sentryv1alpha1.Team, existing *sentry.Team) error {
 	// Our resource might no longer exist so check that it's not nil to avoid panicking below
 	if existing != nil {
-		resp, err := r.Sentry.Client.Teams.Delete(r.Sentry.Organization, existing.Slug)
+		resp, err := sc.Client.Teams.Delete(sc.Organization, existing.Slug)
 		if err != nil {
 			switch {
 			case resp.StatusCode >= 500:
@@ -230,8 +286,8 @@ func (r *TeamReconciler) handleDelete(ctx context.Context, team *sentryv1alpha1.
 	return nil
 }
 
-func (r *TeamReconciler) handleUpdate(ctx context.Context, team *sentryv1alpha1.Team, existing *sentry.Team) error {
-	sTeam, resp, err := r.Sentry.Client.Teams.Update(r.Sentry.Organization, existing.Slug, &sentry.UpdateTeamParams{
+func (r *TeamReconciler) handleUpdate(ctx context.Context, sc *Sentry, team *sentryv1alpha1.Team, existing *sentry.Team) error {
+	sTeam, resp, err := sc.Client.Teams.Update(sc.Organization, existing.Slug, &sentry.UpdateTeamParams{
 		Name: team.Spec.Name,
 		Slug: team.Spec.Slug,
 	})
@@ -248,6 +304,10 @@ func (r *TeamReconciler) handleUpdate(ctx context.Context, team *sentryv1alpha1.
 		}
 	}
 
+	if err := r.handleMembers(sc, team, sTeam.Slug); err != nil {
+		return err
+	}
+
 	team.Status.Condition = sentryv1alpha1.TeamConditionCreated
 	team.Status.Message = ""
 	team.Status.ID = sTeam.ID
@@ -259,9 +319,159 @@ func (r *TeamReconciler) handleUpdate(ctx context.Context, team *sentryv1alpha1.
 	return nil
 }
 
+// handleMembers converges the members of our Sentry team with the members in our spec, adding, updating the role of
+// and removing Sentry organization members accordingly. Members in our spec that are not part of the Sentry
+// organization, or have yet to accept their invitation, are recorded in our status. Members of our Sentry team are
+// left untouched if our spec doesn't have any.
+func (r *TeamReconciler) handleMembers(sc *Sentry, team *sentryv1alpha1.Team, teamSlug string) error {
+	team.Status.UnknownMembers = nil
+	team.Status.PendingMembers = nil
+
+	if len(team.Spec.Members) == 0 {
+		return nil
+	}
+
+	opts := &sentry.ListOptions{}
+	var sMembers []sentry.Member
+	for {
+		members, resp, err := sc.Client.Members.List(sc.Organization, opts)
+		if err != nil {
+			switch {
+			case resp.StatusCode >= 500:
+				return retryableError{err}
+			default:
+				// Don't retry on 4XX errors as these indicate that there might be an issue with our organization
+				return err
+			}
+		}
+
+		sMembers = append(sMembers, members...)
+		if !resp.NextPage.Results {
+			break
+		}
+		opts.Cursor = resp.NextPage.Cursor
+	}
+
+	sMembersByEmail := make(map[string]sentry.Member)
+	for _, sMember := range sMembers {
+		sMembersByEmail[strings.ToLower(sMember.Email)] = sMember
+	}
+
+	desired := make(map[string]bool)
+	for _, member := range team.Spec.Members {
+		email := strings.ToLower(member.Email)
+		desired[email] = true
+
+		sMember, ok := sMembersByEmail[email]
+		if !ok {
+			team.Status.UnknownMembers = append(team.Status.UnknownMembers, member.Email)
+			continue
+		}
+
+		if sMember.Pending {
+			team.Status.PendingMembers = append(team.Status.PendingMembers, member.Email)
+		}
+
+		role, isMember := memberTeamRole(sMember, teamSlug)
+		if !isMember {
+			_, resp, err := sc.Client.Members.AddTeam(sc.Organization, sMember.ID, teamSlug)
+			if err != nil {
+				return memberError(resp, err)
+			}
+
+			// Sentry adds members to a team with the default team role
+			role = teamRole("")
+		}
+
+		if desiredRole := teamRole(member.Role); role != desiredRole {
+			_, resp, err := sc.Client.Members.UpdateTeam(sc.Organization, sMember.ID, teamSlug, &sentry.UpdateMemberTeamParams{
+				TeamRole: desiredRole,
+			})
+			if err != nil {
+				return memberError(resp, err)
+			}
+		}
+	}
+
+	for _, sMember := range sMembers {
+		if desired[strings.ToLower(sMember.Email)] {
+			continue
+		}
+
+		if _, isMember := memberTeamRole(sMember, teamSlug); isMember {
+			resp, err := sc.Client.Members.RemoveTeam(sc.Organization, sMember.ID, teamSlug)
+			if err != nil {
+				return memberError(resp, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// memberTeamRole returns the role of the Sentry organization member within the given Sentry team, and whether they are
+// a member of it.
+func memberTeamRole(sMember sentry.Member, teamSlug string) (string, bool) {
+	for _, sTeamRole := range sMember.TeamRoles {
+		if sTeamRole.TeamSlug == teamSlug {
+			return teamRole(sTeamRole.Role), true
+		}
+	}
+
+	for _, slug := range sMember.Teams {
+		if slug == teamSlug {
+			return teamRole(""), true
+		}
+	}
+
+	return "", false
+}
+
+// result requeues our reconcile key periodically while any members in our spec are unknown to or pending in our Sentry
+// organization, so that they get added to our Sentry team once they join it.
+func (r *TeamReconciler) result(team *sentryv1alpha1.Team) ctrl.Result {
+	if len(team.Status.UnknownMembers) > 0 || len(team.Status.PendingMembers) > 0 {
+		return ctrl.Result{RequeueAfter: teamMembersInterval}
+	}
+
+	return ctrl.Result{}
+}
+
+// teamRole returns the role of a member within a Sentry team, which defaults to contributor.
+func teamRole(role string) string {
+	if role == "" {
+		return "contributor"
+	}
+
+	return role
+}
+
+func memberError(resp *sentry.Response, err error) error {
+	switch {
+	case resp.StatusCode >= 500:
+		return retryableError{err}
+	case resp.StatusCode == http.StatusNotFound:
+		// Retry on 404 errors as the member might have just been added to or removed from the Sentry organization
+		return retryableError{err}
+	default:
+		// Don't retry on other 4XX errors as these indicate that we might have an issue with our spec
+		return err
+	}
+}
+
 // handleError is a helper function for annotating our Custom Resource status with the error condition and message. It
-// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key.
+// also checks if the error is retryable, ignoring non-retryable ones so we don't requeue our reconcile key. Planned
+// actions in dry-run mode are recorded as an event and annotated with the planned condition instead.
 func (r *TeamReconciler) handleError(ctx context.Context, team *sentryv1alpha1.Team, err error) error {
+	// Record the planned action instead of an error if we are running in dry-run mode
+	var de dryRunError
+	if errors.As(err, &de) {
+		r.Recorder.Event(team, corev1.EventTypeNormal, "DryRun", de.Error())
+		team.Status.Condition = sentryv1alpha1.TeamConditionPlanned
+		team.Status.Message = de.Error()
+		return r.Status().Update(ctx, team)
+	}
+
 	team.Status.Condition = sentryv1alpha1.TeamConditionError
 	team.Status.Message = err.Error()
 	if err := r.Status().Update(ctx, team); err != nil {
@@ -207,6 +207,81 @@ var _ = Describe("TestReconciler", func() {
 		})
 	})
 
+	Context("when updating a Team's members", func() {
+		var (
+			existing *sentry.Team
+		)
+
+		BeforeEach(func() {
+			Expect(k8sClient.Get(ctx, lookupKey, team)).To(Succeed())
+
+			existing = testSentryTeam("12345", team.Spec.Name)
+			fakeSentryTeams.ListReturns([]sentry.Team{*existing}, newSentryResponse(http.StatusOK), nil)
+			fakeSentryTeams.UpdateReturns(existing, newSentryResponse(http.StatusOK), nil)
+
+			fakeSentryMembers.ListReturns([]sentry.Member{
+				{ID: "1", Email: "jane@example.com", Teams: []string{existing.Slug}},
+				{ID: "2", Email: "john@example.com", Pending: true},
+				{ID: "3", Email: "bob@example.com", Teams: []string{existing.Slug}},
+			}, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMembers.AddTeamReturns(existing, newSentryResponse(http.StatusCreated), nil)
+			fakeSentryMembers.UpdateTeamReturns(&sentry.MemberTeam{}, newSentryResponse(http.StatusOK), nil)
+			fakeSentryMembers.RemoveTeamReturns(newSentryResponse(http.StatusOK), nil)
+
+			team.Spec.Members = []sentryv1alpha1.TeamMember{
+				{Email: "jane@example.com", Role: "admin"},
+				{Email: "john@example.com"},
+				{Email: "unknown@example.com"},
+			}
+		})
+
+		It("the Team's members get converged successfully", func() {
+			Expect(k8sClient.Update(ctx, team)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.TeamStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, team)
+				if err != nil {
+					return nil, err
+				}
+				return &team.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition":      Equal(sentryv1alpha1.TeamConditionCreated),
+					"UnknownMembers": Equal([]string{"unknown@example.com"}),
+					"PendingMembers": Equal([]string{"john@example.com"}),
+				})),
+			)
+
+			By("invoked the Sentry client's .Members.AddTeam method")
+			organizationSlug, memberID, teamSlug := fakeSentryMembers.AddTeamArgsForCall(fakeSentryMembers.AddTeamCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(memberID).To(Equal("2"))
+			Expect(teamSlug).To(Equal(existing.Slug))
+
+			By("invoked the Sentry client's .Members.UpdateTeam method")
+			organizationSlug, memberID, teamSlug, params := fakeSentryMembers.UpdateTeamArgsForCall(fakeSentryMembers.UpdateTeamCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(memberID).To(Equal("1"))
+			Expect(teamSlug).To(Equal(existing.Slug))
+			Expect(params).To(Equal(&sentry.UpdateMemberTeamParams{
+				TeamRole: "admin",
+			}))
+
+			By("did not invoke the Sentry client's .Members.UpdateTeam method for members added with the default role")
+			for idx := 0; idx < fakeSentryMembers.UpdateTeamCallCount(); idx++ {
+				_, memberID, _, _ := fakeSentryMembers.UpdateTeamArgsForCall(idx)
+				Expect(memberID).ToNot(Equal("2"))
+			}
+
+			By("invoked the Sentry client's .Members.RemoveTeam method")
+			organizationSlug, memberID, teamSlug = fakeSentryMembers.RemoveTeamArgsForCall(fakeSentryMembers.RemoveTeamCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(memberID).To(Equal("3"))
+			Expect(teamSlug).To(Equal(existing.Slug))
+		})
+	})
+
 	Context("when deleting a Team", func() {
 		var (
 			existing *sentry.Team
@@ -238,4 +313,94 @@ var _ = Describe("TestReconciler", func() {
 			Expect(teamSlug).To(Equal(existing.Slug))
 		})
 	})
+
+	Context("when adopting a Team", func() {
+		var (
+			adopt     *sentryv1alpha1.Team
+			existing  *sentry.Team
+			createdBy int
+		)
+
+		BeforeEach(func() {
+			adopt = request.DeepCopy()
+			adopt.Name = "test-team-adopt"
+			adopt.Annotations = map[string]string{
+				sentryv1alpha1.AdoptAnnotation: "67890",
+			}
+			lookupKey = types.NamespacedName{Name: adopt.Name, Namespace: teamNamespace}
+
+			existing = testSentryTeam("67890", "test-team-existing")
+			fakeSentryTeams.ListReturns([]sentry.Team{*existing}, newSentryResponse(http.StatusOK), nil)
+
+			updated := testSentryTeam("67890", adopt.Spec.Name)
+			fakeSentryTeams.UpdateReturns(updated, newSentryResponse(http.StatusOK), nil)
+
+			createdBy = fakeSentryTeams.CreateCallCount()
+		})
+
+		It("the Team gets adopted successfully", func() {
+			Expect(k8sClient.Create(ctx, adopt)).To(Succeed())
+
+			By("with the expected status")
+			Eventually(func() (*sentryv1alpha1.TeamStatus, error) {
+				err := k8sClient.Get(ctx, lookupKey, team)
+				if err != nil {
+					return nil, err
+				}
+				return &team.Status, nil
+			}, timeout, interval).Should(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Condition": Equal(sentryv1alpha1.TeamConditionCreated),
+					"Message":   BeEmpty(),
+					"ID":        Equal("67890"),
+				})),
+			)
+
+			By("with the expected finalizer")
+			Expect(team.Finalizers).To(ContainElement(controllers.TeamFinalizerName))
+
+			By("did not invoke the Sentry client's .Teams.Create method")
+			Expect(fakeSentryTeams.CreateCallCount()).To(Equal(createdBy))
+
+			By("invoked the Sentry client's .Teams.Update method")
+			organizationSlug, teamSlug, params := fakeSentryTeams.UpdateArgsForCall(fakeSentryTeams.UpdateCallCount() - 1)
+			Expect(organizationSlug).To(Equal("organization"))
+			Expect(teamSlug).To(Equal(existing.Slug))
+			Expect(params).To(Equal(&sentry.UpdateTeamParams{
+				Name: adopt.Spec.Name,
+				Slug: adopt.Spec.Slug,
+			}))
+		})
+
+		Context("in a namespace that is not allowed to adopt Sentry resources", func() {
+			BeforeEach(func() {
+				adopt.Namespace = "test-team-adopt-namespace"
+				lookupKey = types.NamespacedName{Name: adopt.Name, Namespace: adopt.Namespace}
+			})
+
+			It("the Team gets adopted unsuccessfully", func() {
+				Expect(k8sClient.Create(ctx, adopt)).To(Succeed())
+
+				By("with the expected status")
+				Eventually(func() (*sentryv1alpha1.TeamStatus, error) {
+					err := k8sClient.Get(ctx, lookupKey, team)
+					if err != nil {
+						return nil, err
+					}
+					return &team.Status, nil
+				}, timeout, interval).Should(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Condition": Equal(sentryv1alpha1.TeamConditionError),
+						"Message":   Equal("namespace test-team-adopt-namespace is not allowed to adopt existing Sentry resources using the default Sentry credentials"),
+					})),
+				)
+
+				By("without our finalizer")
+				Expect(team.Finalizers).ToNot(ContainElement(controllers.TeamFinalizerName))
+
+				By("did not invoke the Sentry client's .Teams.Create method")
+				Expect(fakeSentryTeams.CreateCallCount()).To(Equal(createdBy))
+			})
+		})
+	})
 })
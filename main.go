@@ -27,17 +27,26 @@ SOFTWARE.
 package main
 
 import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"gopkg.in/alecthomas/kingpin.v2"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
 	"github.com/jace-ys/sentry-operator/controllers"
+	"github.com/jace-ys/sentry-operator/pkg/export"
 	"github.com/jace-ys/sentry-operator/pkg/sentry"
 	// +kubebuilder:scaffold:imports
 )
@@ -48,12 +57,41 @@ var (
 
 	cmd = kingpin.New("sentry-operator", "A Kubernetes operator for Sentry.").Version("v0.0.0")
 
-	metricsAddr    = cmd.Flag("metrics-address", "Address to bind the metrics endpoint to.").Default("127.0.0.1:8080").String()
-	leaderElection = cmd.Flag("leader-election", "Enable leader election for controller manager.").Bool()
+	runCmd           = cmd.Command("run", "Run the operator.").Default()
+	metricsAddr      = runCmd.Flag("metrics-address", "Address to bind the metrics endpoint to.").Default("127.0.0.1:8080").String()
+	healthProbeAddr  = runCmd.Flag("health-probe-address", "Address to bind the health probe endpoints to.").Default(":8081").String()
+	leaderElection   = runCmd.Flag("leader-election", "Enable leader election for controller manager.").Bool()
+	watchDeployments = runCmd.Flag("watch-deployments", "Create a Release whenever the image of a Deployment annotated with a Sentry project changes, finalizing it once the rollout completes.").Bool()
+	releaseHistory   = runCmd.Flag("release-history-limit", "Number of Releases kept for each Deployment or StatefulSet watched via --watch-deployments or --track-deploys, deleting older ones while retaining their Sentry releases. Set to 0 to keep all Releases.").Default("10").Int()
+	watchCronJobs    = runCmd.Flag("watch-cronjobs", "Create a Monitor for each CronJob annotated with a Sentry project, mirroring its schedule, timezone and max runtime.").Bool()
+	trackDeploys     = runCmd.Flag("track-deploys", "Create a Sentry deploy whenever a Deployment or StatefulSet referencing a Sentry project finishes rolling out a new image.").Bool()
+	dryRun           = runCmd.Flag("dry-run", "Only perform GET requests against the Sentry API, recording the actions that would have been performed in each resource's status and events instead.").Bool()
 
 	sentryOrganization = cmd.Flag("sentry-organization", "The slug of the Sentry organization to be managed.").Envar("SENTRY_ORGANIZATION").Required().String()
-	sentryToken        = cmd.Flag("sentry-token", "The authentication token for communicating with the Sentry API.").Envar("SENTRY_TOKEN").Required().String()
+	sentryToken        = cmd.Flag("sentry-token", "The authentication token for communicating with the Sentry API.").Envar("SENTRY_TOKEN").String()
+	sentryTokenFile    = cmd.Flag("sentry-token-file", "Path to a file containing the authentication token for communicating with the Sentry API, re-read whenever it changes.").Envar("SENTRY_TOKEN_FILE").String()
 	sentryURL          = cmd.Flag("sentry-url", "The URL of the Sentry server.").Envar("SENTRY_URL").Default("https://sentry.io").URL()
+
+	sentryCheckInterval = runCmd.Flag("sentry-check-interval", "Minimum interval between verifications of the Sentry organization by the readiness check.").Default("1m").Duration()
+	sentryCheckTimeout  = runCmd.Flag("sentry-check-timeout", "Maximum time a verification of the Sentry organization by the readiness check can take before reporting not ready.").Default("10s").Duration()
+
+	namespacedCredentials        = runCmd.Flag("namespaced-credentials", "Allow namespaces to use their own Sentry organization and token by creating a SentryCredentials. If not set, SentryCredentials and their Secrets are not watched.").Bool()
+	defaultCredentialsNamespaces = runCmd.Flag("default-credentials-namespace", "Namespace allowed to fall back to the operator-wide Sentry credentials when it has no SentryCredentials. Can be repeated, defaults to all namespaces.").Strings()
+	adoptNamespaces              = runCmd.Flag("adopt-namespace", "Namespace allowed to adopt existing Sentry resources using the operator-wide Sentry credentials. Can be repeated, defaults to no namespaces.").Strings()
+	integrationScopes            = runCmd.Flag("internal-integration-scope", "Permission scope that InternalIntegrations are allowed to request using the operator-wide Sentry credentials. Can be repeated, defaults to all scopes except admin scopes.").Strings()
+	credentialsDeletionTimeout   = runCmd.Flag("credentials-deletion-timeout", "How long to retry deleting a resource whose Sentry credentials can't be resolved, before removing its finalizer without deleting its Sentry resource.").Default("10m").Duration()
+
+	exportCmd         = cmd.Command("export", "Export the existing resources in the Sentry organization as Custom Resources to be adopted by the operator.")
+	exportOutputDir   = exportCmd.Flag("output-dir", "Directory to write the manifests to. If not set, the manifests are written to stdout.").String()
+	exportNamespace   = exportCmd.Flag("namespace", "Namespace to set on the exported Custom Resources.").Default("default").String()
+	exportSplitByTeam = exportCmd.Flag("split-by-team", "Write the Custom Resources of each team to a separate file. Requires --output-dir to be set.").Bool()
+)
+
+var (
+	tokenChanges = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sentry_token_changes_total",
+		Help: "Total number of times the Sentry authentication token was changed.",
+	})
 )
 
 func init() {
@@ -61,66 +99,306 @@ func init() {
 
 	_ = sentryv1alpha1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
+
+	metrics.Registry.MustRegister(tokenChanges)
 }
 
 func main() {
-	kingpin.MustParse(cmd.Parse(os.Args[1:]))
+	command := kingpin.MustParse(cmd.Parse(os.Args[1:]))
 
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
+	switch command {
+	case runCmd.FullCommand():
+		run()
+	case exportCmd.FullCommand():
+		exportResources()
+	}
+}
+
+func run() {
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:             scheme,
-		MetricsBindAddress: *metricsAddr,
-		Port:               9443,
-		LeaderElection:     *leaderElection,
-		LeaderElectionID:   "sentry.kubernetes.jaceys.me",
+		Scheme:                 scheme,
+		MetricsBindAddress:     *metricsAddr,
+		HealthProbeBindAddress: *healthProbeAddr,
+		Port:                   9443,
+		LeaderElection:         *leaderElection,
+		LeaderElectionID:       "sentry.kubernetes.jaceys.me",
 	})
 	if err != nil {
 		exit(err, "unable to start manager")
 	}
 
-	sentryClient := sentry.NewClient(*sentryToken, sentry.WithSentryURL(*sentryURL))
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		exit(err, "unable to add health check", "check", "ping")
+	}
+
+	tokenSource, err := newTokenSource()
+	if err != nil {
+		exit(err, "failed to read Sentry token")
+	}
+
+	// Report not ready if we can no longer read a token, such as when its file gets removed or emptied. Whether the
+	// token is still valid is verified by the sentry-organization readiness check below.
+	if err := mgr.AddReadyzCheck("sentry-token", func(_ *http.Request) error {
+		_, err := tokenSource.Token()
+		return err
+	}); err != nil {
+		exit(err, "unable to add readiness check", "check", "sentry-token")
+	}
+
+	sentryClient := sentry.NewClient(*sentryToken, sentry.WithSentryURL(*sentryURL), sentry.WithTokenSource(tokenSource))
 	organization, _, err := sentryClient.Organizations.Get(*sentryOrganization)
 	if err != nil {
 		exit(err, "failed to verify Sentry organization")
 	}
 
+	newSentryClient := func(client *sentry.Client) *controllers.SentryClient {
+		if *dryRun {
+			return controllers.NewDryRunClient(controllers.NewSentryClient(client))
+		}
+		return controllers.NewSentryClient(client)
+	}
+
 	ctrlSentry := &controllers.Sentry{
 		Organization: organization.Slug,
-		Client: &controllers.SentryClient{
-			Organizations: sentryClient.Organizations,
-			Projects:      sentryClient.Projects,
-			Teams:         sentryClient.Teams,
+		Client:       newSentryClient(sentryClient),
+		Credentials: &controllers.Credentials{
+			NewClient: func(token string, sentryURL *url.URL) *controllers.SentryClient {
+				return newSentryClient(sentry.NewClient(token, sentry.WithSentryURL(sentryURL)))
+			},
+			Namespaced:        *namespacedCredentials,
+			DefaultNamespaces: *defaultCredentialsNamespaces,
+			AdoptNamespaces:   *adoptNamespaces,
+			IntegrationScopes: *integrationScopes,
+			DeletionTimeout:   *credentialsDeletionTimeout,
 		},
 	}
 
+	if *dryRun {
+		setupLog.Info("running in dry-run mode, only GET requests will be performed against the Sentry API")
+	}
+
+	// Report not ready if we can no longer access our Sentry organization, such as when our token gets revoked
+	if err := mgr.AddReadyzCheck("sentry-organization", (&controllers.SentryHealthChecker{
+		Sentry:   ctrlSentry,
+		Interval: *sentryCheckInterval,
+		Timeout:  *sentryCheckTimeout,
+	}).Check); err != nil {
+		exit(err, "unable to add readiness check", "check", "sentry-organization")
+	}
+
 	if err = (&controllers.ProjectReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Project"),
-		Scheme: mgr.GetScheme(),
-		Sentry: ctrlSentry,
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Project"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("project-controller"),
+		Sentry:   ctrlSentry,
 	}).SetupWithManager(mgr); err != nil {
 		exit(err, "unable to create controller", "controller", "Project")
 	}
 
 	if err = (&controllers.ProjectKeyReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("ProjectKey"),
-		Scheme: mgr.GetScheme(),
-		Sentry: ctrlSentry,
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ProjectKey"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("projectkey-controller"),
+		Sentry:   ctrlSentry,
 	}).SetupWithManager(mgr); err != nil {
 		exit(err, "unable to create controller", "controller", "ProjectKey")
 	}
 
 	if err = (&controllers.TeamReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("Team"),
-		Scheme: mgr.GetScheme(),
-		Sentry: ctrlSentry,
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Team"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("team-controller"),
+		Sentry:   ctrlSentry,
 	}).SetupWithManager(mgr); err != nil {
 		exit(err, "unable to create controller", "controller", "Team")
 	}
 
+	if *namespacedCredentials {
+		if err = (&controllers.SentryCredentialsReconciler{
+			Client: mgr.GetClient(),
+			Log:    ctrl.Log.WithName("controllers").WithName("SentryCredentials"),
+			Scheme: mgr.GetScheme(),
+			Sentry: ctrlSentry,
+		}).SetupWithManager(mgr); err != nil {
+			exit(err, "unable to create controller", "controller", "SentryCredentials")
+		}
+	}
+
+	if err = (&controllers.OrganizationMemberReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("OrganizationMember"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("organizationmember-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "OrganizationMember")
+	}
+
+	if err = (&controllers.IssueAlertRuleReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("IssueAlertRule"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("issuealertrule-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "IssueAlertRule")
+	}
+
+	if err = (&controllers.MetricAlertRuleReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("MetricAlertRule"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("metricalertrule-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "MetricAlertRule")
+	}
+
+	if err = (&controllers.ReleaseReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Release"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("release-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "Release")
+	}
+
+	if *watchDeployments {
+		if err = (&controllers.DeploymentReleaseReconciler{
+			Client:       mgr.GetClient(),
+			Log:          ctrl.Log.WithName("controllers").WithName("DeploymentRelease"),
+			Scheme:       mgr.GetScheme(),
+			HistoryLimit: *releaseHistory,
+		}).SetupWithManager(mgr); err != nil {
+			exit(err, "unable to create controller", "controller", "DeploymentRelease")
+		}
+	}
+
+	if *trackDeploys {
+		if err = (&controllers.DeployReconciler{
+			Client:       mgr.GetClient(),
+			Log:          ctrl.Log.WithName("controllers").WithName("Deploy"),
+			Scheme:       mgr.GetScheme(),
+			Recorder:     mgr.GetEventRecorderFor("deploy-controller"),
+			Sentry:       ctrlSentry,
+			DryRun:       *dryRun,
+			HistoryLimit: *releaseHistory,
+		}).SetupWithManager(mgr); err != nil {
+			exit(err, "unable to create controller", "controller", "Deploy")
+		}
+	}
+
+	if err = (&controllers.ProjectOwnershipReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ProjectOwnership"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("projectownership-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "ProjectOwnership")
+	}
+
+	if err = (&controllers.ServiceHookReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ServiceHook"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("servicehook-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "ServiceHook")
+	}
+
+	if err = (&controllers.MonitorReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Monitor"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("monitor-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "Monitor")
+	}
+
+	if *watchCronJobs {
+		if err = (&controllers.CronJobMonitorReconciler{
+			Client: mgr.GetClient(),
+			Log:    ctrl.Log.WithName("controllers").WithName("CronJobMonitor"),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			if !errors.Is(err, controllers.ErrCronJobsNotServed) {
+				exit(err, "unable to create controller", "controller", "CronJobMonitor")
+			}
+
+			// Keep running our other controllers, as clusters older than Kubernetes 1.21 don't serve batch/v1 CronJobs
+			setupLog.Error(err, "not watching CronJobs", "controller", "CronJobMonitor")
+		}
+	}
+
+	if err = (&controllers.OrganizationSettingsReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("OrganizationSettings"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("organizationsettings-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "OrganizationSettings")
+	}
+
+	if err = (&controllers.ProjectPluginReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ProjectPlugin"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("projectplugin-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "ProjectPlugin")
+	}
+
+	if err = (&controllers.SavedSearchReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("SavedSearch"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("savedsearch-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "SavedSearch")
+	}
+
+	if err = (&controllers.DashboardReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("Dashboard"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("dashboard-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "Dashboard")
+	}
+
+	if err = (&controllers.ArtifactUploadReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ArtifactUpload"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("artifactupload-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "ArtifactUpload")
+	}
+
+	if err = (&controllers.InternalIntegrationReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("InternalIntegration"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("internalintegration-controller"),
+		Sentry:   ctrlSentry,
+	}).SetupWithManager(mgr); err != nil {
+		exit(err, "unable to create controller", "controller", "InternalIntegration")
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
@@ -129,6 +407,61 @@ func main() {
 	}
 }
 
+func exportResources() {
+	if *exportSplitByTeam && *exportOutputDir == "" {
+		exit(errors.New("--output-dir must be set"), "unable to split export by team")
+	}
+
+	tokenSource, err := newTokenSource()
+	if err != nil {
+		exit(err, "failed to read Sentry token")
+	}
+
+	sentryClient := sentry.NewClient(*sentryToken, sentry.WithSentryURL(*sentryURL), sentry.WithTokenSource(tokenSource))
+	exporter := export.NewExporter(sentryClient, *sentryOrganization, *exportNamespace)
+
+	files, err := exporter.Export(*sentryOrganization+".yaml", *exportSplitByTeam)
+	if err != nil {
+		exit(err, "failed to export Sentry organization")
+	}
+
+	for _, file := range files {
+		data, err := file.Marshal()
+		if err != nil {
+			exit(err, "failed to marshal manifests", "file", file.Name)
+		}
+
+		if *exportOutputDir == "" {
+			os.Stdout.Write(data)
+			continue
+		}
+
+		path := filepath.Join(*exportOutputDir, file.Name)
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			exit(err, "failed to write manifests", "file", path)
+		}
+
+		setupLog.Info("exported manifests", "file", path, "resources", len(file.Objects))
+	}
+}
+
+func newTokenSource() (sentry.TokenSource, error) {
+	switch {
+	case *sentryToken != "" && *sentryTokenFile != "":
+		return nil, errors.New("only one of --sentry-token or --sentry-token-file can be set")
+	case *sentryToken != "":
+		return sentry.StaticTokenSource(*sentryToken), nil
+	case *sentryTokenFile != "":
+		log := ctrl.Log.WithName("sentry")
+		return sentry.NewFileTokenSource(*sentryTokenFile, sentry.WithTokenChangeHandler(func() {
+			log.Info("Sentry token changed", "path", *sentryTokenFile)
+			tokenChanges.Inc()
+		}))
+	default:
+		return nil, errors.New("one of --sentry-token or --sentry-token-file must be set")
+	}
+}
+
 func exit(err error, msg string, keysAndValues ...interface{}) {
 	setupLog.Error(err, msg, keysAndValues...)
 	os.Exit(1)
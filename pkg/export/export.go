@@ -0,0 +1,290 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	sentryv1alpha1 "github.com/jace-ys/sentry-operator/api/v1alpha1"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+// UnassignedFileName is the name of the file containing projects that don't belong to any team when splitting the
+// export by team.
+const UnassignedFileName = "unassigned.yaml"
+
+// File is a set of Custom Resources to be written to a single manifest file.
+type File struct {
+	Name    string
+	Objects []runtime.Object
+}
+
+// Exporter generates Team, Project and ProjectKey Custom Resources for the existing resources in a Sentry organization.
+// Each Custom Resource is annotated with the ID of its Sentry resource, so that applying it adopts the Sentry resource
+// instead of creating a new one.
+type Exporter struct {
+	client       *sentry.Client
+	organization string
+	namespace    string
+}
+
+// NewExporter creates an Exporter for the given Sentry organization, generating Custom Resources in the given namespace.
+func NewExporter(client *sentry.Client, organization, namespace string) *Exporter {
+	return &Exporter{
+		client:       client,
+		organization: organization,
+		namespace:    namespace,
+	}
+}
+
+// Export returns the Custom Resources for the Sentry organization in a single file with the given name. If splitByTeam
+// is set, the Custom Resources are instead returned in one file per team, each containing the team along with its
+// projects and their keys.
+func (e *Exporter) Export(name string, splitByTeam bool) ([]File, error) {
+	teams, err := e.listTeams()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	projects, err := e.listProjects()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	files := make(map[string]*File)
+	var order []string
+	fileFor := func(teamSlug string) *File {
+		name := name
+		if splitByTeam {
+			name = UnassignedFileName
+			if teamSlug != "" {
+				name = teamSlug + ".yaml"
+			}
+		}
+
+		if _, ok := files[name]; !ok {
+			files[name] = &File{Name: name}
+			order = append(order, name)
+		}
+		return files[name]
+	}
+
+	for _, team := range teams {
+		file := fileFor(team.Slug)
+		file.Objects = append(file.Objects, e.newTeam(team))
+	}
+
+	for _, project := range projects {
+		keys, err := e.listKeys(project.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list keys for project %s: %w", project.Slug, err)
+		}
+
+		// Projects shared between teams are written to the file of the team they were created under
+		teamSlugs := projectTeams(project)
+		var teamSlug string
+		if len(teamSlugs) > 0 {
+			teamSlug = teamSlugs[0]
+		}
+
+		file := fileFor(teamSlug)
+		file.Objects = append(file.Objects, e.newProject(project, teamSlugs))
+
+		names := make(map[string]bool)
+		for _, key := range keys {
+			projectKey := e.newProjectKey(project, key, names)
+			file.Objects = append(file.Objects, projectKey)
+		}
+	}
+
+	result := make([]File, len(order))
+	for idx, name := range order {
+		result[idx] = *files[name]
+	}
+
+	return result, nil
+}
+
+// Marshal encodes the file's Custom Resources as a multi-document YAML manifest.
+func (f File) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	for _, obj := range f.Objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		// Strip out any fields that are managed by Kubernetes or the operator
+		delete(content, "status")
+		if metadata, ok := content["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "creationTimestamp")
+		}
+
+		data, err := yaml.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.WriteString("---\n")
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (e *Exporter) listTeams() ([]sentry.Team, error) {
+	opts := &sentry.ListOptions{}
+	var sTeams []sentry.Team
+	for {
+		teams, resp, err := e.client.Teams.List(e.organization, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		sTeams = append(sTeams, teams...)
+		if resp.NextPage == nil || !resp.NextPage.Results {
+			break
+		}
+		opts.Cursor = resp.NextPage.Cursor
+	}
+
+	sort.Slice(sTeams, func(i, j int) bool {
+		return sTeams[i].Slug < sTeams[j].Slug
+	})
+
+	return sTeams, nil
+}
+
+func (e *Exporter) listProjects() ([]sentry.Project, error) {
+	opts := &sentry.ListOptions{}
+	var sProjects []sentry.Project
+	for {
+		projects, resp, err := e.client.Organizations.ListProjects(e.organization, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		sProjects = append(sProjects, projects...)
+		if resp.NextPage == nil || !resp.NextPage.Results {
+			break
+		}
+		opts.Cursor = resp.NextPage.Cursor
+	}
+
+	sort.Slice(sProjects, func(i, j int) bool {
+		return sProjects[i].Slug < sProjects[j].Slug
+	})
+
+	return sProjects, nil
+}
+
+func (e *Exporter) listKeys(projectSlug string) ([]sentry.ProjectKey, error) {
+	opts := &sentry.ListOptions{}
+	var sKeys []sentry.ProjectKey
+	for {
+		keys, resp, err := e.client.Projects.ListKeys(e.organization, projectSlug, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		sKeys = append(sKeys, keys...)
+		if resp.NextPage == nil || !resp.NextPage.Results {
+			break
+		}
+		opts.Cursor = resp.NextPage.Cursor
+	}
+
+	return sKeys, nil
+}
+
+func (e *Exporter) newTeam(sTeam sentry.Team) *sentryv1alpha1.Team {
+	return &sentryv1alpha1.Team{
+		TypeMeta:   typeMeta("Team"),
+		ObjectMeta: e.objectMeta(sTeam.Slug, sTeam.ID),
+		Spec: sentryv1alpha1.TeamSpec{
+			Name: sTeam.Name,
+			Slug: sTeam.Slug,
+		},
+	}
+}
+
+func (e *Exporter) newProject(sProject sentry.Project, teamSlugs []string) *sentryv1alpha1.Project {
+	return &sentryv1alpha1.Project{
+		TypeMeta:   typeMeta("Project"),
+		ObjectMeta: e.objectMeta(sProject.Slug, sProject.ID),
+		Spec: sentryv1alpha1.ProjectSpec{
+			Teams: teamSlugs,
+			Name:  sProject.Name,
+			Slug:  sProject.Slug,
+		},
+	}
+}
+
+// newProjectKey generates a ProjectKey named after its project and key name. As key names are not unique within a
+// project, the key's ID is appended to the name if it has already been used.
+func (e *Exporter) newProjectKey(sProject sentry.Project, sKey sentry.ProjectKey, names map[string]bool) *sentryv1alpha1.ProjectKey {
+	name := sanitizeName(sProject.Slug + "-" + sKey.Name)
+	if names[name] {
+		name = sanitizeName(name + "-" + sKey.ID)
+	}
+	names[name] = true
+
+	return &sentryv1alpha1.ProjectKey{
+		TypeMeta:   typeMeta("ProjectKey"),
+		ObjectMeta: e.objectMeta(name, sKey.ID),
+		Spec: sentryv1alpha1.ProjectKeySpec{
+			Project: sProject.Slug,
+			Name:    sKey.Name,
+		},
+	}
+}
+
+func (e *Exporter) objectMeta(name, id string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: e.namespace,
+		Annotations: map[string]string{
+			sentryv1alpha1.AdoptAnnotation: id,
+		},
+	}
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		APIVersion: sentryv1alpha1.GroupVersion.String(),
+		Kind:       kind,
+	}
+}
+
+// projectTeams returns the slugs of the teams that have access to a Sentry project.
+func projectTeams(sProject sentry.Project) []string {
+	if len(sProject.Teams) == 0 && sProject.Team.Slug != "" {
+		return []string{sProject.Team.Slug}
+	}
+
+	var teamSlugs []string
+	for _, sTeam := range sProject.Teams {
+		teamSlugs = append(teamSlugs, sTeam.Slug)
+	}
+
+	return teamSlugs
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// sanitizeName converts the given value into a valid Kubernetes object name.
+func sanitizeName(value string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(value), "-")
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = name[:validation.DNS1123SubdomainMaxLength]
+	}
+
+	return strings.Trim(name, "-")
+}
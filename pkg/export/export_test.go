@@ -0,0 +1,108 @@
+package export_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/export"
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("Exporter", func() {
+	var (
+		server   *httptest.Server
+		exporter *export.Exporter
+	)
+
+	respond := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Link", `<https://sentry.io/api/0/next/?&cursor=0:0:0>; rel="previous"; results="false"; cursor="0:0:1", <https://sentry.io/api/0/next/?&cursor=0:0:0>; rel="next"; results="false"; cursor="0:0:0"`)
+			w.Write([]byte(body))
+		}
+	}
+
+	BeforeEach(func() {
+		handler := http.NewServeMux()
+		handler.HandleFunc("/api/0/organizations/organization/teams/", respond(`[
+			{"id": "2", "slug": "frontend", "name": "Frontend"},
+			{"id": "1", "slug": "backend", "name": "Backend"}
+		]`))
+		handler.HandleFunc("/api/0/organizations/organization/projects/", respond(`[
+			{"id": "10", "slug": "api", "name": "API", "team": {"id": "1", "slug": "backend"}},
+			{"id": "11", "slug": "web", "name": "Web", "teams": [{"id": "2", "slug": "frontend"}]},
+			{"id": "12", "slug": "orphan", "name": "Orphan"}
+		]`))
+		handler.HandleFunc("/api/0/projects/organization/api/keys/", respond(`[
+			{"id": "a1", "name": "Default"},
+			{"id": "b2", "name": "Default"}
+		]`))
+		handler.HandleFunc("/api/0/projects/organization/web/keys/", respond(`[]`))
+		handler.HandleFunc("/api/0/projects/organization/orphan/keys/", respond(`[]`))
+
+		server = httptest.NewServer(handler)
+		serverURL, _ := url.Parse(server.URL)
+		client := sentry.NewClient("token", sentry.WithSentryURL(serverURL))
+		exporter = export.NewExporter(client, "organization", "sentry")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Export", func() {
+		It("exports all resources into a single file", func() {
+			files, err := exporter.Export("sentry.yaml", false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(files[0].Name).To(Equal("sentry.yaml"))
+			Expect(files[0].Objects).To(HaveLen(7))
+
+			data, err := files[0].Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(`---
+apiVersion: sentry.kubernetes.jaceys.me/v1alpha1
+kind: Team
+metadata:
+  annotations:
+    sentry.kubernetes.jaceys.me/adopt: "1"
+  name: backend
+  namespace: sentry
+spec:
+  name: Backend
+  slug: backend
+`))
+			Expect(string(data)).To(ContainSubstring(`---
+apiVersion: sentry.kubernetes.jaceys.me/v1alpha1
+kind: ProjectKey
+metadata:
+  annotations:
+    sentry.kubernetes.jaceys.me/adopt: b2
+  name: api-default-b2
+  namespace: sentry
+spec:
+  name: Default
+  project: api
+`))
+			Expect(string(data)).ToNot(ContainSubstring("status"))
+			Expect(string(data)).ToNot(ContainSubstring("creationTimestamp"))
+		})
+
+		It("exports resources into one file per team", func() {
+			files, err := exporter.Export("sentry.yaml", true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(3))
+
+			Expect(files[0].Name).To(Equal("backend.yaml"))
+			Expect(files[0].Objects).To(HaveLen(4))
+			Expect(files[1].Name).To(Equal("frontend.yaml"))
+			Expect(files[1].Objects).To(HaveLen(2))
+			Expect(files[2].Name).To(Equal(export.UnassignedFileName))
+			Expect(files[2].Objects).To(HaveLen(1))
+		})
+	})
+})
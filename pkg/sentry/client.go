@@ -6,26 +6,40 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
 	DefaultSentryURL = "https://sentry.io/"
 	APIVersion       = 0
+
+	// DefaultTimeout bounds how long a request to the Sentry API can take, including reading its response body, so that
+	// a hung connection doesn't block its caller indefinitely.
+	DefaultTimeout = time.Minute
 )
 
 type Client struct {
-	client  *http.Client
-	token   string
-	baseURL *url.URL
-
-	Organizations *OrganizationsService
-	Projects      *ProjectsService
-	Teams         *TeamsService
+	client      *http.Client
+	tokenSource TokenSource
+	baseURL     *url.URL
+
+	Dashboards       *DashboardsService
+	Members          *MembersService
+	MetricAlertRules *MetricAlertRulesService
+	Monitors         *MonitorsService
+	Organizations    *OrganizationsService
+	Projects         *ProjectsService
+	Releases         *ReleasesService
+	SavedSearches    *SavedSearchesService
+	SentryApps       *SentryAppsService
+	Teams            *TeamsService
 }
 
 type ClientOption func(*Client)
@@ -40,6 +54,7 @@ func NewClient(token string, opts ...ClientOption) *Client {
 
 	client := &Client{
 		client: &http.Client{
+			Timeout: DefaultTimeout,
 			// Don't follow (302) redirects from the Sentry API, as we won't be able to preserve the
 			// original HTTP method and body, falling back to a GET request instead which might break how
 			// the response body is unmarshalled into our data structures.
@@ -47,8 +62,8 @@ func NewClient(token string, opts ...ClientOption) *Client {
 				return http.ErrUseLastResponse
 			},
 		},
-		baseURL: sentryURL,
-		token:   token,
+		baseURL:     sentryURL,
+		tokenSource: StaticTokenSource(token),
 	}
 
 	for _, opt := range opts {
@@ -56,8 +71,15 @@ func NewClient(token string, opts ...ClientOption) *Client {
 	}
 
 	common := service{client}
+	client.Dashboards = (*DashboardsService)(&common)
+	client.Members = (*MembersService)(&common)
+	client.MetricAlertRules = (*MetricAlertRulesService)(&common)
+	client.Monitors = (*MonitorsService)(&common)
 	client.Organizations = (*OrganizationsService)(&common)
 	client.Projects = (*ProjectsService)(&common)
+	client.Releases = (*ReleasesService)(&common)
+	client.SavedSearches = (*SavedSearchesService)(&common)
+	client.SentryApps = (*SentryAppsService)(&common)
 	client.Teams = (*TeamsService)(&common)
 
 	return client
@@ -69,6 +91,12 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+func WithTokenSource(tokenSource TokenSource) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = tokenSource
+	}
+}
+
 func WithSentryURL(sentryURL *url.URL) ClientOption {
 	return func(c *Client) {
 		sentryURL.Path = path.Join("api", strconv.Itoa(APIVersion)) + "/"
@@ -76,10 +104,20 @@ func WithSentryURL(sentryURL *url.URL) ClientOption {
 	}
 }
 
+// do sends the given request and decodes the response body into v. The returned Response is never nil, so that callers
+// can always inspect its status code when handling errors.
 func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
+	// Read our token only when sending the request, so that a token that can't be read is handled like any other
+	// failure to reach the Sentry API
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return unavailableResponse(req), fmt.Errorf("%w: %v", ErrTokenUnavailable, err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, err
+		return unavailableResponse(req), err
 	}
 	defer resp.Body.Close()
 
@@ -89,7 +127,7 @@ func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
 		apiErr := make(APIError)
 		err := json.NewDecoder(response.Body).Decode(&apiErr)
 		if err != nil {
-			return nil, err
+			return response, err
 		}
 
 		return response, apiErr
@@ -126,7 +164,6 @@ func (c *Client) newRequest(method, endpoint string, body interface{}) (*http.Re
 		return nil, err
 	}
 
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
 
@@ -140,6 +177,66 @@ type Response struct {
 	NextPage *Page
 }
 
+// newUploadRequest creates a multipart request that uploads the given file content, along with the given form fields.
+func (c *Client) newUploadRequest(method, endpoint string, fields map[string]string, fileName string, content []byte) (*http.Request, error) {
+	endpoint = strings.Trim(endpoint, "/") + "/"
+	requestURL, err := c.baseURL.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := writer.WriteField(key, fields[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, requestURL.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.Header.Add("Accept", "application/json")
+
+	return req, nil
+}
+
+// unavailableResponse is returned along with errors that occur before a response is received from the Sentry API. It
+// reports a 503 Service Unavailable status so that these errors are handled like any other transient server error.
+func unavailableResponse(req *http.Request) *Response {
+	return &Response{
+		Response: &http.Response{
+			Status:     http.StatusText(http.StatusServiceUnavailable),
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     make(http.Header),
+			Request:    req,
+		},
+	}
+}
+
 func (c *Client) newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.parsePaginationLinks()
@@ -0,0 +1,123 @@
+package sentry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type DashboardsService service
+
+type Dashboard struct {
+	DateCreated time.Time         `json:"dateCreated"`
+	ID          string            `json:"id"`
+	Title       string            `json:"title"`
+	Widgets     []DashboardWidget `json:"widgets"`
+}
+
+type DashboardWidget struct {
+	DisplayType string                 `json:"displayType"`
+	ID          string                 `json:"id,omitempty"`
+	Interval    string                 `json:"interval,omitempty"`
+	Layout      *DashboardWidgetLayout `json:"layout,omitempty"`
+	Limit       *int                   `json:"limit,omitempty"`
+	Queries     []DashboardWidgetQuery `json:"queries"`
+	Title       string                 `json:"title"`
+	WidgetType  string                 `json:"widgetType,omitempty"`
+}
+
+type DashboardWidgetQuery struct {
+	Aggregates []string `json:"aggregates"`
+	Columns    []string `json:"columns"`
+	Conditions string   `json:"conditions"`
+	Fields     []string `json:"fields"`
+	ID         string   `json:"id,omitempty"`
+	Name       string   `json:"name"`
+	OrderBy    string   `json:"orderby"`
+}
+
+// DashboardWidgetLayout is the position and size of a widget on a dashboard's grid.
+type DashboardWidgetLayout struct {
+	H    int `json:"h"`
+	MinH int `json:"minH"`
+	W    int `json:"w"`
+	X    int `json:"x"`
+	Y    int `json:"y"`
+}
+
+func (s *DashboardsService) List(organizationSlug string, opts *ListOptions) ([]Dashboard, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/organizations/%s/dashboards", organizationSlug)
+	} else {
+		endpoint = fmt.Sprintf("/organizations/%s/dashboards/?&cursor=%s", organizationSlug, opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboards := new([]Dashboard)
+	resp, err := s.client.do(req, dashboards)
+	return *dashboards, resp, err
+}
+
+func (s *DashboardsService) Get(organizationSlug, dashboardID string) (*Dashboard, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/dashboards/%s", organizationSlug, dashboardID)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboard := new(Dashboard)
+	resp, err := s.client.do(req, dashboard)
+	return dashboard, resp, err
+}
+
+type CreateDashboardParams struct {
+	Title   string            `json:"title"`
+	Widgets []DashboardWidget `json:"widgets"`
+}
+
+func (s *DashboardsService) Create(organizationSlug string, params *CreateDashboardParams) (*Dashboard, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/dashboards", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboard := new(Dashboard)
+	resp, err := s.client.do(req, dashboard)
+	return dashboard, resp, err
+}
+
+// UpdateDashboardParams replace the title and widgets of a Sentry dashboard. Widgets without an ID are created, and
+// existing widgets that are left out are removed from the dashboard.
+type UpdateDashboardParams struct {
+	Title   string            `json:"title"`
+	Widgets []DashboardWidget `json:"widgets"`
+}
+
+func (s *DashboardsService) Update(organizationSlug, dashboardID string, params *UpdateDashboardParams) (*Dashboard, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/dashboards/%s", organizationSlug, dashboardID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dashboard := new(Dashboard)
+	resp, err := s.client.do(req, dashboard)
+	return dashboard, resp, err
+}
+
+func (s *DashboardsService) Delete(organizationSlug, dashboardID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/dashboards/%s", organizationSlug, dashboardID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
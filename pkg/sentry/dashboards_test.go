@@ -0,0 +1,325 @@
+package sentry_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("DashboardsService", func() {
+	errorsWidget := sentry.DashboardWidget{
+		DisplayType: "line",
+		ID:          "101",
+		Interval:    "5m",
+		Layout:      &sentry.DashboardWidgetLayout{H: 2, MinH: 2, W: 2, X: 0, Y: 0},
+		Queries: []sentry.DashboardWidgetQuery{
+			{
+				Aggregates: []string{"count()"},
+				Columns:    []string{},
+				Conditions: "event.type:error",
+				Fields:     []string{"count()"},
+				ID:         "201",
+			},
+		},
+		Title:      "Errors",
+		WidgetType: "discover",
+	}
+
+	transactionsWidget := sentry.DashboardWidget{
+		DisplayType: "big_number",
+		ID:          "102",
+		Interval:    "5m",
+		Layout:      &sentry.DashboardWidgetLayout{H: 1, MinH: 1, W: 1, X: 2, Y: 0},
+		Queries: []sentry.DashboardWidgetQuery{
+			{
+				Aggregates: []string{"count()"},
+				Columns:    []string{},
+				Conditions: "event.type:transaction",
+				Fields:     []string{"count()"},
+				ID:         "202",
+			},
+		},
+		Title:      "Transactions",
+		WidgetType: "discover",
+	}
+
+	Describe("List", func() {
+		var (
+			dashboards []sentry.Dashboard
+			resp       *sentry.Response
+			err        error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/dashboards/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/dashboards/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			dashboards, resp, err = client.Dashboards.List("organization", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(dashboards).To(Equal([]sentry.Dashboard{
+				{
+					DateCreated: parseTime("2020-09-14T09:21:33.789Z"),
+					ID:          "12",
+					Title:       "Checkout Service",
+				},
+			}))
+		})
+	})
+
+	Describe("Get", func() {
+		var (
+			dashboardID string
+
+			dashboard *sentry.Dashboard
+			resp      *sentry.Response
+			err       error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/dashboards/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/dashboards/12/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			dashboardID = "12"
+		})
+
+		JustBeforeEach(func() {
+			dashboard, resp, err = client.Dashboards.Get("organization", dashboardID)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(dashboard).To(Equal(&sentry.Dashboard{
+				DateCreated: parseTime("2020-09-14T09:21:33.789Z"),
+				ID:          "12",
+				Title:       "Checkout Service",
+				Widgets:     []sentry.DashboardWidget{errorsWidget},
+			}))
+		})
+
+		Context("when dashboard does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/dashboards/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				dashboardID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("Create", func() {
+		var (
+			params *sentry.CreateDashboardParams
+
+			dashboard *sentry.Dashboard
+			resp      *sentry.Response
+			err       error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/dashboards/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/dashboards/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if body["title"] == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"title": []string{"This field may not be blank."}}))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateDashboardParams{
+				Title: "Checkout Service",
+				Widgets: []sentry.DashboardWidget{
+					{
+						DisplayType: "line",
+						Interval:    "5m",
+						Layout:      &sentry.DashboardWidgetLayout{H: 2, MinH: 2, W: 2},
+						Queries: []sentry.DashboardWidgetQuery{
+							{
+								Aggregates: []string{"count()"},
+								Conditions: "event.type:error",
+								Fields:     []string{"count()"},
+							},
+						},
+						Title:      "Errors",
+						WidgetType: "discover",
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			dashboard, resp, err = client.Dashboards.Create("organization", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(dashboard).To(Equal(&sentry.Dashboard{
+				DateCreated: parseTime("2020-09-14T09:21:33.789Z"),
+				ID:          "12",
+				Title:       "Checkout Service",
+				Widgets:     []sentry.DashboardWidget{errorsWidget},
+			}))
+		})
+
+		Context("when dashboard is invalid", func() {
+			BeforeEach(func() {
+				params.Title = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"title": []interface{}{"This field may not be blank."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			params *sentry.UpdateDashboardParams
+
+			dashboard *sentry.Dashboard
+			resp      *sentry.Response
+			err       error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/dashboards/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/dashboards/12/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateDashboardParams{
+				Title:   "Checkout Service",
+				Widgets: []sentry.DashboardWidget{errorsWidget, transactionsWidget},
+			}
+		})
+
+		JustBeforeEach(func() {
+			dashboard, resp, err = client.Dashboards.Update("organization", "12", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(dashboard).To(Equal(&sentry.Dashboard{
+				DateCreated: parseTime("2020-09-14T09:21:33.789Z"),
+				ID:          "12",
+				Title:       "Checkout Service",
+				Widgets:     []sentry.DashboardWidget{errorsWidget, transactionsWidget},
+			}))
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			dashboardID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/dashboards/12/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			dashboardID = "12"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Dashboards.Delete("organization", dashboardID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when dashboard does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/dashboards/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				dashboardID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+})
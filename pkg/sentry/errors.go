@@ -1,9 +1,14 @@
 package sentry
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrTokenUnavailable is returned when the authentication token can't be read from the client's TokenSource, such as
+// when its file is being replaced.
+var ErrTokenUnavailable = errors.New("sentry: authentication token unavailable")
+
 type APIError map[string]interface{}
 
 func (e APIError) Error() string {
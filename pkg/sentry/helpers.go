@@ -12,3 +12,18 @@ type ListOptions struct {
 func Bool(v bool) *bool {
 	return &v
 }
+
+func Int(v int) *int {
+	return &v
+}
+
+func String(v string) *string {
+	return &v
+}
+
+func Strings(v ...string) *[]string {
+	if v == nil {
+		v = []string{}
+	}
+	return &v
+}
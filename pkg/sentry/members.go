@@ -0,0 +1,149 @@
+package sentry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type MembersService service
+
+type Member struct {
+	DateCreated  time.Time        `json:"dateCreated"`
+	Email        string           `json:"email"`
+	Expired      bool             `json:"expired"`
+	ID           string           `json:"id"`
+	InviteStatus string           `json:"inviteStatus"`
+	Name         string           `json:"name"`
+	Pending      bool             `json:"pending"`
+	Role         string           `json:"role"`
+	RoleName     string           `json:"roleName"`
+	TeamRoles    []MemberTeamRole `json:"teamRoles"`
+	Teams        []string         `json:"teams"`
+}
+
+type MemberTeamRole struct {
+	Role     string `json:"role"`
+	TeamSlug string `json:"teamSlug"`
+}
+
+type MemberTeam struct {
+	IsActive bool   `json:"isActive"`
+	TeamRole string `json:"teamRole"`
+}
+
+func (s *MembersService) List(organizationSlug string, opts *ListOptions) ([]Member, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/organizations/%s/members", organizationSlug)
+	} else {
+		endpoint = fmt.Sprintf("/organizations/%s/members/?&cursor=%s", organizationSlug, opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members := new([]Member)
+	resp, err := s.client.do(req, members)
+	return *members, resp, err
+}
+
+func (s *MembersService) Get(organizationSlug, memberID string) (*Member, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/members/%s", organizationSlug, memberID)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(Member)
+	resp, err := s.client.do(req, member)
+	return member, resp, err
+}
+
+type InviteMemberParams struct {
+	Email      string           `json:"email,omitempty"`
+	Role       string           `json:"role,omitempty"`
+	Teams      []string         `json:"teams,omitempty"`
+	TeamRoles  []MemberTeamRole `json:"teamRoles,omitempty"`
+	SendInvite *bool            `json:"sendInvite,omitempty"`
+}
+
+func (s *MembersService) Invite(organizationSlug string, params *InviteMemberParams) (*Member, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/members", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(Member)
+	resp, err := s.client.do(req, member)
+	return member, resp, err
+}
+
+type UpdateMemberParams struct {
+	Role string `json:"role,omitempty"`
+}
+
+func (s *MembersService) Update(organizationSlug, memberID string, params *UpdateMemberParams) (*Member, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/members/%s", organizationSlug, memberID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(Member)
+	resp, err := s.client.do(req, member)
+	return member, resp, err
+}
+
+func (s *MembersService) Delete(organizationSlug, memberID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/members/%s", organizationSlug, memberID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+func (s *MembersService) AddTeam(organizationSlug, memberID, teamSlug string) (*Team, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/members/%s/teams/%s", organizationSlug, memberID, teamSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team := new(Team)
+	resp, err := s.client.do(req, team)
+	return team, resp, err
+}
+
+type UpdateMemberTeamParams struct {
+	TeamRole string `json:"teamRole,omitempty"`
+}
+
+func (s *MembersService) UpdateTeam(organizationSlug, memberID, teamSlug string, params *UpdateMemberTeamParams) (*MemberTeam, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/members/%s/teams/%s", organizationSlug, memberID, teamSlug)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	memberTeam := new(MemberTeam)
+	resp, err := s.client.do(req, memberTeam)
+	return memberTeam, resp, err
+}
+
+func (s *MembersService) RemoveTeam(organizationSlug, memberID, teamSlug string) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/members/%s/teams/%s", organizationSlug, memberID, teamSlug)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
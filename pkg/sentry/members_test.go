@@ -0,0 +1,463 @@
+package sentry_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("MembersService", func() {
+	Describe("List", func() {
+		var (
+			members []sentry.Member
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/members/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/members/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			members, resp, err = client.Members.List("organization", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(members).To(Equal([]sentry.Member{
+				{
+					DateCreated:  parseTime("2020-01-04T00:00:00.000Z"),
+					Email:        "jane@example.com",
+					Expired:      false,
+					ID:           "57377908164",
+					InviteStatus: "approved",
+					Name:         "Jane Doe",
+					Pending:      false,
+					Role:         "member",
+					RoleName:     "Member",
+					TeamRoles: []sentry.MemberTeamRole{
+						{
+							TeamSlug: "ancient-gabelers",
+						},
+					},
+					Teams: []string{"ancient-gabelers"},
+				},
+			}))
+		})
+	})
+
+	Describe("Get", func() {
+		var (
+			memberID string
+
+			member *sentry.Member
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/members/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/members/57377908164/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			memberID = "57377908164"
+		})
+
+		JustBeforeEach(func() {
+			member, resp, err = client.Members.Get("organization", memberID)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(member).To(Equal(&sentry.Member{
+				DateCreated:  parseTime("2020-01-04T00:00:00.000Z"),
+				Email:        "jane@example.com",
+				Expired:      false,
+				ID:           "57377908164",
+				InviteStatus: "approved",
+				Name:         "Jane Doe",
+				Pending:      false,
+				Role:         "member",
+				RoleName:     "Member",
+				TeamRoles: []sentry.MemberTeamRole{
+					{
+						Role:     "admin",
+						TeamSlug: "ancient-gabelers",
+					},
+				},
+				Teams: []string{"ancient-gabelers"},
+			}))
+		})
+
+		Context("when member does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/members/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				memberID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("Invite", func() {
+		var (
+			params *sentry.InviteMemberParams
+
+			member *sentry.Member
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/members/invite.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/members/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var rParams sentry.InviteMemberParams
+				err := json.NewDecoder(r.Body).Decode(&rParams)
+				Expect(err).ToNot(HaveOccurred())
+
+				apiErr := make(sentry.APIError)
+				if rParams.Email == "" {
+					apiErr["email"] = "This field is required"
+				}
+
+				if len(apiErr) > 0 {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(apiErr))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.InviteMemberParams{
+				Email: "john@example.com",
+				Role:  "member",
+				Teams: []string{"ancient-gabelers"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			member, resp, err = client.Members.Invite("organization", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(member).To(Equal(&sentry.Member{
+				DateCreated:  parseTime("2020-01-04T00:00:00.000Z"),
+				Email:        "john@example.com",
+				Expired:      false,
+				ID:           "57377908165",
+				InviteStatus: "approved",
+				Name:         "john@example.com",
+				Pending:      true,
+				Role:         "member",
+				RoleName:     "Member",
+				TeamRoles: []sentry.MemberTeamRole{
+					{
+						TeamSlug: "ancient-gabelers",
+					},
+				},
+				Teams: []string{"ancient-gabelers"},
+			}))
+		})
+
+		Context("when missing required parameters", func() {
+			BeforeEach(func() {
+				params = &sentry.InviteMemberParams{}
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"email": "This field is required"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			params *sentry.UpdateMemberParams
+
+			member *sentry.Member
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/members/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/members/57377908164/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				var rParams sentry.UpdateMemberParams
+				err := json.NewDecoder(r.Body).Decode(&rParams)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rParams.Role).To(Equal("admin"))
+
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateMemberParams{
+				Role: "admin",
+			}
+		})
+
+		JustBeforeEach(func() {
+			member, resp, err = client.Members.Update("organization", "57377908164", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(member).To(Equal(&sentry.Member{
+				DateCreated:  parseTime("2020-01-04T00:00:00.000Z"),
+				Email:        "jane@example.com",
+				Expired:      false,
+				ID:           "57377908164",
+				InviteStatus: "approved",
+				Name:         "Jane Doe",
+				Pending:      false,
+				Role:         "admin",
+				RoleName:     "Admin",
+				TeamRoles:    []sentry.MemberTeamRole{},
+				Teams:        []string{},
+			}))
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			memberID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/members/57377908164/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			memberID = "57377908164"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Members.Delete("organization", memberID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when member does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/members/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				memberID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("AddTeam", func() {
+		var (
+			team *sentry.Team
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/members/add-team.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/members/57377908164/teams/ancient-gabelers/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			team, resp, err = client.Members.AddTeam("organization", "57377908164", "ancient-gabelers")
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(team).To(Equal(&sentry.Team{
+				Avatar: sentry.Avatar{
+					AvatarType: "letter_avatar",
+				},
+				DateCreated: parseTime("2018-11-06T21:20:08.115Z"),
+				HasAccess:   true,
+				ID:          "3",
+				IsMember:    true,
+				IsPending:   false,
+				MemberCount: 2,
+				Name:        "Ancient Gabelers",
+				Slug:        "ancient-gabelers",
+			}))
+		})
+	})
+
+	Describe("UpdateTeam", func() {
+		var (
+			params *sentry.UpdateMemberTeamParams
+
+			memberTeam *sentry.MemberTeam
+			resp       *sentry.Response
+			err        error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/members/update-team.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/members/57377908164/teams/ancient-gabelers/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				var rParams sentry.UpdateMemberTeamParams
+				err := json.NewDecoder(r.Body).Decode(&rParams)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rParams.TeamRole).To(Equal("admin"))
+
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateMemberTeamParams{
+				TeamRole: "admin",
+			}
+		})
+
+		JustBeforeEach(func() {
+			memberTeam, resp, err = client.Members.UpdateTeam("organization", "57377908164", "ancient-gabelers", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(memberTeam).To(Equal(&sentry.MemberTeam{
+				IsActive: true,
+				TeamRole: "admin",
+			}))
+		})
+	})
+
+	Describe("RemoveTeam", func() {
+		var (
+			memberID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/members/57377908164/teams/ancient-gabelers/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{}`))
+			}),
+		)
+
+		BeforeEach(func() {
+			memberID = "57377908164"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Members.RemoveTeam("organization", memberID, "ancient-gabelers")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+		})
+
+		Context("when member does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/members/invalid/teams/ancient-gabelers/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				memberID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,156 @@
+package sentry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type MetricAlertRulesService service
+
+type MetricAlertRule struct {
+	Aggregate        string                   `json:"aggregate"`
+	Dataset          string                   `json:"dataset"`
+	DateCreated      time.Time                `json:"dateCreated"`
+	DateModified     time.Time                `json:"dateModified"`
+	Environment      *string                  `json:"environment"`
+	ID               string                   `json:"id"`
+	Name             string                   `json:"name"`
+	OrganizationID   string                   `json:"organizationId"`
+	Projects         []string                 `json:"projects"`
+	Query            string                   `json:"query"`
+	Resolution       float64                  `json:"resolution"`
+	ResolveThreshold *float64                 `json:"resolveThreshold"`
+	ThresholdType    int                      `json:"thresholdType"`
+	TimeWindow       float64                  `json:"timeWindow"`
+	Triggers         []MetricAlertRuleTrigger `json:"triggers"`
+}
+
+type MetricAlertRuleTrigger struct {
+	Actions          []MetricAlertRuleAction `json:"actions"`
+	AlertRuleID      string                  `json:"alertRuleId"`
+	AlertThreshold   float64                 `json:"alertThreshold"`
+	DateCreated      time.Time               `json:"dateCreated"`
+	ID               string                  `json:"id"`
+	Label            string                  `json:"label"`
+	ResolveThreshold *float64                `json:"resolveThreshold"`
+	ThresholdType    int                     `json:"thresholdType"`
+}
+
+type MetricAlertRuleAction struct {
+	AlertRuleTriggerID string    `json:"alertRuleTriggerId"`
+	DateCreated        time.Time `json:"dateCreated"`
+	Desc               string    `json:"desc"`
+	ID                 string    `json:"id"`
+	IntegrationID      *int      `json:"integrationId"`
+	TargetIdentifier   string    `json:"targetIdentifier"`
+	TargetType         string    `json:"targetType"`
+	Type               string    `json:"type"`
+}
+
+func (s *MetricAlertRulesService) List(organizationSlug string, opts *ListOptions) ([]MetricAlertRule, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/organizations/%s/alert-rules", organizationSlug)
+	} else {
+		endpoint = fmt.Sprintf("/organizations/%s/alert-rules/?&cursor=%s", organizationSlug, opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules := new([]MetricAlertRule)
+	resp, err := s.client.do(req, rules)
+	return *rules, resp, err
+}
+
+func (s *MetricAlertRulesService) Get(organizationSlug, ruleID string) (*MetricAlertRule, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/alert-rules/%s", organizationSlug, ruleID)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(MetricAlertRule)
+	resp, err := s.client.do(req, rule)
+	return rule, resp, err
+}
+
+type MetricAlertRuleTriggerParams struct {
+	Actions          []MetricAlertRuleActionParams `json:"actions"`
+	AlertThreshold   float64                       `json:"alertThreshold"`
+	ID               string                        `json:"id,omitempty"`
+	Label            string                        `json:"label,omitempty"`
+	ResolveThreshold *float64                      `json:"resolveThreshold,omitempty"`
+}
+
+type MetricAlertRuleActionParams struct {
+	ID               string `json:"id,omitempty"`
+	IntegrationID    *int   `json:"integrationId,omitempty"`
+	TargetIdentifier string `json:"targetIdentifier,omitempty"`
+	TargetType       string `json:"targetType,omitempty"`
+	Type             string `json:"type,omitempty"`
+}
+
+type CreateMetricAlertRuleParams struct {
+	Aggregate        string                         `json:"aggregate,omitempty"`
+	Dataset          string                         `json:"dataset,omitempty"`
+	Environment      *string                        `json:"environment"`
+	Name             string                         `json:"name,omitempty"`
+	Projects         []string                       `json:"projects,omitempty"`
+	Query            string                         `json:"query"`
+	ResolveThreshold *float64                       `json:"resolveThreshold"`
+	ThresholdType    int                            `json:"thresholdType"`
+	TimeWindow       int                            `json:"timeWindow,omitempty"`
+	Triggers         []MetricAlertRuleTriggerParams `json:"triggers"`
+}
+
+func (s *MetricAlertRulesService) Create(organizationSlug string, params *CreateMetricAlertRuleParams) (*MetricAlertRule, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/alert-rules", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(MetricAlertRule)
+	resp, err := s.client.do(req, rule)
+	return rule, resp, err
+}
+
+type UpdateMetricAlertRuleParams struct {
+	Aggregate        string                         `json:"aggregate,omitempty"`
+	Dataset          string                         `json:"dataset,omitempty"`
+	Environment      *string                        `json:"environment"`
+	Name             string                         `json:"name,omitempty"`
+	Projects         []string                       `json:"projects,omitempty"`
+	Query            string                         `json:"query"`
+	ResolveThreshold *float64                       `json:"resolveThreshold"`
+	ThresholdType    int                            `json:"thresholdType"`
+	TimeWindow       int                            `json:"timeWindow,omitempty"`
+	Triggers         []MetricAlertRuleTriggerParams `json:"triggers"`
+}
+
+func (s *MetricAlertRulesService) Update(organizationSlug, ruleID string, params *UpdateMetricAlertRuleParams) (*MetricAlertRule, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/alert-rules/%s", organizationSlug, ruleID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(MetricAlertRule)
+	resp, err := s.client.do(req, rule)
+	return rule, resp, err
+}
+
+func (s *MetricAlertRulesService) Delete(organizationSlug, ruleID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/alert-rules/%s", organizationSlug, ruleID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
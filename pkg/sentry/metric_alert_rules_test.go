@@ -0,0 +1,411 @@
+package sentry_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("MetricAlertRulesService", func() {
+	Describe("List", func() {
+		var (
+			rules []sentry.MetricAlertRule
+			resp  *sentry.Response
+			err   error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/metric_alert_rules/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/alert-rules/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			rules, resp, err = client.MetricAlertRules.List("organization", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(rules).To(Equal([]sentry.MetricAlertRule{
+				{
+					Aggregate:      "count()",
+					Dataset:        "events",
+					DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+					DateModified:   parseTime("2020-08-17T14:16:24.231Z"),
+					Environment:    sentry.String("production"),
+					ID:             "7",
+					Name:           "Error rate",
+					OrganizationID: "1",
+					Projects:       []string{"project"},
+					Query:          "level:error",
+					Resolution:     1,
+					ThresholdType:  0,
+					TimeWindow:     60,
+					Triggers: []sentry.MetricAlertRuleTrigger{
+						{
+							Actions: []sentry.MetricAlertRuleAction{
+								{
+									AlertRuleTriggerID: "8",
+									DateCreated:        parseTime("2020-08-17T14:16:24.231Z"),
+									Desc:               "Send an email to members of #team",
+									ID:                 "9",
+									TargetIdentifier:   "2",
+									TargetType:         "team",
+									Type:               "email",
+								},
+							},
+							AlertRuleID:    "7",
+							AlertThreshold: 100,
+							DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+							ID:             "8",
+							Label:          "critical",
+							ThresholdType:  0,
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("Get", func() {
+		var (
+			ruleID string
+
+			rule *sentry.MetricAlertRule
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/metric_alert_rules/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/alert-rules/valid/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			ruleID = "valid"
+		})
+
+		JustBeforeEach(func() {
+			rule, resp, err = client.MetricAlertRules.Get("organization", ruleID)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(rule).To(Equal(&sentry.MetricAlertRule{
+				Aggregate:      "count()",
+				Dataset:        "events",
+				DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+				DateModified:   parseTime("2020-08-17T14:16:24.231Z"),
+				Environment:    sentry.String("production"),
+				ID:             "7",
+				Name:           "Error rate",
+				OrganizationID: "1",
+				Projects:       []string{"project"},
+				Query:          "level:error",
+				Resolution:     1,
+				ThresholdType:  0,
+				TimeWindow:     60,
+				Triggers: []sentry.MetricAlertRuleTrigger{
+					{
+						Actions: []sentry.MetricAlertRuleAction{
+							{
+								AlertRuleTriggerID: "8",
+								DateCreated:        parseTime("2020-08-17T14:16:24.231Z"),
+								Desc:               "Send an email to members of #team",
+								ID:                 "9",
+								TargetIdentifier:   "2",
+								TargetType:         "team",
+								Type:               "email",
+							},
+						},
+						AlertRuleID:    "7",
+						AlertThreshold: 100,
+						DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+						ID:             "8",
+						Label:          "critical",
+						ThresholdType:  0,
+					},
+				},
+			}))
+		})
+
+		Context("when rule does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/alert-rules/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				ruleID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("Create", func() {
+		var (
+			params *sentry.CreateMetricAlertRuleParams
+
+			rule *sentry.MetricAlertRule
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/metric_alert_rules/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/alert-rules/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateMetricAlertRuleParams{
+				Aggregate:   "count()",
+				Dataset:     "events",
+				Environment: sentry.String("production"),
+				Name:        "Error rate",
+				Projects:    []string{"project"},
+				Query:       "level:error",
+				TimeWindow:  60,
+				Triggers: []sentry.MetricAlertRuleTriggerParams{
+					{
+						Label:          "critical",
+						AlertThreshold: 100,
+						Actions: []sentry.MetricAlertRuleActionParams{
+							{
+								Type:             "email",
+								TargetType:       "team",
+								TargetIdentifier: "2",
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			rule, resp, err = client.MetricAlertRules.Create("organization", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(rule).To(Equal(&sentry.MetricAlertRule{
+				Aggregate:      "count()",
+				Dataset:        "events",
+				DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+				DateModified:   parseTime("2020-08-17T14:16:24.231Z"),
+				Environment:    sentry.String("production"),
+				ID:             "7",
+				Name:           "Error rate",
+				OrganizationID: "1",
+				Projects:       []string{"project"},
+				Query:          "level:error",
+				Resolution:     1,
+				ThresholdType:  0,
+				TimeWindow:     60,
+				Triggers: []sentry.MetricAlertRuleTrigger{
+					{
+						Actions: []sentry.MetricAlertRuleAction{
+							{
+								AlertRuleTriggerID: "8",
+								DateCreated:        parseTime("2020-08-17T14:16:24.231Z"),
+								Desc:               "Send an email to members of #team",
+								ID:                 "9",
+								TargetIdentifier:   "2",
+								TargetType:         "team",
+								Type:               "email",
+							},
+						},
+						AlertRuleID:    "7",
+						AlertThreshold: 100,
+						DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+						ID:             "8",
+						Label:          "critical",
+						ThresholdType:  0,
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			params *sentry.UpdateMetricAlertRuleParams
+
+			rule *sentry.MetricAlertRule
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/metric_alert_rules/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/alert-rules/7/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateMetricAlertRuleParams{
+				Aggregate:   "count()",
+				Dataset:     "events",
+				Environment: sentry.String("production"),
+				Name:        "Error rate in production",
+				Projects:    []string{"project"},
+				Query:       "level:error",
+				TimeWindow:  30,
+				Triggers: []sentry.MetricAlertRuleTriggerParams{
+					{
+						Label:          "critical",
+						AlertThreshold: 100,
+						Actions: []sentry.MetricAlertRuleActionParams{
+							{
+								Type:             "email",
+								TargetType:       "team",
+								TargetIdentifier: "2",
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			rule, resp, err = client.MetricAlertRules.Update("organization", "7", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(rule).To(Equal(&sentry.MetricAlertRule{
+				Aggregate:      "count()",
+				Dataset:        "events",
+				DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+				DateModified:   parseTime("2020-08-17T14:16:24.231Z"),
+				Environment:    sentry.String("production"),
+				ID:             "7",
+				Name:           "Error rate in production",
+				OrganizationID: "1",
+				Projects:       []string{"project"},
+				Query:          "level:error",
+				Resolution:     1,
+				ThresholdType:  0,
+				TimeWindow:     30,
+				Triggers: []sentry.MetricAlertRuleTrigger{
+					{
+						Actions: []sentry.MetricAlertRuleAction{
+							{
+								AlertRuleTriggerID: "8",
+								DateCreated:        parseTime("2020-08-17T14:16:24.231Z"),
+								Desc:               "Send an email to members of #team",
+								ID:                 "9",
+								TargetIdentifier:   "2",
+								TargetType:         "team",
+								Type:               "email",
+							},
+						},
+						AlertRuleID:    "7",
+						AlertThreshold: 100,
+						DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+						ID:             "8",
+						Label:          "critical",
+						ThresholdType:  0,
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			ruleID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/alert-rules/valid/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			ruleID = "valid"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.MetricAlertRules.Delete("organization", ruleID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when rule does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/alert-rules/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				ruleID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,117 @@
+package sentry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type MonitorsService service
+
+type Monitor struct {
+	Config      MonitorConfig  `json:"config"`
+	DateCreated time.Time      `json:"dateCreated"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Project     MonitorProject `json:"project"`
+	Slug        string         `json:"slug"`
+	Status      string         `json:"status"`
+	Type        string         `json:"type"`
+}
+
+// MonitorConfig is the schedule configuration of a Sentry cron monitor. Only crontab schedules are supported, and the
+// check-in margin and max runtime are in minutes.
+type MonitorConfig struct {
+	CheckinMargin *int   `json:"checkin_margin"`
+	MaxRuntime    *int   `json:"max_runtime"`
+	Schedule      string `json:"schedule"`
+	ScheduleType  string `json:"schedule_type"`
+	Timezone      string `json:"timezone,omitempty"`
+}
+
+type MonitorProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+func (s *MonitorsService) List(organizationSlug string, opts *ListOptions) ([]Monitor, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/organizations/%s/monitors", organizationSlug)
+	} else {
+		endpoint = fmt.Sprintf("/organizations/%s/monitors/?&cursor=%s", organizationSlug, opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitors := new([]Monitor)
+	resp, err := s.client.do(req, monitors)
+	return *monitors, resp, err
+}
+
+func (s *MonitorsService) Get(organizationSlug, monitorSlug string) (*Monitor, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/monitors/%s", organizationSlug, monitorSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(Monitor)
+	resp, err := s.client.do(req, monitor)
+	return monitor, resp, err
+}
+
+type CreateMonitorParams struct {
+	Config  MonitorConfig `json:"config"`
+	Name    string        `json:"name"`
+	Project string        `json:"project"`
+	Slug    string        `json:"slug,omitempty"`
+	Type    string        `json:"type"`
+}
+
+func (s *MonitorsService) Create(organizationSlug string, params *CreateMonitorParams) (*Monitor, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/monitors", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(Monitor)
+	resp, err := s.client.do(req, monitor)
+	return monitor, resp, err
+}
+
+type UpdateMonitorParams struct {
+	Config  MonitorConfig `json:"config"`
+	Name    string        `json:"name,omitempty"`
+	Project string        `json:"project,omitempty"`
+	Slug    string        `json:"slug,omitempty"`
+	Type    string        `json:"type,omitempty"`
+}
+
+func (s *MonitorsService) Update(organizationSlug, monitorSlug string, params *UpdateMonitorParams) (*Monitor, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/monitors/%s", organizationSlug, monitorSlug)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitor := new(Monitor)
+	resp, err := s.client.do(req, monitor)
+	return monitor, resp, err
+}
+
+func (s *MonitorsService) Delete(organizationSlug, monitorSlug string) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/monitors/%s", organizationSlug, monitorSlug)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
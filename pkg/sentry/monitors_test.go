@@ -0,0 +1,311 @@
+package sentry_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("MonitorsService", func() {
+	Describe("List", func() {
+		var (
+			monitors []sentry.Monitor
+			resp     *sentry.Response
+			err      error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/monitors/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/monitors/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			monitors, resp, err = client.Monitors.List("organization", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(monitors).To(Equal([]sentry.Monitor{
+				{
+					Config: sentry.MonitorConfig{
+						CheckinMargin: sentry.Int(5),
+						MaxRuntime:    sentry.Int(30),
+						Schedule:      "0 2 * * *",
+						ScheduleType:  "crontab",
+						Timezone:      "Europe/London",
+					},
+					DateCreated: parseTime("2020-09-07T09:30:12.456Z"),
+					ID:          "d1b7e2a3-6f4c-4c1e-9a0b-3e5f8d2c7b91",
+					Name:        "nightly-backup",
+					Project: sentry.MonitorProject{
+						ID:   "2",
+						Name: "Project",
+						Slug: "project",
+					},
+					Slug:   "nightly-backup",
+					Status: "active",
+					Type:   "cron_job",
+				},
+			}))
+		})
+	})
+
+	Describe("Get", func() {
+		var (
+			monitorSlug string
+
+			monitor *sentry.Monitor
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/monitors/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/monitors/nightly-backup/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			monitorSlug = "nightly-backup"
+		})
+
+		JustBeforeEach(func() {
+			monitor, resp, err = client.Monitors.Get("organization", monitorSlug)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(monitor).To(Equal(&sentry.Monitor{
+				Config: sentry.MonitorConfig{
+					CheckinMargin: sentry.Int(5),
+					MaxRuntime:    sentry.Int(30),
+					Schedule:      "0 2 * * *",
+					ScheduleType:  "crontab",
+					Timezone:      "Europe/London",
+				},
+				DateCreated: parseTime("2020-09-07T09:30:12.456Z"),
+				ID:          "d1b7e2a3-6f4c-4c1e-9a0b-3e5f8d2c7b91",
+				Name:        "nightly-backup",
+				Project: sentry.MonitorProject{
+					ID:   "2",
+					Name: "Project",
+					Slug: "project",
+				},
+				Slug:   "nightly-backup",
+				Status: "active",
+				Type:   "cron_job",
+			}))
+		})
+
+		Context("when monitor does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/monitors/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				monitorSlug = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("Create", func() {
+		var (
+			params *sentry.CreateMonitorParams
+
+			monitor *sentry.Monitor
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/monitors/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/monitors/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				config, _ := body["config"].(map[string]interface{})
+				if config["schedule"] == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"config": map[string]interface{}{"schedule": []string{"This field is required."}}}))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateMonitorParams{
+				Config: sentry.MonitorConfig{
+					CheckinMargin: sentry.Int(5),
+					MaxRuntime:    sentry.Int(30),
+					Schedule:      "0 2 * * *",
+					ScheduleType:  "crontab",
+					Timezone:      "Europe/London",
+				},
+				Name:    "nightly-backup",
+				Project: "project",
+				Type:    "cron_job",
+			}
+		})
+
+		JustBeforeEach(func() {
+			monitor, resp, err = client.Monitors.Create("organization", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(monitor.ID).To(Equal("d1b7e2a3-6f4c-4c1e-9a0b-3e5f8d2c7b91"))
+			Expect(monitor.Slug).To(Equal("nightly-backup"))
+			Expect(monitor.Config).To(Equal(params.Config))
+		})
+
+		Context("when monitor is invalid", func() {
+			BeforeEach(func() {
+				params.Config.Schedule = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"config": map[string]interface{}{"schedule": []interface{}{"This field is required."}}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			params *sentry.UpdateMonitorParams
+
+			monitor *sentry.Monitor
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/monitors/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/monitors/nightly-backup/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateMonitorParams{
+				Config: sentry.MonitorConfig{
+					CheckinMargin: sentry.Int(5),
+					MaxRuntime:    sentry.Int(60),
+					Schedule:      "0 3 * * *",
+					ScheduleType:  "crontab",
+					Timezone:      "Europe/London",
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			monitor, resp, err = client.Monitors.Update("organization", "nightly-backup", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(monitor.Slug).To(Equal("nightly-backup"))
+			Expect(monitor.Config).To(Equal(params.Config))
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			monitorSlug string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/monitors/nightly-backup/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			}),
+		)
+
+		BeforeEach(func() {
+			monitorSlug = "nightly-backup"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Monitors.Delete("organization", monitorSlug)
+		})
+
+		It("returns a 202 Accepted response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusAccepted))
+		})
+
+		Context("when monitor does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/monitors/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				monitorSlug = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+})
@@ -9,14 +9,24 @@ import (
 type OrganizationsService service
 
 type Organization struct {
-	Avatar         Avatar             `json:"avatar"`
-	DateCreated    time.Time          `json:"dateCreated"`
-	ID             string             `json:"id"`
-	IsEarlyAdopter bool               `json:"isEarlyAdopter"`
-	Name           string             `json:"name"`
-	Require2FA     bool               `json:"require2FA"`
-	Slug           string             `json:"slug"`
-	Status         OrganizationStatus `json:"status"`
+	AllowSharedIssues    bool               `json:"allowSharedIssues"`
+	Avatar               Avatar             `json:"avatar"`
+	DataScrubber         bool               `json:"dataScrubber"`
+	DataScrubberDefaults bool               `json:"dataScrubberDefaults"`
+	DateCreated          time.Time          `json:"dateCreated"`
+	DefaultRole          string             `json:"defaultRole"`
+	EnhancedPrivacy      bool               `json:"enhancedPrivacy"`
+	ID                   string             `json:"id"`
+	IsEarlyAdopter       bool               `json:"isEarlyAdopter"`
+	Name                 string             `json:"name"`
+	OpenMembership       bool               `json:"openMembership"`
+	Require2FA           bool               `json:"require2FA"`
+	SafeFields           []string           `json:"safeFields"`
+	ScrapeJavaScript     bool               `json:"scrapeJavaScript"`
+	ScrubIPAddresses     bool               `json:"scrubIPAddresses"`
+	SensitiveFields      []string           `json:"sensitiveFields"`
+	Slug                 string             `json:"slug"`
+	Status               OrganizationStatus `json:"status"`
 }
 
 type OrganizationStatus struct {
@@ -36,6 +46,32 @@ func (s *OrganizationsService) Get(organizationSlug string) (*Organization, *Res
 	return organization, resp, err
 }
 
+type UpdateOrganizationParams struct {
+	AllowSharedIssues    *bool     `json:"allowSharedIssues,omitempty"`
+	DataScrubber         *bool     `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults *bool     `json:"dataScrubberDefaults,omitempty"`
+	DefaultRole          string    `json:"defaultRole,omitempty"`
+	EnhancedPrivacy      *bool     `json:"enhancedPrivacy,omitempty"`
+	OpenMembership       *bool     `json:"openMembership,omitempty"`
+	Require2FA           *bool     `json:"require2FA,omitempty"`
+	SafeFields           *[]string `json:"safeFields,omitempty"`
+	ScrapeJavaScript     *bool     `json:"scrapeJavaScript,omitempty"`
+	ScrubIPAddresses     *bool     `json:"scrubIPAddresses,omitempty"`
+	SensitiveFields      *[]string `json:"sensitiveFields,omitempty"`
+}
+
+func (s *OrganizationsService) Update(organizationSlug string, params *UpdateOrganizationParams) (*Organization, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization := new(Organization)
+	resp, err := s.client.do(req, organization)
+	return organization, resp, err
+}
+
 func (s *OrganizationsService) ListProjects(organizationSlug string, opts *ListOptions) ([]Project, *Response, error) {
 	var endpoint string
 	if opts.Cursor == "" {
@@ -53,3 +89,31 @@ func (s *OrganizationsService) ListProjects(organizationSlug string, opts *ListO
 	resp, err := s.client.do(req, projects)
 	return *projects, resp, err
 }
+
+type SpikeProtectionParams struct {
+	Projects []string `json:"projects"`
+}
+
+// EnableSpikeProtection enables spike protection for the given projects of an organization, which limits the number of
+// events accepted during sudden spikes in volume.
+func (s *OrganizationsService) EnableSpikeProtection(organizationSlug string, params *SpikeProtectionParams) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/spike-protections", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+func (s *OrganizationsService) DisableSpikeProtection(organizationSlug string, params *SpikeProtectionParams) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/spike-protections", organizationSlug)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
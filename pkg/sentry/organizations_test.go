@@ -1,6 +1,7 @@
 package sentry_test
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 
@@ -43,15 +44,25 @@ var _ = Describe("TeamsService", func() {
 			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
 
 			Expect(organization).To(Equal(&sentry.Organization{
+				AllowSharedIssues: true,
 				Avatar: sentry.Avatar{
 					AvatarType: "letter_avatar",
 				},
-				DateCreated:    parseTime("2018-11-06T21:19:55.101Z"),
-				ID:             "2",
-				IsEarlyAdopter: false,
-				Name:           "The Interstellar Jurisdiction",
-				Require2FA:     false,
-				Slug:           "the-interstellar-jurisdiction",
+				DataScrubber:         false,
+				DataScrubberDefaults: false,
+				DateCreated:          parseTime("2018-11-06T21:19:55.101Z"),
+				DefaultRole:          "member",
+				EnhancedPrivacy:      false,
+				ID:                   "2",
+				IsEarlyAdopter:       false,
+				Name:                 "The Interstellar Jurisdiction",
+				OpenMembership:       true,
+				Require2FA:           false,
+				SafeFields:           []string{},
+				ScrapeJavaScript:     true,
+				ScrubIPAddresses:     false,
+				SensitiveFields:      []string{},
+				Slug:                 "the-interstellar-jurisdiction",
 				Status: sentry.OrganizationStatus{
 					ID:   "active",
 					Name: "active",
@@ -78,6 +89,70 @@ var _ = Describe("TeamsService", func() {
 		})
 	})
 
+	Describe("Update", func() {
+		var (
+			params *sentry.UpdateOrganizationParams
+
+			organization *sentry.Organization
+			resp         *sentry.Response
+			err          error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/organizations/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if role, ok := body["defaultRole"]; ok && role != "member" && role != "admin" && role != "manager" && role != "owner" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"defaultRole": []string{"Invalid role"}}))
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateOrganizationParams{
+				DataScrubber:    sentry.Bool(true),
+				OpenMembership:  sentry.Bool(false),
+				Require2FA:      sentry.Bool(true),
+				SensitiveFields: sentry.Strings("password", "token"),
+			}
+		})
+
+		JustBeforeEach(func() {
+			organization, resp, err = client.Organizations.Update("organization", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(organization.DataScrubber).To(BeTrue())
+			Expect(organization.OpenMembership).To(BeFalse())
+			Expect(organization.Require2FA).To(BeTrue())
+			Expect(organization.SensitiveFields).To(Equal([]string{"password", "token"}))
+		})
+
+		Context("when organization is invalid", func() {
+			BeforeEach(func() {
+				params.DefaultRole = "invalid"
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"defaultRole": []interface{}{"Invalid role"}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
 	Describe("ListProjects", func() {
 		var (
 			projects []sentry.Project
@@ -140,4 +215,87 @@ var _ = Describe("TeamsService", func() {
 			}))
 		})
 	})
+
+	Describe("EnableSpikeProtection", func() {
+		var (
+			params *sentry.SpikeProtectionParams
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/spike-protections/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body sentry.SpikeProtectionParams
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if len(body.Projects) == 0 {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"projects": []string{"This field is required."}}))
+					return
+				}
+
+				Expect(body.Projects).To(Equal([]string{"pump-station"}))
+				w.WriteHeader(http.StatusCreated)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.SpikeProtectionParams{
+				Projects: []string{"pump-station"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Organizations.EnableSpikeProtection("organization", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+		})
+
+		Context("when no projects are given", func() {
+			BeforeEach(func() {
+				params.Projects = nil
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"projects": []interface{}{"This field is required."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("DisableSpikeProtection", func() {
+		var (
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/spike-protections/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				var body sentry.SpikeProtectionParams
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body.Projects).To(Equal([]string{"pump-station"}))
+
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			resp, err = client.Organizations.DisableSpikeProtection("organization", &sentry.SpikeProtectionParams{
+				Projects: []string{"pump-station"},
+			})
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+	})
 })
@@ -1,32 +1,54 @@
 package sentry
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
 type ProjectsService service
 
 type Project struct {
-	Avatar       Avatar       `json:"avatar"`
-	Color        string       `json:"color"`
-	DateCreated  time.Time    `json:"dateCreated"`
-	Features     []string     `json:"features"`
-	FirstEvent   time.Time    `json:"firstEvent"`
-	HasAccess    bool         `json:"hasAccess"`
-	ID           string       `json:"id"`
-	IsBookmarked bool         `json:"isBookmarked"`
-	IsInternal   bool         `json:"isInternal"`
-	IsMember     bool         `json:"isMember"`
-	IsPublic     bool         `json:"isPublic"`
-	Name         string       `json:"name"`
-	Organization Organization `json:"organization"`
-	Platform     string       `json:"platform"`
-	Slug         string       `json:"slug"`
-	Status       string       `json:"status"`
-	Team         Team         `json:"team"`
-	Teams        []Team       `json:"teams"`
+	AllowedDomains       []string       `json:"allowedDomains"`
+	Avatar               Avatar         `json:"avatar"`
+	Color                string         `json:"color"`
+	DataScrubber         bool           `json:"dataScrubber"`
+	DataScrubberDefaults bool           `json:"dataScrubberDefaults"`
+	DateCreated          time.Time      `json:"dateCreated"`
+	DefaultEnvironment   string         `json:"defaultEnvironment"`
+	DigestsMaxDelay      int            `json:"digestsMaxDelay"`
+	DigestsMinDelay      int            `json:"digestsMinDelay"`
+	Features             []string       `json:"features"`
+	FirstEvent           time.Time      `json:"firstEvent"`
+	HasAccess            bool           `json:"hasAccess"`
+	ID                   string         `json:"id"`
+	IsBookmarked         bool           `json:"isBookmarked"`
+	IsInternal           bool           `json:"isInternal"`
+	IsMember             bool           `json:"isMember"`
+	IsPublic             bool           `json:"isPublic"`
+	Name                 string         `json:"name"`
+	Options              ProjectOptions `json:"options"`
+	Organization         Organization   `json:"organization"`
+	Platform             string         `json:"platform"`
+	ResolveAge           int            `json:"resolveAge"`
+	ScrubIPAddresses     bool           `json:"scrubIPAddresses"`
+	SensitiveFields      []string       `json:"sensitiveFields"`
+	Slug                 string         `json:"slug"`
+	Status               string         `json:"status"`
+	SubjectPrefix        string         `json:"subjectPrefix"`
+	SubjectTemplate      string         `json:"subjectTemplate"`
+	Team                 Team           `json:"team"`
+	Teams                []Team         `json:"teams"`
+}
+
+// ProjectOptions are the options of a Sentry project that are managed by the operator. List options, such as the
+// filtered releases and error messages, are separated by newlines.
+type ProjectOptions struct {
+	FilterErrorMessages     string `json:"filters:error_messages"`
+	FilterReleases          string `json:"filters:releases"`
+	SpikeProtectionDisabled *bool  `json:"quotas:spike-protection-disabled"`
 }
 
 func (s *ProjectsService) List(opts *ListOptions) ([]Project, *Response, error) {
@@ -60,13 +82,28 @@ func (s *ProjectsService) Get(organizationSlug, projectSlug string) (*Project, *
 }
 
 type UpdateProjectParams struct {
-	Name            string `json:"name,omitempty"`
-	Slug            string `json:"slug,omitempty"`
-	Team            string `json:"team,omitempty"`
-	Platform        string `json:"platform,omitempty"`
-	IsBookmarked    *bool  `json:"isBookmarked,omitempty"`
-	DigestsMinDelay int    `json:"digestsMinDelay,omitempty"`
-	DigestsMaxDelay int    `json:"digestsMaxDelay,omitempty"`
+	Name                 string                      `json:"name,omitempty"`
+	Slug                 string                      `json:"slug,omitempty"`
+	Team                 string                      `json:"team,omitempty"`
+	Platform             string                      `json:"platform,omitempty"`
+	IsBookmarked         *bool                       `json:"isBookmarked,omitempty"`
+	DigestsMinDelay      int                         `json:"digestsMinDelay,omitempty"`
+	DigestsMaxDelay      int                         `json:"digestsMaxDelay,omitempty"`
+	SubjectPrefix        *string                     `json:"subjectPrefix,omitempty"`
+	SubjectTemplate      *string                     `json:"subjectTemplate,omitempty"`
+	ResolveAge           *int                        `json:"resolveAge,omitempty"`
+	DefaultEnvironment   *string                     `json:"defaultEnvironment,omitempty"`
+	AllowedDomains       *[]string                   `json:"allowedDomains,omitempty"`
+	ScrubIPAddresses     *bool                       `json:"scrubIPAddresses,omitempty"`
+	DataScrubber         *bool                       `json:"dataScrubber,omitempty"`
+	DataScrubberDefaults *bool                       `json:"dataScrubberDefaults,omitempty"`
+	SensitiveFields      *[]string                   `json:"sensitiveFields,omitempty"`
+	Options              *UpdateProjectOptionsParams `json:"options,omitempty"`
+}
+
+type UpdateProjectOptionsParams struct {
+	FilterErrorMessages *string `json:"filters:error_messages,omitempty"`
+	FilterReleases      *string `json:"filters:releases,omitempty"`
 }
 
 func (s *ProjectsService) Update(organizationSlug, projectSlug string, params *UpdateProjectParams) (*Project, *Response, error) {
@@ -92,6 +129,30 @@ func (s *ProjectsService) Delete(organizationSlug, projectSlug string) (*Respons
 	return resp, err
 }
 
+func (s *ProjectsService) AddTeam(organizationSlug, projectSlug, teamSlug string) (*Project, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/teams/%s", organizationSlug, projectSlug, teamSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.do(req, project)
+	return project, resp, err
+}
+
+func (s *ProjectsService) RemoveTeam(organizationSlug, projectSlug, teamSlug string) (*Project, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/teams/%s", organizationSlug, projectSlug, teamSlug)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.do(req, project)
+	return project, resp, err
+}
+
 type ProjectKey struct {
 	BrowserSDK        ProjectKeyBrowserSDK `json:"browserSdk"`
 	BrowserSDKVersion string               `json:"browserSdkVersion"`
@@ -197,3 +258,530 @@ func (s *ProjectsService) DeleteKey(organizationSlug, projectSlug, keyID string)
 	resp, err := s.client.do(req, nil)
 	return resp, err
 }
+
+type ProjectEnvironment struct {
+	ID       string `json:"id"`
+	IsHidden bool   `json:"isHidden"`
+	Name     string `json:"name"`
+}
+
+func (s *ProjectsService) ListEnvironments(organizationSlug, projectSlug string) ([]ProjectEnvironment, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/environments", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environments := new([]ProjectEnvironment)
+	resp, err := s.client.do(req, environments)
+	return *environments, resp, err
+}
+
+func (s *ProjectsService) GetEnvironment(organizationSlug, projectSlug, environmentName string) (*ProjectEnvironment, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/environments/%s", organizationSlug, projectSlug, url.PathEscape(environmentName))
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(ProjectEnvironment)
+	resp, err := s.client.do(req, environment)
+	return environment, resp, err
+}
+
+type UpdateProjectEnvironmentParams struct {
+	IsHidden *bool `json:"isHidden,omitempty"`
+}
+
+func (s *ProjectsService) UpdateEnvironment(organizationSlug, projectSlug, environmentName string, params *UpdateProjectEnvironmentParams) (*ProjectEnvironment, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/environments/%s", organizationSlug, projectSlug, url.PathEscape(environmentName))
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(ProjectEnvironment)
+	resp, err := s.client.do(req, environment)
+	return environment, resp, err
+}
+
+type ProjectFilter struct {
+	Active      bool     `json:"-"`
+	Description string   `json:"description"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Subfilters  []string `json:"-"`
+}
+
+// UnmarshalJSON decodes a Sentry project filter, whose active field is either a boolean or, for filters with
+// subfilters such as the legacy browsers filter, the list of active subfilters.
+func (f *ProjectFilter) UnmarshalJSON(data []byte) error {
+	type alias ProjectFilter
+	aux := &struct {
+		*alias
+		Active json.RawMessage `json:"active"`
+	}{
+		alias: (*alias)(f),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if len(aux.Active) == 0 || string(aux.Active) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(aux.Active, &f.Active); err == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(aux.Active, &f.Subfilters); err != nil {
+		return err
+	}
+
+	f.Active = len(f.Subfilters) > 0
+	return nil
+}
+
+func (s *ProjectsService) ListFilters(organizationSlug, projectSlug string) ([]ProjectFilter, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/filters", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filters := new([]ProjectFilter)
+	resp, err := s.client.do(req, filters)
+	return *filters, resp, err
+}
+
+// UpdateProjectFilterParams updates a Sentry project filter. Subfilters are only used by filters that support them,
+// such as the legacy browsers filter, and take precedence over the active field when set.
+type UpdateProjectFilterParams struct {
+	Active     *bool    `json:"active,omitempty"`
+	Subfilters []string `json:"subfilters,omitempty"`
+}
+
+func (s *ProjectsService) UpdateFilter(organizationSlug, projectSlug, filterID string, params *UpdateProjectFilterParams) (*Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/filters/%s", organizationSlug, projectSlug, filterID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+// ProjectQuota limits the number of events of a data category, such as "error", "transaction" or "attachment", that a
+// Sentry project accepts within a window of seconds. A nil limit indicates that the category is unlimited.
+type ProjectQuota struct {
+	Category string `json:"category"`
+	Limit    *int   `json:"limit"`
+	Window   int    `json:"window"`
+}
+
+func (s *ProjectsService) ListQuotas(organizationSlug, projectSlug string) ([]ProjectQuota, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/quotas", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quotas := new([]ProjectQuota)
+	resp, err := s.client.do(req, quotas)
+	return *quotas, resp, err
+}
+
+type UpdateProjectQuotaParams struct {
+	Limit  *int `json:"limit"`
+	Window int  `json:"window,omitempty"`
+}
+
+func (s *ProjectsService) UpdateQuota(organizationSlug, projectSlug, category string, params *UpdateProjectQuotaParams) (*ProjectQuota, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/quotas/%s", organizationSlug, projectSlug, category)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quota := new(ProjectQuota)
+	resp, err := s.client.do(req, quota)
+	return quota, resp, err
+}
+
+type ProjectOwnership struct {
+	AutoAssignment bool      `json:"autoAssignment"`
+	DateCreated    time.Time `json:"dateCreated"`
+	Fallthrough    bool      `json:"fallthrough"`
+	IsActive       bool      `json:"isActive"`
+	LastUpdated    time.Time `json:"lastUpdated"`
+	Raw            string    `json:"raw"`
+}
+
+func (s *ProjectsService) GetOwnership(organizationSlug, projectSlug string) (*ProjectOwnership, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/ownership", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownership := new(ProjectOwnership)
+	resp, err := s.client.do(req, ownership)
+	return ownership, resp, err
+}
+
+type UpdateProjectOwnershipParams struct {
+	AutoAssignment *bool   `json:"autoAssignment,omitempty"`
+	Fallthrough    *bool   `json:"fallthrough,omitempty"`
+	Raw            *string `json:"raw,omitempty"`
+}
+
+func (s *ProjectsService) UpdateOwnership(organizationSlug, projectSlug string, params *UpdateProjectOwnershipParams) (*ProjectOwnership, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/ownership", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownership := new(ProjectOwnership)
+	resp, err := s.client.do(req, ownership)
+	return ownership, resp, err
+}
+
+type IssueAlertRule struct {
+	ActionMatch string                    `json:"actionMatch"`
+	Actions     []IssueAlertRuleComponent `json:"actions"`
+	Conditions  []IssueAlertRuleComponent `json:"conditions"`
+	DateCreated time.Time                 `json:"dateCreated"`
+	Environment *string                   `json:"environment"`
+	FilterMatch string                    `json:"filterMatch"`
+	Filters     []IssueAlertRuleComponent `json:"filters"`
+	Frequency   int                       `json:"frequency"`
+	ID          string                    `json:"id"`
+	Name        string                    `json:"name"`
+	Projects    []string                  `json:"projects"`
+}
+
+// IssueAlertRuleComponent is a condition, filter or action of an issue alert rule. Each component is identified by its
+// "id", with the remaining fields depending on the type of component.
+type IssueAlertRuleComponent map[string]interface{}
+
+func (s *ProjectsService) ListRules(organizationSlug, projectSlug string, opts *ListOptions) ([]IssueAlertRule, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/projects/%s/%s/rules", organizationSlug, projectSlug)
+	} else {
+		endpoint = fmt.Sprintf("/projects/%s/%s/rules/?&cursor=%s", organizationSlug, projectSlug, opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules := new([]IssueAlertRule)
+	resp, err := s.client.do(req, rules)
+	return *rules, resp, err
+}
+
+func (s *ProjectsService) GetRule(organizationSlug, projectSlug, ruleID string) (*IssueAlertRule, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/rules/%s", organizationSlug, projectSlug, ruleID)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(IssueAlertRule)
+	resp, err := s.client.do(req, rule)
+	return rule, resp, err
+}
+
+type CreateIssueAlertRuleParams struct {
+	ActionMatch string                    `json:"actionMatch,omitempty"`
+	Actions     []IssueAlertRuleComponent `json:"actions"`
+	Conditions  []IssueAlertRuleComponent `json:"conditions"`
+	Environment *string                   `json:"environment"`
+	FilterMatch string                    `json:"filterMatch,omitempty"`
+	Filters     []IssueAlertRuleComponent `json:"filters"`
+	Frequency   int                       `json:"frequency,omitempty"`
+	Name        string                    `json:"name,omitempty"`
+}
+
+func (s *ProjectsService) CreateRule(organizationSlug, projectSlug string, params *CreateIssueAlertRuleParams) (*IssueAlertRule, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/rules", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(IssueAlertRule)
+	resp, err := s.client.do(req, rule)
+	return rule, resp, err
+}
+
+type UpdateIssueAlertRuleParams struct {
+	ActionMatch string                    `json:"actionMatch,omitempty"`
+	Actions     []IssueAlertRuleComponent `json:"actions"`
+	Conditions  []IssueAlertRuleComponent `json:"conditions"`
+	Environment *string                   `json:"environment"`
+	FilterMatch string                    `json:"filterMatch,omitempty"`
+	Filters     []IssueAlertRuleComponent `json:"filters"`
+	Frequency   int                       `json:"frequency,omitempty"`
+	Name        string                    `json:"name,omitempty"`
+}
+
+func (s *ProjectsService) UpdateRule(organizationSlug, projectSlug, ruleID string, params *UpdateIssueAlertRuleParams) (*IssueAlertRule, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/rules/%s", organizationSlug, projectSlug, ruleID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(IssueAlertRule)
+	resp, err := s.client.do(req, rule)
+	return rule, resp, err
+}
+
+func (s *ProjectsService) DeleteRule(organizationSlug, projectSlug, ruleID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/rules/%s", organizationSlug, projectSlug, ruleID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+type ServiceHook struct {
+	DateCreated time.Time `json:"dateCreated"`
+	Events      []string  `json:"events"`
+	ID          string    `json:"id"`
+	Secret      string    `json:"secret"`
+	Status      string    `json:"status"`
+	URL         string    `json:"url"`
+}
+
+func (s *ProjectsService) ListHooks(organizationSlug, projectSlug string, opts *ListOptions) ([]ServiceHook, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/projects/%s/%s/hooks", organizationSlug, projectSlug)
+	} else {
+		endpoint = fmt.Sprintf("/projects/%s/%s/hooks/?&cursor=%s", organizationSlug, projectSlug, opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hooks := new([]ServiceHook)
+	resp, err := s.client.do(req, hooks)
+	return *hooks, resp, err
+}
+
+func (s *ProjectsService) GetHook(organizationSlug, projectSlug, hookID string) (*ServiceHook, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/hooks/%s", organizationSlug, projectSlug, hookID)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(ServiceHook)
+	resp, err := s.client.do(req, hook)
+	return hook, resp, err
+}
+
+type CreateServiceHookParams struct {
+	Events []string `json:"events"`
+	URL    string   `json:"url"`
+}
+
+func (s *ProjectsService) CreateHook(organizationSlug, projectSlug string, params *CreateServiceHookParams) (*ServiceHook, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/hooks", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(ServiceHook)
+	resp, err := s.client.do(req, hook)
+	return hook, resp, err
+}
+
+type UpdateServiceHookParams struct {
+	Events []string `json:"events"`
+	URL    string   `json:"url"`
+}
+
+func (s *ProjectsService) UpdateHook(organizationSlug, projectSlug, hookID string, params *UpdateServiceHookParams) (*ServiceHook, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/hooks/%s", organizationSlug, projectSlug, hookID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(ServiceHook)
+	resp, err := s.client.do(req, hook)
+	return hook, resp, err
+}
+
+func (s *ProjectsService) DeleteHook(organizationSlug, projectSlug, hookID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/hooks/%s", organizationSlug, projectSlug, hookID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+type ProjectPlugin struct {
+	CanDisable bool                       `json:"canDisable"`
+	Config     []ProjectPluginConfigField `json:"config"`
+	Enabled    bool                       `json:"enabled"`
+	ID         string                     `json:"id"`
+	IsHidden   bool                       `json:"isHidden"`
+	Name       string                     `json:"name"`
+	Slug       string                     `json:"slug"`
+	Type       string                     `json:"type"`
+	Version    string                     `json:"version"`
+}
+
+// ProjectPluginConfigField is a configuration field of a Sentry project plugin. The values of secret fields are not
+// returned by Sentry, which only indicates whether a value has been saved along with its prefix.
+type ProjectPluginConfigField struct {
+	DefaultValue  interface{} `json:"defaultValue"`
+	HasSavedValue bool        `json:"hasSavedValue"`
+	Help          string      `json:"help"`
+	Label         string      `json:"label"`
+	Name          string      `json:"name"`
+	Prefix        string      `json:"prefix"`
+	Required      bool        `json:"required"`
+	Type          string      `json:"type"`
+	Value         interface{} `json:"value"`
+}
+
+func (s *ProjectsService) ListPlugins(organizationSlug, projectSlug string) ([]ProjectPlugin, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/plugins", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugins := new([]ProjectPlugin)
+	resp, err := s.client.do(req, plugins)
+	return *plugins, resp, err
+}
+
+func (s *ProjectsService) GetPlugin(organizationSlug, projectSlug, pluginID string) (*ProjectPlugin, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/plugins/%s", organizationSlug, projectSlug, pluginID)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugin := new(ProjectPlugin)
+	resp, err := s.client.do(req, plugin)
+	return plugin, resp, err
+}
+
+// UpdateProjectPluginParams are the configuration values of a Sentry project plugin, keyed by the name of their field.
+type UpdateProjectPluginParams map[string]interface{}
+
+func (s *ProjectsService) UpdatePlugin(organizationSlug, projectSlug, pluginID string, params UpdateProjectPluginParams) (*ProjectPlugin, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/plugins/%s", organizationSlug, projectSlug, pluginID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugin := new(ProjectPlugin)
+	resp, err := s.client.do(req, plugin)
+	return plugin, resp, err
+}
+
+func (s *ProjectsService) EnablePlugin(organizationSlug, projectSlug, pluginID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/plugins/%s", organizationSlug, projectSlug, pluginID)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+func (s *ProjectsService) DisablePlugin(organizationSlug, projectSlug, pluginID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/plugins/%s", organizationSlug, projectSlug, pluginID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+// ResetPlugin clears the configuration of a Sentry project plugin.
+func (s *ProjectsService) ResetPlugin(organizationSlug, projectSlug, pluginID string) (*ProjectPlugin, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/plugins/%s", organizationSlug, projectSlug, pluginID)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, map[string]bool{"reset": true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugin := new(ProjectPlugin)
+	resp, err := s.client.do(req, plugin)
+	return plugin, resp, err
+}
+
+func (s *ProjectsService) ListSearches(organizationSlug, projectSlug string) ([]SavedSearch, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/searches", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searches := new([]SavedSearch)
+	resp, err := s.client.do(req, searches)
+	return *searches, resp, err
+}
+
+func (s *ProjectsService) CreateSearch(organizationSlug, projectSlug string, params *CreateSavedSearchParams) (*SavedSearch, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/searches", organizationSlug, projectSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(SavedSearch)
+	resp, err := s.client.do(req, search)
+	return search, resp, err
+}
+
+func (s *ProjectsService) UpdateSearch(organizationSlug, projectSlug, searchID string, params *UpdateSavedSearchParams) (*SavedSearch, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/searches/%s", organizationSlug, projectSlug, searchID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(SavedSearch)
+	resp, err := s.client.do(req, search)
+	return search, resp, err
+}
+
+func (s *ProjectsService) DeleteSearch(organizationSlug, projectSlug, searchID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/searches/%s", organizationSlug, projectSlug, searchID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
@@ -1,6 +1,7 @@
 package sentry_test
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 
@@ -117,19 +118,28 @@ var _ = Describe("ProjectsService", func() {
 			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
 
 			Expect(project).To(Equal(&sentry.Project{
+				AllowedDomains: []string{"*"},
 				Avatar: sentry.Avatar{
 					AvatarType: "letter_avatar",
 				},
-				Color:        "#3fbf7f",
-				DateCreated:  parseTime("2018-11-06T21:19:55.121Z"),
-				Features:     []string{"releases", "sample-events", "minidump", "servicehooks", "rate-limits", "data-forwarding"},
-				HasAccess:    true,
-				ID:           "2",
-				IsBookmarked: false,
-				IsInternal:   false,
-				IsMember:     true,
-				IsPublic:     false,
-				Name:         "Pump Station",
+				Color:                "#3fbf7f",
+				DataScrubber:         true,
+				DataScrubberDefaults: true,
+				DateCreated:          parseTime("2018-11-06T21:19:55.121Z"),
+				DigestsMaxDelay:      1800,
+				DigestsMinDelay:      300,
+				Features:             []string{"releases", "sample-events", "minidump", "servicehooks", "rate-limits", "data-forwarding"},
+				HasAccess:            true,
+				ID:                   "2",
+				IsBookmarked:         false,
+				IsInternal:           false,
+				IsMember:             true,
+				IsPublic:             false,
+				Name:                 "Pump Station",
+				Options: sentry.ProjectOptions{
+					FilterReleases:          "1.0.0-beta*\n2.0.0-rc*",
+					SpikeProtectionDisabled: sentry.Bool(true),
+				},
 				Organization: sentry.Organization{
 					Avatar: sentry.Avatar{
 						AvatarType: "letter_avatar",
@@ -145,8 +155,11 @@ var _ = Describe("ProjectsService", func() {
 						Name: "active",
 					},
 				},
-				Slug:   "pump-station",
-				Status: "active",
+				SensitiveFields: []string{},
+				Slug:            "pump-station",
+				Status:          "active",
+				SubjectPrefix:   "[Sentry] ",
+				SubjectTemplate: "$shortID - $title",
 				Team: sentry.Team{
 					ID:   "2",
 					Name: "Powerful Abolitionist",
@@ -225,19 +238,24 @@ var _ = Describe("ProjectsService", func() {
 			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
 
 			Expect(project).To(Equal(&sentry.Project{
+				AllowedDomains: []string{"http://example.com", "http://example.invalid"},
 				Avatar: sentry.Avatar{
 					AvatarType: "letter_avatar",
 				},
-				Color:        "#bf803f",
-				DateCreated:  parseTime("2018-11-06T21:20:19.624Z"),
-				Features:     []string{"releases", "sample-events", "minidump", "servicehooks", "rate-limits", "data-forwarding"},
-				HasAccess:    true,
-				ID:           "5",
-				IsBookmarked: false,
-				IsInternal:   false,
-				IsMember:     true,
-				IsPublic:     false,
-				Name:         "Plane Proxy",
+				Color:                "#bf803f",
+				DataScrubber:         true,
+				DataScrubberDefaults: true,
+				DateCreated:          parseTime("2018-11-06T21:20:19.624Z"),
+				DigestsMaxDelay:      1800,
+				DigestsMinDelay:      300,
+				Features:             []string{"releases", "sample-events", "minidump", "servicehooks", "rate-limits", "data-forwarding"},
+				HasAccess:            true,
+				ID:                   "5",
+				IsBookmarked:         false,
+				IsInternal:           false,
+				IsMember:             true,
+				IsPublic:             false,
+				Name:                 "Plane Proxy",
 				Organization: sentry.Organization{
 					Avatar: sentry.Avatar{
 						AvatarType: "letter_avatar",
@@ -253,9 +271,12 @@ var _ = Describe("ProjectsService", func() {
 						Name: "active",
 					},
 				},
-				Platform: "javascript",
-				Slug:     "plane-proxy",
-				Status:   "active",
+				Platform:        "javascript",
+				SensitiveFields: []string{},
+				Slug:            "plane-proxy",
+				Status:          "active",
+				SubjectPrefix:   "[Sentry] ",
+				SubjectTemplate: "$shortID - $title",
 				Team: sentry.Team{
 					ID:   "2",
 					Name: "Powerful Abolitionist",
@@ -331,6 +352,119 @@ var _ = Describe("ProjectsService", func() {
 		})
 	})
 
+	Describe("AddTeam", func() {
+		var (
+			teamSlug string
+
+			project *sentry.Project
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/projects/add-team.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/the-spoiled-yoghurt/teams/powerful-abolitionist/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			teamSlug = "powerful-abolitionist"
+		})
+
+		JustBeforeEach(func() {
+			project, resp, err = client.Projects.AddTeam("organization", "the-spoiled-yoghurt", teamSlug)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(project).To(Equal(&sentry.Project{
+				DateCreated: parseTime("2018-11-06T21:19:55.121Z"),
+				ID:          "2",
+				Name:        "The Spoiled Yoghurt",
+				Slug:        "the-spoiled-yoghurt",
+				Teams: []sentry.Team{
+					{
+						ID:   "3",
+						Name: "Ancient Gabelers",
+						Slug: "ancient-gabelers",
+					},
+					{
+						ID:   "2",
+						Name: "Powerful Abolitionist",
+						Slug: "powerful-abolitionist",
+					},
+				},
+			}))
+		})
+
+		Context("when team does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/the-spoiled-yoghurt/teams/invalid/",
+				testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				teamSlug = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("RemoveTeam", func() {
+		var (
+			project *sentry.Project
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/projects/remove-team.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/the-spoiled-yoghurt/teams/powerful-abolitionist/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			project, resp, err = client.Projects.RemoveTeam("organization", "the-spoiled-yoghurt", "powerful-abolitionist")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(project).To(Equal(&sentry.Project{
+				DateCreated: parseTime("2018-11-06T21:19:55.121Z"),
+				ID:          "2",
+				Name:        "The Spoiled Yoghurt",
+				Slug:        "the-spoiled-yoghurt",
+				Teams: []sentry.Team{
+					{
+						ID:   "3",
+						Name: "Ancient Gabelers",
+						Slug: "ancient-gabelers",
+					},
+				},
+			}))
+		})
+	})
+
 	Describe("ListKeys", func() {
 		var (
 			keys []sentry.ProjectKey
@@ -669,4 +803,1644 @@ var _ = Describe("ProjectsService", func() {
 			})
 		})
 	})
+
+	Describe("ListEnvironments", func() {
+		var (
+			environments []sentry.ProjectEnvironment
+			resp         *sentry.Response
+			err          error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_environments/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/environments/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			environments, resp, err = client.Projects.ListEnvironments("organization", "project")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(environments).To(Equal([]sentry.ProjectEnvironment{
+				{
+					ID:       "1",
+					IsHidden: false,
+					Name:     "production",
+				},
+				{
+					ID:       "2",
+					IsHidden: false,
+					Name:     "staging",
+				},
+			}))
+		})
+	})
+
+	Describe("GetEnvironment", func() {
+		var (
+			environmentName string
+
+			environment *sentry.ProjectEnvironment
+			resp        *sentry.Response
+			err         error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_environments/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/environments/staging/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			environmentName = "staging"
+		})
+
+		JustBeforeEach(func() {
+			environment, resp, err = client.Projects.GetEnvironment("organization", "project", environmentName)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(environment).To(Equal(&sentry.ProjectEnvironment{
+				ID:       "2",
+				IsHidden: false,
+				Name:     "staging",
+			}))
+		})
+
+		Context("when environment does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/environments/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				environmentName = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("UpdateEnvironment", func() {
+		var (
+			params *sentry.UpdateProjectEnvironmentParams
+
+			environment *sentry.ProjectEnvironment
+			resp        *sentry.Response
+			err         error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_environments/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/environments/staging/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateProjectEnvironmentParams{
+				IsHidden: sentry.Bool(true),
+			}
+		})
+
+		JustBeforeEach(func() {
+			environment, resp, err = client.Projects.UpdateEnvironment("organization", "project", "staging", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(environment).To(Equal(&sentry.ProjectEnvironment{
+				ID:       "2",
+				IsHidden: true,
+				Name:     "staging",
+			}))
+		})
+	})
+
+	Describe("ListFilters", func() {
+		var (
+			filters []sentry.ProjectFilter
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_filters/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/filters/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			filters, resp, err = client.Projects.ListFilters("organization", "project")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(filters).To(Equal([]sentry.ProjectFilter{
+				{
+					Active:      true,
+					Description: "Certain browser extensions will inject inline scripts and are known to cause errors.",
+					ID:          "browser-extensions",
+					Name:        "Filter out errors known to be caused by browser extensions",
+				},
+				{
+					Active:      false,
+					Description: "This applies to both IPv4 (``127.0.0.1``) and IPv6 (``::1``) addresses.",
+					ID:          "localhost",
+					Name:        "Filter out events coming from localhost",
+				},
+				{
+					Active:      true,
+					Description: "Older browsers often give less accurate information, and while they may report valid issues, the context to understand them is incorrect or missing.",
+					ID:          "legacy-browsers",
+					Name:        "Filter out known errors from legacy browsers",
+					Subfilters:  []string{"ie_pre_9", "ie9"},
+				},
+				{
+					Active:      false,
+					Description: "Some crawlers may execute pages in incompatible ways which then cause errors that are unlikely to be seen by a normal user.",
+					ID:          "web-crawlers",
+					Name:        "Filter out known web crawlers",
+				},
+			}))
+		})
+	})
+
+	Describe("UpdateFilter", func() {
+		var (
+			filterID string
+			params   *sentry.UpdateProjectFilterParams
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/projects/organization/project/filters/legacy-browsers/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}),
+		)
+
+		BeforeEach(func() {
+			filterID = "legacy-browsers"
+			params = &sentry.UpdateProjectFilterParams{
+				Active:     sentry.Bool(true),
+				Subfilters: []string{"ie_pre_9", "ie9"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Projects.UpdateFilter("organization", "project", filterID, params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+		})
+
+		Context("when filter does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/filters/invalid/",
+				testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				filterID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("ListQuotas", func() {
+		var (
+			quotas []sentry.ProjectQuota
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_quotas/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/quotas/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			quotas, resp, err = client.Projects.ListQuotas("organization", "project")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(quotas).To(Equal([]sentry.ProjectQuota{
+				{
+					Category: "error",
+					Limit:    sentry.Int(10000),
+					Window:   3600,
+				},
+				{
+					Category: "transaction",
+				},
+				{
+					Category: "attachment",
+					Limit:    sentry.Int(500),
+					Window:   86400,
+				},
+			}))
+		})
+	})
+
+	Describe("UpdateQuota", func() {
+		var (
+			category string
+			params   *sentry.UpdateProjectQuotaParams
+
+			quota *sentry.ProjectQuota
+			resp  *sentry.Response
+			err   error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_quotas/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/quotas/transaction/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				var body sentry.UpdateProjectQuotaParams
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(sentry.UpdateProjectQuotaParams{
+					Limit:  sentry.Int(50000),
+					Window: 3600,
+				}))
+
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			category = "transaction"
+			params = &sentry.UpdateProjectQuotaParams{
+				Limit:  sentry.Int(50000),
+				Window: 3600,
+			}
+		})
+
+		JustBeforeEach(func() {
+			quota, resp, err = client.Projects.UpdateQuota("organization", "project", category, params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(quota).To(Equal(&sentry.ProjectQuota{
+				Category: "transaction",
+				Limit:    sentry.Int(50000),
+				Window:   3600,
+			}))
+		})
+
+		Context("when the category does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/quotas/invalid/",
+				testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				category = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("GetOwnership", func() {
+		var (
+			projectSlug string
+
+			ownership *sentry.ProjectOwnership
+			resp      *sentry.Response
+			err       error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_ownership/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/ownership/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			projectSlug = "project"
+		})
+
+		JustBeforeEach(func() {
+			ownership, resp, err = client.Projects.GetOwnership("organization", projectSlug)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(ownership).To(Equal(&sentry.ProjectOwnership{
+				AutoAssignment: false,
+				DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+				Fallthrough:    true,
+				IsActive:       true,
+				LastUpdated:    parseTime("2020-08-17T14:16:24.231Z"),
+				Raw:            "path:src/frontend/* #frontend\n",
+			}))
+		})
+
+		Context("when project does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/invalid/ownership/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				projectSlug = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("UpdateOwnership", func() {
+		var (
+			params *sentry.UpdateProjectOwnershipParams
+
+			ownership *sentry.ProjectOwnership
+			resp      *sentry.Response
+			err       error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_ownership/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/ownership/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateProjectOwnershipParams{
+				AutoAssignment: sentry.Bool(true),
+				Raw:            sentry.String("path:src/frontend/* #frontend\nurl:*/checkout/* jane@example.com\n"),
+			}
+		})
+
+		JustBeforeEach(func() {
+			ownership, resp, err = client.Projects.UpdateOwnership("organization", "project", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(ownership).To(Equal(&sentry.ProjectOwnership{
+				AutoAssignment: true,
+				DateCreated:    parseTime("2020-08-17T14:16:24.231Z"),
+				Fallthrough:    true,
+				IsActive:       true,
+				LastUpdated:    parseTime("2020-08-17T15:16:24.231Z"),
+				Raw:            "path:src/frontend/* #frontend\nurl:*/checkout/* jane@example.com\n",
+			}))
+		})
+	})
+
+	Describe("ListRules", func() {
+		var (
+			rules []sentry.IssueAlertRule
+			resp  *sentry.Response
+			err   error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_rules/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/rules/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			rules, resp, err = client.Projects.ListRules("organization", "project", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(rules).To(Equal([]sentry.IssueAlertRule{
+				{
+					ActionMatch: "all",
+					Actions: []sentry.IssueAlertRuleComponent{
+						{
+							"id":               "sentry.mail.actions.NotifyEmailAction",
+							"name":             "Send a notification to IssueOwners",
+							"targetIdentifier": "",
+							"targetType":       "IssueOwners",
+						},
+					},
+					Conditions: []sentry.IssueAlertRuleComponent{
+						{
+							"id":   "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+							"name": "A new issue is created",
+						},
+					},
+					DateCreated: parseTime("2020-08-17T14:16:24.231Z"),
+					Environment: sentry.String("production"),
+					FilterMatch: "all",
+					Filters: []sentry.IssueAlertRuleComponent{
+						{
+							"id":    "sentry.rules.filters.level.LevelFilter",
+							"level": "40",
+							"match": "gte",
+							"name":  "The event's level is greater than or equal to error",
+						},
+					},
+					Frequency: 30,
+					ID:        "12345",
+					Name:      "New issues",
+					Projects:  []string{"project"},
+				},
+			}))
+		})
+	})
+
+	Describe("GetRule", func() {
+		var (
+			ruleID string
+
+			rule *sentry.IssueAlertRule
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_rules/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/rules/valid/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			ruleID = "valid"
+		})
+
+		JustBeforeEach(func() {
+			rule, resp, err = client.Projects.GetRule("organization", "project", ruleID)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(rule).To(Equal(&sentry.IssueAlertRule{
+				ActionMatch: "all",
+				Actions: []sentry.IssueAlertRuleComponent{
+					{
+						"id":               "sentry.mail.actions.NotifyEmailAction",
+						"name":             "Send a notification to IssueOwners",
+						"targetIdentifier": "",
+						"targetType":       "IssueOwners",
+					},
+				},
+				Conditions: []sentry.IssueAlertRuleComponent{
+					{
+						"id":   "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+						"name": "A new issue is created",
+					},
+				},
+				DateCreated: parseTime("2020-08-17T14:16:24.231Z"),
+				Environment: sentry.String("production"),
+				FilterMatch: "all",
+				Filters: []sentry.IssueAlertRuleComponent{
+					{
+						"id":    "sentry.rules.filters.level.LevelFilter",
+						"level": "40",
+						"match": "gte",
+						"name":  "The event's level is greater than or equal to error",
+					},
+				},
+				Frequency: 30,
+				ID:        "12345",
+				Name:      "New issues",
+				Projects:  []string{"project"},
+			}))
+		})
+
+		Context("when rule does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/rules/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				ruleID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("CreateRule", func() {
+		var (
+			params *sentry.CreateIssueAlertRuleParams
+
+			rule *sentry.IssueAlertRule
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_rules/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/rules/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if _, ok := body["conditions"]; !ok || body["name"] == nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"name": []string{"This field is required."}}))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateIssueAlertRuleParams{
+				ActionMatch: "all",
+				Actions: []sentry.IssueAlertRuleComponent{
+					{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"},
+				},
+				Conditions: []sentry.IssueAlertRuleComponent{
+					{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+				},
+				Environment: sentry.String("production"),
+				Frequency:   30,
+				Name:        "New issues",
+			}
+		})
+
+		JustBeforeEach(func() {
+			rule, resp, err = client.Projects.CreateRule("organization", "project", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(rule).To(Equal(&sentry.IssueAlertRule{
+				ActionMatch: "all",
+				Actions: []sentry.IssueAlertRuleComponent{
+					{
+						"id":               "sentry.mail.actions.NotifyEmailAction",
+						"name":             "Send a notification to IssueOwners",
+						"targetIdentifier": "",
+						"targetType":       "IssueOwners",
+					},
+				},
+				Conditions: []sentry.IssueAlertRuleComponent{
+					{
+						"id":   "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+						"name": "A new issue is created",
+					},
+				},
+				DateCreated: parseTime("2020-08-17T14:16:24.231Z"),
+				Environment: sentry.String("production"),
+				FilterMatch: "all",
+				Filters: []sentry.IssueAlertRuleComponent{
+					{
+						"id":    "sentry.rules.filters.level.LevelFilter",
+						"level": "40",
+						"match": "gte",
+						"name":  "The event's level is greater than or equal to error",
+					},
+				},
+				Frequency: 30,
+				ID:        "12345",
+				Name:      "New issues",
+				Projects:  []string{"project"},
+			}))
+		})
+
+		Context("when rule is invalid", func() {
+			BeforeEach(func() {
+				params.Name = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"name": []interface{}{"This field is required."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("UpdateRule", func() {
+		var (
+			params *sentry.UpdateIssueAlertRuleParams
+
+			rule *sentry.IssueAlertRule
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_rules/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/rules/12345/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateIssueAlertRuleParams{
+				ActionMatch: "all",
+				Actions: []sentry.IssueAlertRuleComponent{
+					{"id": "sentry.mail.actions.NotifyEmailAction", "targetType": "IssueOwners"},
+				},
+				Conditions: []sentry.IssueAlertRuleComponent{
+					{"id": "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition"},
+				},
+				Environment: sentry.String("production"),
+				Frequency:   60,
+				Name:        "New issues in production",
+			}
+		})
+
+		JustBeforeEach(func() {
+			rule, resp, err = client.Projects.UpdateRule("organization", "project", "12345", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(rule).To(Equal(&sentry.IssueAlertRule{
+				ActionMatch: "all",
+				Actions: []sentry.IssueAlertRuleComponent{
+					{
+						"id":               "sentry.mail.actions.NotifyEmailAction",
+						"name":             "Send a notification to IssueOwners",
+						"targetIdentifier": "",
+						"targetType":       "IssueOwners",
+					},
+				},
+				Conditions: []sentry.IssueAlertRuleComponent{
+					{
+						"id":   "sentry.rules.conditions.first_seen_event.FirstSeenEventCondition",
+						"name": "A new issue is created",
+					},
+				},
+				DateCreated: parseTime("2020-08-17T14:16:24.231Z"),
+				Environment: sentry.String("production"),
+				FilterMatch: "all",
+				Filters: []sentry.IssueAlertRuleComponent{
+					{
+						"id":    "sentry.rules.filters.level.LevelFilter",
+						"level": "40",
+						"match": "gte",
+						"name":  "The event's level is greater than or equal to error",
+					},
+				},
+				Frequency: 60,
+				ID:        "12345",
+				Name:      "New issues in production",
+				Projects:  []string{"project"},
+			}))
+		})
+	})
+
+	Describe("DeleteRule", func() {
+		var (
+			ruleID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/projects/organization/project/rules/valid/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			}),
+		)
+
+		BeforeEach(func() {
+			ruleID = "valid"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Projects.DeleteRule("organization", "project", ruleID)
+		})
+
+		It("returns a 202 Accepted response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusAccepted))
+		})
+
+		Context("when rule does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/rules/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				ruleID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("ListHooks", func() {
+		var (
+			hooks []sentry.ServiceHook
+			resp  *sentry.Response
+			err   error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_hooks/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/hooks/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			hooks, resp, err = client.Projects.ListHooks("organization", "project", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(hooks).To(Equal([]sentry.ServiceHook{
+				{
+					DateCreated: parseTime("2020-09-02T10:12:45.123Z"),
+					Events:      []string{"event.alert", "event.created"},
+					ID:          "4f9d73e63b7144ecb8944c41620a090b",
+					Secret:      "8fcac28aaa4c4f5fa572b61d40a8e084364db25fd37449c299e5a41c0504cbc2",
+					Status:      "active",
+					URL:         "https://example.com/sentry-hook",
+				},
+			}))
+		})
+	})
+
+	Describe("GetHook", func() {
+		var (
+			hookID string
+
+			hook *sentry.ServiceHook
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_hooks/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/hooks/valid/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			hookID = "valid"
+		})
+
+		JustBeforeEach(func() {
+			hook, resp, err = client.Projects.GetHook("organization", "project", hookID)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(hook).To(Equal(&sentry.ServiceHook{
+				DateCreated: parseTime("2020-09-02T10:12:45.123Z"),
+				Events:      []string{"event.alert", "event.created"},
+				ID:          "4f9d73e63b7144ecb8944c41620a090b",
+				Secret:      "8fcac28aaa4c4f5fa572b61d40a8e084364db25fd37449c299e5a41c0504cbc2",
+				Status:      "active",
+				URL:         "https://example.com/sentry-hook",
+			}))
+		})
+
+		Context("when service hook does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/hooks/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				hookID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("CreateHook", func() {
+		var (
+			params *sentry.CreateServiceHookParams
+
+			hook *sentry.ServiceHook
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_hooks/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/hooks/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if body["url"] == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"url": []string{"This field may not be blank."}}))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateServiceHookParams{
+				Events: []string{"event.alert"},
+				URL:    "https://example.com/sentry-hook",
+			}
+		})
+
+		JustBeforeEach(func() {
+			hook, resp, err = client.Projects.CreateHook("organization", "project", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(hook).To(Equal(&sentry.ServiceHook{
+				DateCreated: parseTime("2020-09-02T10:12:45.123Z"),
+				Events:      []string{"event.alert"},
+				ID:          "4f9d73e63b7144ecb8944c41620a090b",
+				Secret:      "8fcac28aaa4c4f5fa572b61d40a8e084364db25fd37449c299e5a41c0504cbc2",
+				Status:      "active",
+				URL:         "https://example.com/sentry-hook",
+			}))
+		})
+
+		Context("when service hook is invalid", func() {
+			BeforeEach(func() {
+				params.URL = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"url": []interface{}{"This field may not be blank."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("UpdateHook", func() {
+		var (
+			params *sentry.UpdateServiceHookParams
+
+			hook *sentry.ServiceHook
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_hooks/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/hooks/4f9d73e63b7144ecb8944c41620a090b/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateServiceHookParams{
+				Events: []string{"event.alert", "event.created"},
+				URL:    "https://example.com/sentry-hook/v2",
+			}
+		})
+
+		JustBeforeEach(func() {
+			hook, resp, err = client.Projects.UpdateHook("organization", "project", "4f9d73e63b7144ecb8944c41620a090b", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(hook).To(Equal(&sentry.ServiceHook{
+				DateCreated: parseTime("2020-09-02T10:12:45.123Z"),
+				Events:      []string{"event.alert", "event.created"},
+				ID:          "4f9d73e63b7144ecb8944c41620a090b",
+				Secret:      "8fcac28aaa4c4f5fa572b61d40a8e084364db25fd37449c299e5a41c0504cbc2",
+				Status:      "active",
+				URL:         "https://example.com/sentry-hook/v2",
+			}))
+		})
+	})
+
+	Describe("DeleteHook", func() {
+		var (
+			hookID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/projects/organization/project/hooks/valid/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			hookID = "valid"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Projects.DeleteHook("organization", "project", hookID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when service hook does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/hooks/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				hookID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("ListPlugins", func() {
+		var (
+			plugins []sentry.ProjectPlugin
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_plugins/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/plugins/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			plugins, resp, err = client.Projects.ListPlugins("organization", "project")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(plugins).To(Equal([]sentry.ProjectPlugin{
+				*&sentry.ProjectPlugin{
+					CanDisable: true,
+					Config: []sentry.ProjectPluginConfigField{
+						{HasSavedValue: true, Help: "The HTTP Event Collector endpoint for your Splunk instance.", Label: "Instance URL", Name: "instance", Required: true, Type: "url", Value: "https://splunk.example.com:8088"},
+						{HasSavedValue: true, Label: "Index", Name: "index", Required: true, Type: "string", Value: "main"},
+						{DefaultValue: "sentry", HasSavedValue: true, Label: "Source", Name: "source", Required: true, Type: "string", Value: "sentry"},
+						{HasSavedValue: true, Label: "Token", Name: "token", Prefix: "8a1b", Required: true, Type: "secret"},
+					},
+					Enabled: true,
+					ID:      "splunk",
+					Name:    "Splunk",
+					Slug:    "splunk",
+					Type:    "data-forwarding",
+					Version: "10.0.0",
+				},
+			}))
+		})
+	})
+
+	Describe("GetPlugin", func() {
+		var (
+			pluginID string
+
+			plugin *sentry.ProjectPlugin
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_plugins/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/plugins/splunk/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			pluginID = "splunk"
+		})
+
+		JustBeforeEach(func() {
+			plugin, resp, err = client.Projects.GetPlugin("organization", "project", pluginID)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(plugin).To(Equal(&sentry.ProjectPlugin{
+				CanDisable: true,
+				Config: []sentry.ProjectPluginConfigField{
+					{HasSavedValue: true, Help: "The HTTP Event Collector endpoint for your Splunk instance.", Label: "Instance URL", Name: "instance", Required: true, Type: "url", Value: "https://splunk.example.com:8088"},
+					{HasSavedValue: true, Label: "Index", Name: "index", Required: true, Type: "string", Value: "main"},
+					{DefaultValue: "sentry", HasSavedValue: true, Label: "Source", Name: "source", Required: true, Type: "string", Value: "sentry"},
+					{HasSavedValue: true, Label: "Token", Name: "token", Prefix: "8a1b", Required: true, Type: "secret"},
+				},
+				Enabled: true,
+				ID:      "splunk",
+				Name:    "Splunk",
+				Slug:    "splunk",
+				Type:    "data-forwarding",
+				Version: "10.0.0",
+			}))
+		})
+
+		Context("when plugin does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/plugins/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				pluginID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("UpdatePlugin", func() {
+		var (
+			params sentry.UpdateProjectPluginParams
+
+			plugin *sentry.ProjectPlugin
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_plugins/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/plugins/splunk/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if body["instance"] == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"errors": map[string]string{"instance": "This field is required."}}))
+					return
+				}
+
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = sentry.UpdateProjectPluginParams{
+				"instance": "https://splunk.example.com:8088",
+				"index":    "events",
+				"source":   "sentry",
+				"token":    "8a1b6e0c-4c1b-4b3e-9b2a-5d3c7f1e9a2b",
+			}
+		})
+
+		JustBeforeEach(func() {
+			plugin, resp, err = client.Projects.UpdatePlugin("organization", "project", "splunk", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(plugin).To(Equal(&sentry.ProjectPlugin{
+				CanDisable: true,
+				Config: []sentry.ProjectPluginConfigField{
+					{HasSavedValue: true, Help: "The HTTP Event Collector endpoint for your Splunk instance.", Label: "Instance URL", Name: "instance", Required: true, Type: "url", Value: "https://splunk.example.com:8088"},
+					{HasSavedValue: true, Label: "Index", Name: "index", Required: true, Type: "string", Value: "events"},
+					{DefaultValue: "sentry", HasSavedValue: true, Label: "Source", Name: "source", Required: true, Type: "string", Value: "sentry"},
+					{HasSavedValue: true, Label: "Token", Name: "token", Prefix: "8a1b", Required: true, Type: "secret"},
+				},
+				Enabled: true,
+				ID:      "splunk",
+				Name:    "Splunk",
+				Slug:    "splunk",
+				Type:    "data-forwarding",
+				Version: "10.0.0",
+			}))
+		})
+
+		Context("when plugin config is invalid", func() {
+			BeforeEach(func() {
+				params["instance"] = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"errors": map[string]interface{}{"instance": "This field is required."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("EnablePlugin", func() {
+		var (
+			pluginID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/projects/organization/project/plugins/splunk/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}),
+		)
+
+		BeforeEach(func() {
+			pluginID = "splunk"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Projects.EnablePlugin("organization", "project", pluginID)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+		})
+
+		Context("when plugin does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/plugins/invalid/",
+				testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				pluginID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("DisablePlugin", func() {
+		var (
+			pluginID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/projects/organization/project/plugins/splunk/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			pluginID = "splunk"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Projects.DisablePlugin("organization", "project", pluginID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when plugin does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/plugins/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				pluginID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("ResetPlugin", func() {
+		var (
+			plugin *sentry.ProjectPlugin
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_plugins/reset.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/plugins/splunk/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				Expect(body).To(Equal(map[string]interface{}{"reset": true}))
+
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			plugin, resp, err = client.Projects.ResetPlugin("organization", "project", "splunk")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(plugin).To(Equal(&sentry.ProjectPlugin{
+				CanDisable: true,
+				Config: []sentry.ProjectPluginConfigField{
+					{Help: "The HTTP Event Collector endpoint for your Splunk instance.", Label: "Instance URL", Name: "instance", Required: true, Type: "url"},
+					{Label: "Index", Name: "index", Required: true, Type: "string"},
+					{DefaultValue: "sentry", Label: "Source", Name: "source", Required: true, Type: "string", Value: "sentry"},
+					{Label: "Token", Name: "token", Required: true, Type: "secret"},
+				},
+				ID:      "splunk",
+				Name:    "Splunk",
+				Slug:    "splunk",
+				Type:    "data-forwarding",
+				Version: "10.0.0",
+			}))
+		})
+	})
+
+	Describe("ListSearches", func() {
+		var (
+			searches []sentry.SavedSearch
+			resp     *sentry.Response
+			err      error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_searches/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/searches/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			searches, resp, err = client.Projects.ListSearches("organization", "project")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(searches).To(Equal([]sentry.SavedSearch{
+				*&sentry.SavedSearch{
+					DateCreated: parseTime("2020-09-10T14:03:21.456Z"),
+					ID:          "42",
+					Name:        "Unassigned Errors",
+					Query:       "is:unresolved is:unassigned",
+					Sort:        "date",
+				},
+			}))
+		})
+	})
+
+	Describe("CreateSearch", func() {
+		var (
+			params *sentry.CreateSavedSearchParams
+
+			search *sentry.SavedSearch
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_searches/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/searches/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if body["query"] == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"query": []string{"This field may not be blank."}}))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateSavedSearchParams{
+				Name:  "Unassigned Errors",
+				Query: "is:unresolved is:unassigned",
+				Sort:  "date",
+			}
+		})
+
+		JustBeforeEach(func() {
+			search, resp, err = client.Projects.CreateSearch("organization", "project", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(search).To(Equal(&sentry.SavedSearch{
+				DateCreated: parseTime("2020-09-10T14:03:21.456Z"),
+				ID:          "42",
+				Name:        "Unassigned Errors",
+				Query:       "is:unresolved is:unassigned",
+				Sort:        "date",
+			}))
+		})
+
+		Context("when saved search is invalid", func() {
+			BeforeEach(func() {
+				params.Query = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"query": []interface{}{"This field may not be blank."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("UpdateSearch", func() {
+		var (
+			params *sentry.UpdateSavedSearchParams
+
+			search *sentry.SavedSearch
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/project_searches/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/searches/42/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateSavedSearchParams{
+				Query: "is:unresolved is:unassigned level:error",
+			}
+		})
+
+		JustBeforeEach(func() {
+			search, resp, err = client.Projects.UpdateSearch("organization", "project", "42", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(search).To(Equal(&sentry.SavedSearch{
+				DateCreated: parseTime("2020-09-10T14:03:21.456Z"),
+				ID:          "42",
+				Name:        "Unassigned Errors",
+				Query:       "is:unresolved is:unassigned level:error",
+				Sort:        "date",
+			}))
+		})
+	})
+
+	Describe("DeleteSearch", func() {
+		var (
+			searchID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/projects/organization/project/searches/42/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			searchID = "42"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Projects.DeleteSearch("organization", "project", searchID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when saved search does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/searches/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				searchID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
 })
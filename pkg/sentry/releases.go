@@ -0,0 +1,268 @@
+package sentry
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+type ReleasesService service
+
+type Release struct {
+	CommitCount  int              `json:"commitCount"`
+	DateCreated  time.Time        `json:"dateCreated"`
+	DateReleased *time.Time       `json:"dateReleased"`
+	DeployCount  int              `json:"deployCount"`
+	ID           int              `json:"id"`
+	NewGroups    int              `json:"newGroups"`
+	Projects     []ReleaseProject `json:"projects"`
+	Ref          string           `json:"ref"`
+	ShortVersion string           `json:"shortVersion"`
+	URL          string           `json:"url"`
+	Version      string           `json:"version"`
+}
+
+type ReleaseProject struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type ReleaseCommit struct {
+	AuthorEmail string     `json:"author_email,omitempty"`
+	AuthorName  string     `json:"author_name,omitempty"`
+	ID          string     `json:"id"`
+	Message     string     `json:"message,omitempty"`
+	Repository  string     `json:"repository,omitempty"`
+	Timestamp   *time.Time `json:"timestamp,omitempty"`
+}
+
+type ReleaseRef struct {
+	Commit         string `json:"commit"`
+	PreviousCommit string `json:"previousCommit,omitempty"`
+	Repository     string `json:"repository"`
+}
+
+func (s *ReleasesService) List(organizationSlug string, opts *ListOptions) ([]Release, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/organizations/%s/releases", organizationSlug)
+	} else {
+		endpoint = fmt.Sprintf("/organizations/%s/releases/?&cursor=%s", organizationSlug, opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	releases := new([]Release)
+	resp, err := s.client.do(req, releases)
+	return *releases, resp, err
+}
+
+func (s *ReleasesService) Get(organizationSlug, version string) (*Release, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/releases/%s", organizationSlug, url.PathEscape(version))
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.do(req, release)
+	return release, resp, err
+}
+
+type CreateReleaseParams struct {
+	Commits      []ReleaseCommit `json:"commits,omitempty"`
+	DateReleased *time.Time      `json:"dateReleased,omitempty"`
+	Projects     []string        `json:"projects,omitempty"`
+	Ref          string          `json:"ref,omitempty"`
+	Refs         []ReleaseRef    `json:"refs,omitempty"`
+	URL          string          `json:"url,omitempty"`
+	Version      string          `json:"version,omitempty"`
+}
+
+// Create creates a new release for the given projects. If a release with the same version already exists in the
+// organization, Sentry adds the projects to it instead.
+func (s *ReleasesService) Create(organizationSlug string, params *CreateReleaseParams) (*Release, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/releases", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.do(req, release)
+	return release, resp, err
+}
+
+type UpdateReleaseParams struct {
+	DateReleased *time.Time `json:"dateReleased,omitempty"`
+	Ref          string     `json:"ref,omitempty"`
+	URL          string     `json:"url,omitempty"`
+}
+
+func (s *ReleasesService) Update(organizationSlug, version string, params *UpdateReleaseParams) (*Release, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/releases/%s", organizationSlug, url.PathEscape(version))
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.do(req, release)
+	return release, resp, err
+}
+
+// Finalize marks the release as released at the given time.
+func (s *ReleasesService) Finalize(organizationSlug, version string, dateReleased time.Time) (*Release, *Response, error) {
+	return s.Update(organizationSlug, version, &UpdateReleaseParams{
+		DateReleased: &dateReleased,
+	})
+}
+
+type SetReleaseCommitsParams struct {
+	Commits []ReleaseCommit `json:"commits,omitempty"`
+	Refs    []ReleaseRef    `json:"refs,omitempty"`
+}
+
+// SetCommits associates commits with the release, either by listing the commits directly or by providing the commit
+// ranges of repositories that are integrated with the organization.
+func (s *ReleasesService) SetCommits(organizationSlug, version string, params *SetReleaseCommitsParams) (*Release, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/releases/%s", organizationSlug, url.PathEscape(version))
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := new(Release)
+	resp, err := s.client.do(req, release)
+	return release, resp, err
+}
+
+type Deploy struct {
+	DateFinished time.Time  `json:"dateFinished"`
+	DateStarted  *time.Time `json:"dateStarted"`
+	Environment  string     `json:"environment"`
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	URL          string     `json:"url"`
+}
+
+func (s *ReleasesService) ListDeploys(organizationSlug, version string, opts *ListOptions) ([]Deploy, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/organizations/%s/releases/%s/deploys", organizationSlug, url.PathEscape(version))
+	} else {
+		endpoint = fmt.Sprintf("/organizations/%s/releases/%s/deploys/?&cursor=%s", organizationSlug, url.PathEscape(version), opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deploys := new([]Deploy)
+	resp, err := s.client.do(req, deploys)
+	return *deploys, resp, err
+}
+
+type CreateDeployParams struct {
+	DateFinished *time.Time `json:"dateFinished,omitempty"`
+	DateStarted  *time.Time `json:"dateStarted,omitempty"`
+	Environment  string     `json:"environment,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	URL          string     `json:"url,omitempty"`
+}
+
+// CreateDeploy records a deploy of the release to the given environment. Deploys cannot be updated once created.
+func (s *ReleasesService) CreateDeploy(organizationSlug, version string, params *CreateDeployParams) (*Deploy, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/releases/%s/deploys", organizationSlug, url.PathEscape(version))
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deploy := new(Deploy)
+	resp, err := s.client.do(req, deploy)
+	return deploy, resp, err
+}
+
+func (s *ReleasesService) Delete(organizationSlug, version string) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/releases/%s", organizationSlug, url.PathEscape(version))
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+type ReleaseFile struct {
+	DateCreated time.Time         `json:"dateCreated"`
+	Dist        string            `json:"dist"`
+	Headers     map[string]string `json:"headers"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	SHA1        string            `json:"sha1"`
+	Size        int               `json:"size"`
+}
+
+func (s *ReleasesService) ListFiles(organizationSlug, projectSlug, version string, opts *ListOptions) ([]ReleaseFile, *Response, error) {
+	var endpoint string
+	if opts.Cursor == "" {
+		endpoint = fmt.Sprintf("/projects/%s/%s/releases/%s/files", organizationSlug, projectSlug, url.PathEscape(version))
+	} else {
+		endpoint = fmt.Sprintf("/projects/%s/%s/releases/%s/files/?&cursor=%s", organizationSlug, projectSlug, url.PathEscape(version), opts.Cursor)
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := new([]ReleaseFile)
+	resp, err := s.client.do(req, files)
+	return *files, resp, err
+}
+
+// UploadReleaseFileParams describe a release file to be uploaded, such as a source map. Name is the full URL or path
+// that the file is served from, such as "~/static/js/main.js.map".
+type UploadReleaseFileParams struct {
+	Content []byte
+	Dist    string
+	Name    string
+}
+
+func (s *ReleasesService) UploadFile(organizationSlug, projectSlug, version string, params *UploadReleaseFileParams) (*ReleaseFile, *Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/releases/%s/files", organizationSlug, projectSlug, url.PathEscape(version))
+
+	fields := map[string]string{"name": params.Name}
+	if params.Dist != "" {
+		fields["dist"] = params.Dist
+	}
+
+	req, err := s.client.newUploadRequest(http.MethodPost, endpoint, fields, path.Base(params.Name), params.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file := new(ReleaseFile)
+	resp, err := s.client.do(req, file)
+	return file, resp, err
+}
+
+func (s *ReleasesService) DeleteFile(organizationSlug, projectSlug, version, fileID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/projects/%s/%s/releases/%s/files/%s", organizationSlug, projectSlug, url.PathEscape(version), fileID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
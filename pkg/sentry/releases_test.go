@@ -0,0 +1,635 @@
+package sentry_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("ReleasesService", func() {
+	Describe("List", func() {
+		var (
+			releases []sentry.Release
+			resp     *sentry.Response
+			err      error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			releases, resp, err = client.Releases.List("organization", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(releases).To(Equal([]sentry.Release{
+				{
+					DateCreated: parseTime("2020-08-17T14:16:24.231Z"),
+					ID:          1,
+					Projects: []sentry.ReleaseProject{
+						{
+							ID:   2,
+							Name: "Project",
+							Slug: "project",
+						},
+					},
+					Ref:          "main",
+					ShortVersion: "1.0.0",
+					URL:          "https://example.com/releases/1.0.0",
+					Version:      "1.0.0",
+				},
+			}))
+		})
+	})
+
+	Describe("Get", func() {
+		var (
+			version string
+
+			release *sentry.Release
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/1.0.0/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			version = "1.0.0"
+		})
+
+		JustBeforeEach(func() {
+			release, resp, err = client.Releases.Get("organization", version)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(release).To(Equal(&sentry.Release{
+				DateCreated: parseTime("2020-08-17T14:16:24.231Z"),
+				ID:          1,
+				Projects: []sentry.ReleaseProject{
+					{
+						ID:   2,
+						Name: "Project",
+						Slug: "project",
+					},
+				},
+				Ref:          "main",
+				ShortVersion: "1.0.0",
+				URL:          "https://example.com/releases/1.0.0",
+				Version:      "1.0.0",
+			}))
+		})
+
+		Context("when release does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/releases/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				version = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("Create", func() {
+		var (
+			params *sentry.CreateReleaseParams
+
+			release *sentry.Release
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateReleaseParams{
+				Projects: []string{"project"},
+				Ref:      "main",
+				URL:      "https://example.com/releases/1.0.0",
+				Version:  "1.0.0",
+			}
+		})
+
+		JustBeforeEach(func() {
+			release, resp, err = client.Releases.Create("organization", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(release).To(Equal(&sentry.Release{
+				DateCreated: parseTime("2020-08-17T14:16:24.231Z"),
+				ID:          1,
+				Projects: []sentry.ReleaseProject{
+					{
+						ID:   2,
+						Name: "Project",
+						Slug: "project",
+					},
+				},
+				Ref:          "main",
+				ShortVersion: "1.0.0",
+				URL:          "https://example.com/releases/1.0.0",
+				Version:      "1.0.0",
+			}))
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			params *sentry.UpdateReleaseParams
+
+			release *sentry.Release
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/1.0.0/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			dateReleased := parseTime("2020-08-17T15:16:24.231Z")
+			params = &sentry.UpdateReleaseParams{
+				DateReleased: &dateReleased,
+				Ref:          "main",
+			}
+		})
+
+		JustBeforeEach(func() {
+			release, resp, err = client.Releases.Update("organization", "1.0.0", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			dateReleased := parseTime("2020-08-17T15:16:24.231Z")
+			Expect(release).To(Equal(&sentry.Release{
+				DateCreated:  parseTime("2020-08-17T14:16:24.231Z"),
+				DateReleased: &dateReleased,
+				ID:           1,
+				Projects: []sentry.ReleaseProject{
+					{
+						ID:   2,
+						Name: "Project",
+						Slug: "project",
+					},
+				},
+				Ref:          "main",
+				ShortVersion: "1.0.0",
+				URL:          "https://example.com/releases/1.0.0",
+				Version:      "1.0.0",
+			}))
+		})
+	})
+
+	Describe("Finalize", func() {
+		var (
+			release *sentry.Release
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/1.0.0/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			release, resp, err = client.Releases.Finalize("organization", "1.0.0", parseTime("2020-08-17T15:16:24.231Z"))
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			dateReleased := parseTime("2020-08-17T15:16:24.231Z")
+			Expect(release.DateReleased).To(Equal(&dateReleased))
+		})
+	})
+
+	Describe("SetCommits", func() {
+		var (
+			params *sentry.SetReleaseCommitsParams
+
+			release *sentry.Release
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/set-commits.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/1.0.0/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.SetReleaseCommitsParams{
+				Refs: []sentry.ReleaseRef{
+					{
+						Commit:         "a1b2c3d4",
+						PreviousCommit: "e5f6a7b8",
+						Repository:     "organization/repository",
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			release, resp, err = client.Releases.SetCommits("organization", "1.0.0", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(release.CommitCount).To(Equal(2))
+		})
+	})
+
+	Describe("ListDeploys", func() {
+		var (
+			deploys []sentry.Deploy
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/list-deploys.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/1.0.0/deploys/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			deploys, resp, err = client.Releases.ListDeploys("organization", "1.0.0", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			dateStarted := parseTime("2020-08-17T14:16:24.231Z")
+			Expect(deploys).To(Equal([]sentry.Deploy{
+				{
+					DateFinished: parseTime("2020-08-17T14:26:24.231Z"),
+					DateStarted:  &dateStarted,
+					Environment:  "production",
+					ID:           "3",
+					Name:         "my-app",
+				},
+			}))
+		})
+	})
+
+	Describe("CreateDeploy", func() {
+		var (
+			params *sentry.CreateDeployParams
+
+			deploy *sentry.Deploy
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/releases/create-deploy.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/1.0.0/deploys/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			dateStarted := parseTime("2020-08-17T14:16:24.231Z")
+			dateFinished := parseTime("2020-08-17T14:26:24.231Z")
+			params = &sentry.CreateDeployParams{
+				DateFinished: &dateFinished,
+				DateStarted:  &dateStarted,
+				Environment:  "production",
+				Name:         "my-app",
+			}
+		})
+
+		JustBeforeEach(func() {
+			deploy, resp, err = client.Releases.CreateDeploy("organization", "1.0.0", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			dateStarted := parseTime("2020-08-17T14:16:24.231Z")
+			Expect(deploy).To(Equal(&sentry.Deploy{
+				DateFinished: parseTime("2020-08-17T14:26:24.231Z"),
+				DateStarted:  &dateStarted,
+				Environment:  "production",
+				ID:           "3",
+				Name:         "my-app",
+			}))
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			version string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/releases/1.0.0/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			version = "1.0.0"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Releases.Delete("organization", version)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when release does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/releases/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				version = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("ListFiles", func() {
+		var (
+			files []sentry.ReleaseFile
+			resp  *sentry.Response
+			err   error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/release_files/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/releases/1.0.0/files/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Add("Link", newPaginationLinks())
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			files, resp, err = client.Releases.ListFiles("organization", "project", "1.0.0", &sentry.ListOptions{})
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(resp.NextPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/next/?&cursor=0:0:0",
+				Cursor:  "0:0:0",
+				Results: false,
+			}))
+			Expect(resp.PrevPage).To(Equal(&sentry.Page{
+				URL:     "https://sentry.io/api/0/previous/?&cursor=0:0:1",
+				Cursor:  "0:0:1",
+				Results: true,
+			}))
+
+			Expect(files).To(Equal([]sentry.ReleaseFile{
+				{
+					DateCreated: parseTime("2020-09-16T11:45:02.321Z"),
+					Headers:     map[string]string{"Content-Type": "application/octet-stream"},
+					ID:          "9876",
+					Name:        "~/static/js/main.js.map",
+					SHA1:        "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3",
+					Size:        4,
+				},
+			}))
+		})
+	})
+
+	Describe("UploadFile", func() {
+		var (
+			params *sentry.UploadReleaseFileParams
+
+			file *sentry.ReleaseFile
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/release_files/upload.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/projects/organization/project/releases/1.0.0/files/",
+			testUploadHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.ParseMultipartForm(1 << 20)).To(Succeed())
+
+				if r.FormValue("name") == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"detail": "File name must be specified"}))
+					return
+				}
+
+				Expect(r.FormValue("name")).To(Equal("~/static/js/main.js.map"))
+				Expect(r.FormValue("dist")).To(Equal("web"))
+
+				upload, header, err := r.FormFile("file")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(header.Filename).To(Equal("main.js.map"))
+
+				content, err := ioutil.ReadAll(upload)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(content).To(Equal([]byte("test")))
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UploadReleaseFileParams{
+				Content: []byte("test"),
+				Dist:    "web",
+				Name:    "~/static/js/main.js.map",
+			}
+		})
+
+		JustBeforeEach(func() {
+			file, resp, err = client.Releases.UploadFile("organization", "project", "1.0.0", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(file).To(Equal(&sentry.ReleaseFile{
+				DateCreated: parseTime("2020-09-16T11:45:02.321Z"),
+				Headers:     map[string]string{"Content-Type": "application/octet-stream"},
+				ID:          "9876",
+				Name:        "~/static/js/main.js.map",
+				SHA1:        "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3",
+				Size:        4,
+			}))
+		})
+
+		Context("when release file is invalid", func() {
+			BeforeEach(func() {
+				params.Name = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "File name must be specified"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("DeleteFile", func() {
+		var (
+			fileID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/projects/organization/project/releases/1.0.0/files/9876/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			fileID = "9876"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.Releases.DeleteFile("organization", "project", "1.0.0", fileID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when release file does not exist", func() {
+			handler.HandleFunc("/api/0/projects/organization/project/releases/1.0.0/files/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				fileID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+})
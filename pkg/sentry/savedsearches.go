@@ -0,0 +1,87 @@
+package sentry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type SavedSearchesService service
+
+// SavedSearchTypeIssue is the type of saved searches for issues.
+const SavedSearchTypeIssue = 0
+
+type SavedSearch struct {
+	DateCreated time.Time `json:"dateCreated"`
+	ID          string    `json:"id"`
+	IsGlobal    bool      `json:"isGlobal"`
+	IsPinned    bool      `json:"isPinned"`
+	Name        string    `json:"name"`
+	Query       string    `json:"query"`
+	Sort        string    `json:"sort"`
+	Type        int       `json:"type"`
+	Visibility  string    `json:"visibility"`
+}
+
+func (s *SavedSearchesService) List(organizationSlug string) ([]SavedSearch, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/searches", organizationSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searches := new([]SavedSearch)
+	resp, err := s.client.do(req, searches)
+	return *searches, resp, err
+}
+
+type CreateSavedSearchParams struct {
+	Name       string `json:"name"`
+	Query      string `json:"query"`
+	Sort       string `json:"sort,omitempty"`
+	Type       *int   `json:"type,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+func (s *SavedSearchesService) Create(organizationSlug string, params *CreateSavedSearchParams) (*SavedSearch, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/searches", organizationSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(SavedSearch)
+	resp, err := s.client.do(req, search)
+	return search, resp, err
+}
+
+type UpdateSavedSearchParams struct {
+	Name       string `json:"name,omitempty"`
+	Query      string `json:"query,omitempty"`
+	Sort       string `json:"sort,omitempty"`
+	Type       *int   `json:"type,omitempty"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+func (s *SavedSearchesService) Update(organizationSlug, searchID string, params *UpdateSavedSearchParams) (*SavedSearch, *Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/searches/%s", organizationSlug, searchID)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(SavedSearch)
+	resp, err := s.client.do(req, search)
+	return search, resp, err
+}
+
+func (s *SavedSearchesService) Delete(organizationSlug, searchID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/organizations/%s/searches/%s", organizationSlug, searchID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
@@ -0,0 +1,213 @@
+package sentry_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("SavedSearchesService", func() {
+	Describe("List", func() {
+		var (
+			searches []sentry.SavedSearch
+			resp     *sentry.Response
+			err      error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/saved_searches/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/searches/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			searches, resp, err = client.SavedSearches.List("organization")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(searches).To(Equal([]sentry.SavedSearch{
+				*&sentry.SavedSearch{
+					DateCreated: parseTime("2020-09-10T14:03:21.456Z"),
+					ID:          "23",
+					Name:        "Unresolved Checkout Errors",
+					Query:       "is:unresolved transaction:/checkout/*",
+					Sort:        "date",
+					Visibility:  "organization",
+				},
+			}))
+		})
+	})
+
+	Describe("Create", func() {
+		var (
+			params *sentry.CreateSavedSearchParams
+
+			search *sentry.SavedSearch
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/saved_searches/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/searches/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if body["query"] == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"query": []string{"This field may not be blank."}}))
+					return
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateSavedSearchParams{
+				Name:       "Unresolved Checkout Errors",
+				Query:      "is:unresolved transaction:/checkout/*",
+				Sort:       "date",
+				Type:       sentry.Int(sentry.SavedSearchTypeIssue),
+				Visibility: "organization",
+			}
+		})
+
+		JustBeforeEach(func() {
+			search, resp, err = client.SavedSearches.Create("organization", params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(search).To(Equal(&sentry.SavedSearch{
+				DateCreated: parseTime("2020-09-10T14:03:21.456Z"),
+				ID:          "23",
+				Name:        "Unresolved Checkout Errors",
+				Query:       "is:unresolved transaction:/checkout/*",
+				Sort:        "date",
+				Visibility:  "organization",
+			}))
+		})
+
+		Context("when saved search is invalid", func() {
+			BeforeEach(func() {
+				params.Query = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"query": []interface{}{"This field may not be blank."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			params *sentry.UpdateSavedSearchParams
+
+			search *sentry.SavedSearch
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/saved_searches/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/organizations/organization/searches/23/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.UpdateSavedSearchParams{
+				Sort: "freq",
+			}
+		})
+
+		JustBeforeEach(func() {
+			search, resp, err = client.SavedSearches.Update("organization", "23", params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(search).To(Equal(&sentry.SavedSearch{
+				DateCreated: parseTime("2020-09-10T14:03:21.456Z"),
+				ID:          "23",
+				Name:        "Unresolved Checkout Errors",
+				Query:       "is:unresolved transaction:/checkout/*",
+				Sort:        "freq",
+				Visibility:  "organization",
+			}))
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			searchID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/organizations/organization/searches/23/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			searchID = "23"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.SavedSearches.Delete("organization", searchID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when saved search does not exist", func() {
+			handler.HandleFunc("/api/0/organizations/organization/searches/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				searchID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+})
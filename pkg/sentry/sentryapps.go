@@ -0,0 +1,145 @@
+package sentry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type SentryAppsService service
+
+type SentryApp struct {
+	AllowedOrigins []string       `json:"allowedOrigins"`
+	Author         string         `json:"author"`
+	Events         []string       `json:"events"`
+	IsAlertable    bool           `json:"isAlertable"`
+	Name           string         `json:"name"`
+	Overview       string         `json:"overview"`
+	Owner          SentryAppOwner `json:"owner"`
+	RedirectURL    string         `json:"redirectUrl"`
+	Scopes         []string       `json:"scopes"`
+	Slug           string         `json:"slug"`
+	Status         string         `json:"status"`
+	UUID           string         `json:"uuid"`
+	VerifyInstall  bool           `json:"verifyInstall"`
+	WebhookURL     string         `json:"webhookUrl"`
+}
+
+type SentryAppOwner struct {
+	ID   int    `json:"id"`
+	Slug string `json:"slug"`
+}
+
+func (s *SentryAppsService) Get(sentryAppSlug string) (*SentryApp, *Response, error) {
+	endpoint := fmt.Sprintf("/sentry-apps/%s", sentryAppSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := new(SentryApp)
+	resp, err := s.client.do(req, app)
+	return app, resp, err
+}
+
+// CreateSentryAppParams describe a Sentry app to be created. Setting IsInternal creates an internal integration, which
+// is installed on the given organization only.
+type CreateSentryAppParams struct {
+	Events        []string `json:"events"`
+	IsAlertable   bool     `json:"isAlertable"`
+	IsInternal    bool     `json:"isInternal"`
+	Name          string   `json:"name"`
+	Organization  string   `json:"organization"`
+	Overview      string   `json:"overview,omitempty"`
+	Scopes        []string `json:"scopes"`
+	VerifyInstall bool     `json:"verifyInstall"`
+	WebhookURL    string   `json:"webhookUrl,omitempty"`
+}
+
+func (s *SentryAppsService) Create(params *CreateSentryAppParams) (*SentryApp, *Response, error) {
+	endpoint := "/sentry-apps"
+	req, err := s.client.newRequest(http.MethodPost, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := new(SentryApp)
+	resp, err := s.client.do(req, app)
+	return app, resp, err
+}
+
+type UpdateSentryAppParams struct {
+	Events     []string `json:"events,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	Overview   string   `json:"overview,omitempty"`
+	Scopes     []string `json:"scopes,omitempty"`
+	WebhookURL string   `json:"webhookUrl,omitempty"`
+}
+
+func (s *SentryAppsService) Update(sentryAppSlug string, params *UpdateSentryAppParams) (*SentryApp, *Response, error) {
+	endpoint := fmt.Sprintf("/sentry-apps/%s", sentryAppSlug)
+	req, err := s.client.newRequest(http.MethodPut, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := new(SentryApp)
+	resp, err := s.client.do(req, app)
+	return app, resp, err
+}
+
+func (s *SentryAppsService) Delete(sentryAppSlug string) (*Response, error) {
+	endpoint := fmt.Sprintf("/sentry-apps/%s", sentryAppSlug)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
+
+type SentryAppToken struct {
+	DateCreated time.Time  `json:"dateCreated"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	ID          string     `json:"id"`
+	Scopes      []string   `json:"scopes"`
+	Token       string     `json:"token"`
+}
+
+func (s *SentryAppsService) ListTokens(sentryAppSlug string) ([]SentryAppToken, *Response, error) {
+	endpoint := fmt.Sprintf("/sentry-apps/%s/api-tokens", sentryAppSlug)
+	req, err := s.client.newRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens := new([]SentryAppToken)
+	resp, err := s.client.do(req, tokens)
+	return *tokens, resp, err
+}
+
+// CreateToken creates a new auth token for the internal integration with the given slug. The token's value is only
+// returned in full when it is created.
+func (s *SentryAppsService) CreateToken(sentryAppSlug string) (*SentryAppToken, *Response, error) {
+	endpoint := fmt.Sprintf("/sentry-apps/%s/api-tokens", sentryAppSlug)
+	req, err := s.client.newRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := new(SentryAppToken)
+	resp, err := s.client.do(req, token)
+	return token, resp, err
+}
+
+func (s *SentryAppsService) DeleteToken(sentryAppSlug, tokenID string) (*Response, error) {
+	endpoint := fmt.Sprintf("/sentry-apps/%s/api-tokens/%s", sentryAppSlug, tokenID)
+	req, err := s.client.newRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.do(req, nil)
+	return resp, err
+}
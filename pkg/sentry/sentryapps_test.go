@@ -0,0 +1,389 @@
+package sentry_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("SentryAppsService", func() {
+	newSentryApp := func(scopes ...string) *sentry.SentryApp {
+		return &sentry.SentryApp{
+			AllowedOrigins: []string{},
+			Author:         "organization",
+			Events:         []string{},
+			Name:           "Checkout Feedback",
+			Owner:          sentry.SentryAppOwner{ID: 2, Slug: "organization"},
+			Scopes:         scopes,
+			Slug:           "checkout-feedback-1a2b3c",
+			Status:         "internal",
+			UUID:           "0a1d2c3b-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+		}
+	}
+
+	token := sentry.SentryAppToken{
+		DateCreated: parseTime("2020-09-17T10:12:45.654Z"),
+		ID:          "42",
+		Scopes:      []string{"project:read", "event:write"},
+		Token:       "4f6d1e5a9c8b7a6f5e4d3c2b1a0f9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f",
+	}
+
+	Describe("Get", func() {
+		var (
+			sentryAppSlug string
+
+			app  *sentry.SentryApp
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/sentry_apps/get.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/sentry-apps/checkout-feedback-1a2b3c/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			sentryAppSlug = "checkout-feedback-1a2b3c"
+		})
+
+		JustBeforeEach(func() {
+			app, resp, err = client.SentryApps.Get(sentryAppSlug)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(app).To(Equal(newSentryApp("project:read", "event:write")))
+		})
+
+		Context("when Sentry app does not exist", func() {
+			handler.HandleFunc("/api/0/sentry-apps/invalid/",
+				testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				sentryAppSlug = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("Create", func() {
+		var (
+			params *sentry.CreateSentryAppParams
+
+			app  *sentry.SentryApp
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/sentry_apps/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/sentry-apps/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+				if body["name"] == "" {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write(newAPIError(sentry.APIError{"name": []string{"This field may not be blank."}}))
+					return
+				}
+
+				Expect(body).To(HaveKeyWithValue("isInternal", true))
+				Expect(body).To(HaveKeyWithValue("organization", "organization"))
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			params = &sentry.CreateSentryAppParams{
+				Events:       []string{},
+				IsInternal:   true,
+				Name:         "Checkout Feedback",
+				Organization: "organization",
+				Scopes:       []string{"project:read", "event:write"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			app, resp, err = client.SentryApps.Create(params)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(app).To(Equal(newSentryApp("project:read", "event:write")))
+		})
+
+		Context("when Sentry app is invalid", func() {
+			BeforeEach(func() {
+				params.Name = ""
+			})
+
+			It("returns a 400 Bad Request error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"name": []interface{}{"This field may not be blank."}}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("Update", func() {
+		var (
+			sentryAppSlug string
+			params        *sentry.UpdateSentryAppParams
+
+			app  *sentry.SentryApp
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/sentry_apps/update.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/sentry-apps/checkout-feedback-1a2b3c/",
+			testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			sentryAppSlug = "checkout-feedback-1a2b3c"
+			params = &sentry.UpdateSentryAppParams{
+				Scopes: []string{"project:read", "project:releases"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			app, resp, err = client.SentryApps.Update(sentryAppSlug, params)
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(app).To(Equal(newSentryApp("project:read", "project:releases")))
+		})
+
+		Context("when Sentry app does not exist", func() {
+			handler.HandleFunc("/api/0/sentry-apps/invalid/",
+				testHandler(http.MethodPut, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				sentryAppSlug = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		var (
+			sentryAppSlug string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/sentry-apps/checkout-feedback-1a2b3c/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			sentryAppSlug = "checkout-feedback-1a2b3c"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.SentryApps.Delete(sentryAppSlug)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when Sentry app does not exist", func() {
+			handler.HandleFunc("/api/0/sentry-apps/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				sentryAppSlug = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("ListTokens", func() {
+		var (
+			tokens []sentry.SentryAppToken
+			resp   *sentry.Response
+			err    error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/sentry_app_tokens/list.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/sentry-apps/checkout-feedback-1a2b3c/api-tokens/",
+			testHandler(http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fixture)
+			}),
+		)
+
+		JustBeforeEach(func() {
+			tokens, resp, err = client.SentryApps.ListTokens("checkout-feedback-1a2b3c")
+		})
+
+		It("returns a 200 OK response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusOK))
+
+			Expect(tokens).To(Equal([]sentry.SentryAppToken{token}))
+		})
+	})
+
+	Describe("CreateToken", func() {
+		var (
+			sentryAppSlug string
+
+			created *sentry.SentryAppToken
+			resp    *sentry.Response
+			err     error
+		)
+
+		handler, client := setup()
+		fixture, err := ioutil.ReadFile("fixtures/sentry_app_tokens/create.json")
+		Expect(err).ToNot(HaveOccurred())
+
+		handler.HandleFunc("/api/0/sentry-apps/checkout-feedback-1a2b3c/api-tokens/",
+			testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write(fixture)
+			}),
+		)
+
+		BeforeEach(func() {
+			sentryAppSlug = "checkout-feedback-1a2b3c"
+		})
+
+		JustBeforeEach(func() {
+			created, resp, err = client.SentryApps.CreateToken(sentryAppSlug)
+		})
+
+		It("returns a 201 Created response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusCreated))
+
+			Expect(created).To(Equal(&token))
+		})
+
+		Context("when Sentry app is not an internal integration", func() {
+			handler.HandleFunc("/api/0/sentry-apps/public/api-tokens/",
+				testHandler(http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusForbidden)
+					w.Write(newAPIError(sentry.APIError{"detail": "This route is limited to internal integrations only"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				sentryAppSlug = "public"
+			})
+
+			It("returns a 403 Forbidden error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "This route is limited to internal integrations only"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("DeleteToken", func() {
+		var (
+			tokenID string
+
+			resp *sentry.Response
+			err  error
+		)
+
+		handler, client := setup()
+
+		handler.HandleFunc("/api/0/sentry-apps/checkout-feedback-1a2b3c/api-tokens/42/",
+			testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		BeforeEach(func() {
+			tokenID = "42"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = client.SentryApps.DeleteToken("checkout-feedback-1a2b3c", tokenID)
+		})
+
+		It("returns a 204 No Content response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusNoContent))
+		})
+
+		Context("when token does not exist", func() {
+			handler.HandleFunc("/api/0/sentry-apps/checkout-feedback-1a2b3c/api-tokens/invalid/",
+				testHandler(http.MethodDelete, func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					w.Write(newAPIError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				}),
+			)
+
+			BeforeEach(func() {
+				tokenID = "invalid"
+			})
+
+			It("returns a 404 Not Found error", func() {
+				Expect(err).To(MatchError(sentry.APIError{"detail": "The requested resource does not exist"}))
+				Expect(resp.Response).To(HaveHTTPStatus(http.StatusNotFound))
+			})
+		})
+	})
+})
@@ -47,6 +47,20 @@ func testHandler(method string, handlerFunc func(http.ResponseWriter, *http.Requ
 	}
 }
 
+func testUploadHandler(method string, handlerFunc func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+
+		Expect(r.Method).To(Equal(method))
+		Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+		Expect(r.Header.Get("Content-Type")).To(HavePrefix("multipart/form-data; boundary="))
+		Expect(r.Header.Get("Accept")).To(Equal("application/json"))
+
+		w.Header().Set("Content-Type", "application/json")
+		handlerFunc(w, r)
+	}
+}
+
 func newPaginationLinks() string {
 	return `<https://sentry.io/api/0/previous/?&cursor=0:0:1>; rel="previous"; results="true"; cursor="0:0:1", <https://sentry.io/api/0/next/?&cursor=0:0:0>; rel="next"; results="false"; cursor="0:0:0"`
 }
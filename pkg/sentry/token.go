@@ -0,0 +1,97 @@
+package sentry
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource provides the authentication token used for communicating with the Sentry API.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+type staticTokenSource string
+
+// StaticTokenSource returns a TokenSource that always provides the same token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+func (s staticTokenSource) Token() (string, error) {
+	return string(s), nil
+}
+
+// FileTokenSource is a TokenSource that reads the token from a file, re-reading it whenever the file gets modified so
+// that the token can be rotated without restarting, such as when the file is mounted from a Kubernetes Secret.
+type FileTokenSource struct {
+	path     string
+	onChange func()
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+type FileTokenSourceOption func(*FileTokenSource)
+
+// NewFileTokenSource creates a FileTokenSource for the given path, returning an error if a token cannot be read from it.
+func NewFileTokenSource(path string, opts ...FileTokenSourceOption) (*FileTokenSource, error) {
+	ts := &FileTokenSource{
+		path: path,
+	}
+
+	for _, opt := range opts {
+		opt(ts)
+	}
+
+	if _, err := ts.Token(); err != nil {
+		return nil, err
+	}
+
+	return ts, nil
+}
+
+// WithTokenChangeHandler registers a function to be called whenever the token read from the file changes.
+func WithTokenChangeHandler(onChange func()) FileTokenSourceOption {
+	return func(ts *FileTokenSource) {
+		ts.onChange = onChange
+	}
+}
+
+func (ts *FileTokenSource) Token() (string, error) {
+	info, err := os.Stat(ts.path)
+	if err != nil {
+		return "", err
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if info.ModTime().Equal(ts.modTime) {
+		return ts.token, nil
+	}
+
+	data, err := ioutil.ReadFile(ts.path)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errors.New("sentry: token file is empty")
+	}
+
+	changed := ts.token != "" && ts.token != token
+	ts.token = token
+	ts.modTime = info.ModTime()
+
+	if changed && ts.onChange != nil {
+		ts.onChange()
+	}
+
+	return ts.token, nil
+}
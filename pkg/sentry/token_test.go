@@ -0,0 +1,135 @@
+package sentry_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jace-ys/sentry-operator/pkg/sentry"
+)
+
+var _ = Describe("FileTokenSource", func() {
+	var (
+		dir       string
+		tokenPath string
+		changes   int
+
+		tokenSource *sentry.FileTokenSource
+		err         error
+	)
+
+	writeToken := func(token string, modTime time.Time) {
+		defer GinkgoRecover()
+
+		Expect(ioutil.WriteFile(tokenPath, []byte(token), 0600)).To(Succeed())
+		Expect(os.Chtimes(tokenPath, modTime, modTime)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		dir, err = ioutil.TempDir("", "sentry-token")
+		Expect(err).ToNot(HaveOccurred())
+
+		tokenPath = filepath.Join(dir, "token")
+		changes = 0
+		writeToken("token\n", time.Now().Add(-time.Minute))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		tokenSource, err = sentry.NewFileTokenSource(tokenPath, sentry.WithTokenChangeHandler(func() {
+			changes++
+		}))
+	})
+
+	It("returns the token read from the file", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		token, err := tokenSource.Token()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token).To(Equal("token"))
+		Expect(changes).To(Equal(0))
+	})
+
+	Context("when the file is modified", func() {
+		It("returns the new token", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			writeToken("rotated", time.Now())
+
+			token, err := tokenSource.Token()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal("rotated"))
+			Expect(changes).To(Equal(1))
+		})
+
+		It("is used by the client for subsequent requests", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			var authorization string
+			handler := http.NewServeMux()
+			handler.HandleFunc("/api/0/organizations/organization/", func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				w.Write([]byte(`{}`))
+			})
+
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			client := sentry.NewClient("", sentry.WithSentryURL(serverURL), sentry.WithTokenSource(tokenSource))
+
+			_, _, err := client.Organizations.Get("organization")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorization).To(Equal("Bearer token"))
+
+			writeToken("rotated", time.Now())
+
+			_, _, err = client.Organizations.Get("organization")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authorization).To(Equal("Bearer rotated"))
+		})
+	})
+
+	Context("when the file is empty", func() {
+		BeforeEach(func() {
+			writeToken("", time.Now())
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError("sentry: token file is empty"))
+		})
+	})
+
+	Context("when the file is removed", func() {
+		It("returns an error", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Remove(tokenPath)).To(Succeed())
+
+			_, err := tokenSource.Token()
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("fails the client's requests with a 503 Service Unavailable response", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Remove(tokenPath)).To(Succeed())
+
+			client := sentry.NewClient("", sentry.WithTokenSource(tokenSource))
+
+			_, resp, err := client.Organizations.Get("organization")
+			Expect(errors.Is(err, sentry.ErrTokenUnavailable)).To(BeTrue())
+			Expect(resp).ToNot(BeNil())
+			Expect(resp.Response).To(HaveHTTPStatus(http.StatusServiceUnavailable))
+		})
+	})
+})